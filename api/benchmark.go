@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stellarlinkco/ai-eval/internal/benchmark"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/leaderboard"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+type benchmarkRequest struct {
+	Dataset        string `json:"dataset"`
+	Provider       string `json:"provider"`
+	Model          string `json:"model"`
+	SampleSize     int    `json:"sample_size"`
+	SampleStrategy string `json:"sample_strategy"`
+	Seed           int64  `json:"seed"`
+}
+
+const (
+	benchmarkJobRunning = "running"
+	benchmarkJobDone    = "done"
+	benchmarkJobFailed  = "failed"
+)
+
+// benchmarkJob tracks one POST /api/benchmark request, run asynchronously so
+// the handler can return immediately with an id to poll.
+type benchmarkJob struct {
+	ID         string
+	Status     string // running, done, failed
+	Dataset    string
+	Provider   string
+	Model      string
+	Error      string `json:",omitempty"`
+	Entry      *leaderboard.Entry
+	CreatedAt  time.Time
+	FinishedAt time.Time `json:",omitempty"`
+}
+
+// benchmarkJobStore tracks in-flight and completed benchmark jobs in memory,
+// scoped to the lifetime of the server process.
+type benchmarkJobStore struct {
+	mu   sync.Mutex
+	jobs map[string]*benchmarkJob
+}
+
+func newBenchmarkJobStore() *benchmarkJobStore {
+	return &benchmarkJobStore{jobs: make(map[string]*benchmarkJob)}
+}
+
+func (s *benchmarkJobStore) create(dataset, provider, model string) *benchmarkJob {
+	job := &benchmarkJob{
+		ID:        newBenchmarkJobID(),
+		Status:    benchmarkJobRunning,
+		Dataset:   dataset,
+		Provider:  provider,
+		Model:     model,
+		CreatedAt: time.Now().UTC(),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = job
+	return job
+}
+
+func (s *benchmarkJobStore) get(id string) (*benchmarkJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, false
+	}
+	cp := *job
+	return &cp, true
+}
+
+func (s *benchmarkJobStore) finish(id string, entry *leaderboard.Entry, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+	job.FinishedAt = time.Now().UTC()
+	if err != nil {
+		job.Status = benchmarkJobFailed
+		job.Error = err.Error()
+		return
+	}
+	job.Status = benchmarkJobDone
+	job.Entry = entry
+}
+
+func newBenchmarkJobID() string {
+	var buf [8]byte
+	if _, err := io.ReadFull(rand.Reader, buf[:]); err != nil {
+		return fmt.Sprintf("bench_%d", time.Now().UTC().UnixNano())
+	}
+	return fmt.Sprintf("bench_%s_%s", time.Now().UTC().Format("20060102T150405Z"), hex.EncodeToString(buf[:]))
+}
+
+// resolveBenchmarkDataset and resolveBenchmarkProvider are package vars so
+// tests can substitute fakes without a real dataset file or LLM provider.
+var (
+	resolveBenchmarkDataset  = resolveBenchmarkDatasetImpl
+	resolveBenchmarkProvider = resolveBenchmarkProviderImpl
+)
+
+func resolveBenchmarkDatasetImpl(name string, sampleSize int, sampleStrategy string, seed int64) (benchmark.Dataset, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil, errors.New("benchmark: missing dataset (mmlu|humaneval|gsm8k)")
+	}
+	if sampleSize < 0 {
+		return nil, fmt.Errorf("benchmark: sample_size must be >= 0 (got %d)", sampleSize)
+	}
+	strategy, err := benchmark.ParseSampleStrategy(sampleStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "mmlu":
+		return &benchmark.MMLUDataset{SampleSize: sampleSize, SampleStrategy: strategy, Seed: seed}, nil
+	case "humaneval":
+		return &benchmark.HumanEvalDataset{SampleSize: sampleSize, SampleStrategy: strategy, Seed: seed}, nil
+	case "gsm8k":
+		return &benchmark.GSM8KDataset{SampleSize: sampleSize, SampleStrategy: strategy, Seed: seed}, nil
+	default:
+		return nil, fmt.Errorf("benchmark: unknown dataset %q (expected mmlu|humaneval|gsm8k)", name)
+	}
+}
+
+func resolveBenchmarkProviderImpl(cfg *config.Config, providerName, model string) (llm.Provider, string, error) {
+	if cfg == nil {
+		return nil, "", errors.New("benchmark: missing config")
+	}
+
+	name := normalizeBenchmarkProviderName(providerName)
+	if name == "" {
+		name = normalizeBenchmarkProviderName(cfg.LLM.DefaultProvider)
+	}
+	if name == "" {
+		return nil, "", errors.New("benchmark: missing provider")
+	}
+
+	pcfg, ok := cfg.LLM.Providers[name]
+	if !ok {
+		available := make([]string, 0, len(cfg.LLM.Providers))
+		for k := range cfg.LLM.Providers {
+			available = append(available, k)
+		}
+		sort.Strings(available)
+		return nil, "", fmt.Errorf("benchmark: provider %q not configured (available: %s)", name, strings.Join(available, ", "))
+	}
+
+	resolvedModel := strings.TrimSpace(model)
+	if resolvedModel == "" {
+		resolvedModel = strings.TrimSpace(pcfg.Model)
+	}
+	modelName := resolvedModel
+	if modelName == "" {
+		modelName = "default"
+	}
+
+	switch name {
+	case "claude":
+		return llm.NewClaudeProvider(pcfg.APIKey, pcfg.BaseURL, resolvedModel), modelName, nil
+	case "openai":
+		return llm.NewOpenAIProvider(pcfg.APIKey, pcfg.BaseURL, resolvedModel, llm.WithOpenAIHeaders(pcfg.Headers)), modelName, nil
+	default:
+		return nil, "", fmt.Errorf("benchmark: unsupported provider %q", name)
+	}
+}
+
+// benchmarkMetadata builds the leaderboard.Entry.Metadata recording how a
+// dataset sample was drawn, so a leaderboard entry can be traced back to a
+// reproducible sample_strategy/seed combination. Returns nil for the default
+// head-N strategy, which needs no seed to reproduce.
+func benchmarkMetadata(strategy string, seed int64) map[string]any {
+	strategy = strings.ToLower(strings.TrimSpace(strategy))
+	if strategy == "" {
+		return nil
+	}
+	return map[string]any{"sample_strategy": strategy, "seed": seed}
+}
+
+func normalizeBenchmarkProviderName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "anthropic" {
+		return "claude"
+	}
+	return name
+}
+
+// handleStartBenchmark starts a benchmark run in the background and returns
+// its job id immediately; poll GET /api/benchmark/:id for the outcome.
+func (s *Server) handleStartBenchmark(c *gin.Context) {
+	if s == nil || s.config == nil || s.lbStore == nil || s.benchmarkJobs == nil {
+		respondError(c, http.StatusInternalServerError, errors.New("server not initialized"))
+		return
+	}
+
+	var req benchmarkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	ds, err := resolveBenchmarkDataset(req.Dataset, req.SampleSize, req.SampleStrategy, req.Seed)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	provider, modelName, err := resolveBenchmarkProvider(s.config, req.Provider, req.Model)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	job := s.benchmarkJobs.create(ds.Name(), provider.Name(), modelName)
+	go s.runBenchmarkJob(job.ID, provider, modelName, ds, req.SampleStrategy, req.Seed)
+
+	c.JSON(http.StatusAccepted, gin.H{"id": job.ID, "status": job.Status})
+}
+
+// runBenchmarkJob executes ds against provider and records the outcome on
+// the job identified by jobID. It runs on its own goroutine, detached from
+// the request that started it, so it uses a background context rather than
+// the (already-returned) request context.
+func (s *Server) runBenchmarkJob(jobID string, provider llm.Provider, modelName string, ds benchmark.Dataset, sampleStrategy string, seed int64) {
+	r := &benchmark.BenchmarkRunner{Provider: provider, Store: s.lbStore}
+	res, err := r.Run(context.Background(), ds)
+	if err != nil {
+		s.benchmarkJobs.finish(jobID, nil, err)
+		return
+	}
+	res.Model = modelName
+
+	entry := &leaderboard.Entry{
+		Model:    modelName,
+		Provider: provider.Name(),
+		Dataset:  ds.Name(),
+		Score:    res.Score,
+		Accuracy: res.Accuracy,
+		Latency:  res.TotalTime.Milliseconds(),
+		EvalDate: time.Now().UTC(),
+		Metadata: benchmarkMetadata(sampleStrategy, seed),
+	}
+	if err := s.lbStore.Save(context.Background(), entry); err != nil {
+		s.benchmarkJobs.finish(jobID, nil, err)
+		return
+	}
+	s.benchmarkJobs.finish(jobID, entry, nil)
+}
+
+func (s *Server) handleGetBenchmarkJob(c *gin.Context) {
+	if s == nil || s.benchmarkJobs == nil {
+		respondError(c, http.StatusInternalServerError, errors.New("server not initialized"))
+		return
+	}
+
+	id := strings.TrimSpace(c.Param("id"))
+	if id == "" {
+		respondError(c, http.StatusBadRequest, errors.New("missing job id"))
+		return
+	}
+
+	job, ok := s.benchmarkJobs.get(id)
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("benchmark job %q not found", id))
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
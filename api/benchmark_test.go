@@ -0,0 +1,230 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/leaderboard"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+// withFakeBenchmarkResolvers stubs the dataset/provider resolution seams for
+// the duration of the test, so no real dataset file or LLM provider is
+// needed.
+func withFakeBenchmarkResolvers(t *testing.T, provider llm.Provider, providerErr error) {
+	t.Helper()
+
+	origDataset := resolveBenchmarkDataset
+	origProvider := resolveBenchmarkProvider
+	resolveBenchmarkDataset = resolveBenchmarkDatasetImpl
+	resolveBenchmarkProvider = func(cfg *config.Config, providerName, model string) (llm.Provider, string, error) {
+		if providerErr != nil {
+			return nil, "", providerErr
+		}
+		return provider, "fake-model", nil
+	}
+	t.Cleanup(func() {
+		resolveBenchmarkDataset = origDataset
+		resolveBenchmarkProvider = origProvider
+	})
+}
+
+func newTestServerForBenchmark(t *testing.T, lb *leaderboard.Store) (*Server, *gin.Engine) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	t.Setenv("AI_EVAL_API_KEY", "")
+	t.Setenv("AI_EVAL_DISABLE_AUTH", "true")
+
+	s := &Server{
+		router:        gin.New(),
+		config:        &config.Config{},
+		lbStore:       lb,
+		benchmarkJobs: newBenchmarkJobStore(),
+	}
+	if err := s.registerRoutes(); err != nil {
+		t.Fatalf("registerRoutes: %v", err)
+	}
+	return s, s.router
+}
+
+func TestHandlers_StartBenchmark_NotInitialized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("AI_EVAL_API_KEY", "")
+	t.Setenv("AI_EVAL_DISABLE_AUTH", "true")
+
+	s := &Server{router: gin.New()}
+	if err := s.registerRoutes(); err != nil {
+		t.Fatalf("registerRoutes: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/benchmark", bytes.NewBufferString(`{"dataset":"mmlu"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestHandlers_StartBenchmark_BadJSON(t *testing.T) {
+	lb, err := leaderboard.NewStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer lb.Close()
+	_, r := newTestServerForBenchmark(t, lb)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/benchmark", bytes.NewBufferString("{"))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlers_StartBenchmark_UnknownDataset(t *testing.T) {
+	lb, err := leaderboard.NewStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer lb.Close()
+	_, r := newTestServerForBenchmark(t, lb)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/benchmark", bytes.NewBufferString(`{"dataset":"not-a-dataset"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlers_StartBenchmark_ProviderNotConfigured(t *testing.T) {
+	withFakeBenchmarkResolvers(t, nil, errors.New(`benchmark: provider "claude" not configured`))
+
+	lb, err := leaderboard.NewStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer lb.Close()
+	_, r := newTestServerForBenchmark(t, lb)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/benchmark", bytes.NewBufferString(`{"dataset":"mmlu"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlers_StartBenchmark_RunsAsyncAndSavesLeaderboardEntry(t *testing.T) {
+	fake := &fakeProvider{
+		CompleteWithToolsFunc: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			return &llm.EvalResult{TextContent: "A", InputTokens: 1, OutputTokens: 1}, nil
+		},
+	}
+	withFakeBenchmarkResolvers(t, fake, nil)
+
+	lb, err := leaderboard.NewStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer lb.Close()
+	s, r := newTestServerForBenchmark(t, lb)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/benchmark", bytes.NewBufferString(`{"dataset":"mmlu","sample_size":1}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("status: got %d want %d, body=%s", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+	var started struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if started.ID == "" || started.Status != benchmarkJobRunning {
+		t.Fatalf("got %#v, want a running job id", started)
+	}
+
+	var job *benchmarkJob
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if j, ok := s.benchmarkJobs.get(started.ID); ok && j.Status != benchmarkJobRunning {
+			job = j
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if job == nil {
+		t.Fatalf("benchmark job %q did not finish in time", started.ID)
+	}
+	if job.Status != benchmarkJobDone {
+		t.Fatalf("job status: got %q want %q (error=%s)", job.Status, benchmarkJobDone, job.Error)
+	}
+	if job.Entry == nil || job.Entry.ID == 0 {
+		t.Fatalf("expected a saved leaderboard entry, got %#v", job.Entry)
+	}
+
+	getReq := httptest.NewRequest(http.MethodGet, "/api/benchmark/"+started.ID, nil)
+	getRec := httptest.NewRecorder()
+	r.ServeHTTP(getRec, getReq)
+	if getRec.Code != http.StatusOK {
+		t.Fatalf("GET status: got %d want %d", getRec.Code, http.StatusOK)
+	}
+}
+
+func TestHandlers_GetBenchmarkJob_NotFound(t *testing.T) {
+	lb, err := leaderboard.NewStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer lb.Close()
+	_, r := newTestServerForBenchmark(t, lb)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/benchmark/nope", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandlers_GetBenchmarkJob_NotInitialized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("AI_EVAL_API_KEY", "")
+	t.Setenv("AI_EVAL_DISABLE_AUTH", "true")
+
+	s := &Server{router: gin.New()}
+	if err := s.registerRoutes(); err != nil {
+		t.Fatalf("registerRoutes: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/benchmark/x", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
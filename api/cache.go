@@ -0,0 +1,103 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// responseCache is a small in-memory TTL cache for expensive, idempotent
+// API responses (diagnose/optimize) keyed by a hash of the request body.
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+// newResponseCache creates a cache with the given TTL. A zero or negative
+// TTL disables caching: get always misses and set is a no-op.
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *responseCache) get(key string) ([]byte, bool) {
+	if c == nil || c.ttl <= 0 {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.body, true
+}
+
+func (c *responseCache) set(key string, body []byte) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{
+		body:      append([]byte(nil), body...),
+		expiresAt: time.Now().Add(c.ttl),
+	}
+}
+
+// cacheKey hashes a request body under a namespace so identical bodies
+// posted to different endpoints don't collide.
+func cacheKey(namespace string, body []byte) string {
+	sum := sha256.Sum256(body)
+	return namespace + ":" + hex.EncodeToString(sum[:])
+}
+
+// readCacheableBody reads the raw request body and restores it so
+// ShouldBindJSON can still consume it afterwards. When cache is disabled
+// (nil or zero TTL) it returns nil without touching the body, since no
+// caller needs the hash in that case.
+func readCacheableBody(c *gin.Context, cache *responseCache) ([]byte, error) {
+	if cache == nil || cache.ttl <= 0 {
+		return nil, nil
+	}
+	raw, err := c.GetRawData()
+	if err != nil {
+		return nil, err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(raw))
+	return raw, nil
+}
+
+// cacheAndRespond marshals v, stores it under key (a no-op when caching is
+// disabled), sets an X-Cache header, and writes the JSON response.
+func cacheAndRespond(c *gin.Context, cache *responseCache, key string, v any) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, err)
+		return
+	}
+	cache.set(key, payload)
+	c.Header("X-Cache", "MISS")
+	c.Data(http.StatusOK, "application/json; charset=utf-8", payload)
+}
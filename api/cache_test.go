@@ -0,0 +1,62 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResponseCache_GetSetTTL(t *testing.T) {
+	t.Parallel()
+
+	c := newResponseCache(50 * time.Millisecond)
+	key := cacheKey("diagnose", []byte(`{"a":1}`))
+
+	if _, ok := c.get(key); ok {
+		t.Fatalf("get: expected miss before set")
+	}
+
+	c.set(key, []byte(`{"ok":true}`))
+	body, ok := c.get(key)
+	if !ok {
+		t.Fatalf("get: expected hit after set")
+	}
+	if string(body) != `{"ok":true}` {
+		t.Fatalf("get: got %q", body)
+	}
+
+	time.Sleep(75 * time.Millisecond)
+	if _, ok := c.get(key); ok {
+		t.Fatalf("get: expected miss after TTL expiry")
+	}
+}
+
+func TestResponseCache_DisabledIsNoop(t *testing.T) {
+	t.Parallel()
+
+	c := newResponseCache(0)
+	key := cacheKey("optimize", []byte(`{}`))
+	c.set(key, []byte("x"))
+
+	if _, ok := c.get(key); ok {
+		t.Fatalf("get: expected disabled cache to never hit")
+	}
+}
+
+func TestResponseCache_NilReceiverIsSafe(t *testing.T) {
+	t.Parallel()
+
+	var c *responseCache
+	if _, ok := c.get("k"); ok {
+		t.Fatalf("get: expected miss on nil cache")
+	}
+	c.set("k", []byte("v")) // must not panic
+}
+
+func TestCacheKey_DifferentNamespacesDontCollide(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"prompt_content":"x"}`)
+	if cacheKey("diagnose", body) == cacheKey("optimize", body) {
+		t.Fatalf("cacheKey: expected different namespaces to produce different keys")
+	}
+}
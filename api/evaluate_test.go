@@ -0,0 +1,93 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/config"
+)
+
+func TestHandlers_EvaluateResponse_Passes(t *testing.T) {
+	s := &Server{
+		config: &config.Config{Evaluation: config.EvaluationConfig{Trials: 1, Threshold: 0.6, Concurrency: 1}},
+	}
+	r := newTestRouterForServer(t, s)
+
+	body := `{
+		"response": "hello world",
+		"expected": {"ExactMatch": "hello world", "Contains": ["hello"]},
+		"evaluators": [{"Type": "exact"}, {"Type": "contains"}]
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/api/evaluate", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d (body=%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var out struct {
+		Passed  bool    `json:"passed"`
+		Score   float64 `json:"score"`
+		Results []any   `json:"results"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&out); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !out.Passed {
+		t.Fatalf("expected passed=true, got %+v", out)
+	}
+	if out.Score != 1 {
+		t.Fatalf("expected score=1, got %v", out.Score)
+	}
+	if len(out.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(out.Results))
+	}
+}
+
+func TestHandlers_EvaluateResponse_Fails(t *testing.T) {
+	s := &Server{
+		config: &config.Config{Evaluation: config.EvaluationConfig{Trials: 1, Threshold: 0.6, Concurrency: 1}},
+	}
+	r := newTestRouterForServer(t, s)
+
+	body := `{"response": "goodbye", "expected": {"ExactMatch": "hello world"}, "evaluators": [{"Type": "exact"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/evaluate", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d (body=%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var out struct {
+		Passed bool `json:"passed"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&out); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if out.Passed {
+		t.Fatalf("expected passed=false, got true")
+	}
+}
+
+func TestHandlers_EvaluateResponse_BadJSON(t *testing.T) {
+	s := &Server{
+		config: &config.Config{Evaluation: config.EvaluationConfig{Trials: 1, Threshold: 0.6, Concurrency: 1}},
+	}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/evaluate", bytes.NewBufferString(`{`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/runner"
 	"github.com/stellarlinkco/ai-eval/internal/store"
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
+	"github.com/stellarlinkco/ai-eval/internal/version"
 	"gopkg.in/yaml.v3"
 )
 
@@ -31,12 +32,76 @@ const (
 	testsDir   = "tests"
 )
 
+// Bounds for the request-level overrides accepted by the diagnose/optimize
+// endpoints. Both run an LLM-backed loop (generation, evaluation, and/or
+// optimization) that can otherwise tie up a server goroutine indefinitely or
+// hammer the provider; these keep a misconfigured or malicious request from
+// doing either.
+const (
+	minAPIConcurrency = 1
+	maxAPIConcurrency = 32
+
+	minAPITimeout = time.Second
+	maxAPITimeout = 30 * time.Minute
+
+	minAPITrials = 1
+	maxAPITrials = 20
+)
+
+// resolveConcurrency returns override if non-nil, else def, validated
+// against [minAPIConcurrency, maxAPIConcurrency].
+func resolveConcurrency(override *int, def int) (int, error) {
+	if override != nil {
+		v := *override
+		if v < minAPIConcurrency || v > maxAPIConcurrency {
+			return 0, fmt.Errorf("concurrency must be between %d and %d (got %d)", minAPIConcurrency, maxAPIConcurrency, v)
+		}
+		return v, nil
+	}
+	if def <= 0 {
+		def = 1
+	}
+	return def, nil
+}
+
+// resolveTrials returns override if non-nil, else def, validated against
+// [minAPITrials, maxAPITrials].
+func resolveTrials(override *int, def int) (int, error) {
+	v := def
+	if override != nil {
+		v = *override
+	}
+	if v < minAPITrials || v > maxAPITrials {
+		return 0, fmt.Errorf("trials must be between %d and %d (got %d)", minAPITrials, maxAPITrials, v)
+	}
+	return v, nil
+}
+
+// resolveTimeout returns overrideMs (milliseconds) as a time.Duration if
+// non-nil, else def, validated against [minAPITimeout, maxAPITimeout].
+func resolveTimeout(overrideMs *int, def time.Duration) (time.Duration, error) {
+	d := def
+	if overrideMs != nil {
+		d = time.Duration(*overrideMs) * time.Millisecond
+	}
+	if d < minAPITimeout || d > maxAPITimeout {
+		return 0, fmt.Errorf("timeout_ms must be between %d and %d (got %d)", minAPITimeout.Milliseconds(), maxAPITimeout.Milliseconds(), d.Milliseconds())
+	}
+	return d, nil
+}
+
 type runRequest struct {
 	Prompt      string   `json:"prompt"`
 	All         bool     `json:"all"`
 	Trials      *int     `json:"trials,omitempty"`
 	Threshold   *float64 `json:"threshold,omitempty"`
 	Concurrency *int     `json:"concurrency,omitempty"`
+
+	// Context holds run-scoped values (e.g. current_date, tenant_id) merged
+	// into every case's Input before rendering, with a case's own Input
+	// taking precedence on key conflicts. Merged on top of
+	// evaluation.context from the server's config.
+	Context map[string]any `json:"context,omitempty"`
 }
 
 type compareRequest struct {
@@ -45,6 +110,56 @@ type compareRequest struct {
 	V2     string `json:"v2"`
 }
 
+type evaluateRequest struct {
+	Response   string                     `json:"response"`
+	Expected   testcase.Expected          `json:"expected"`
+	Evaluators []testcase.EvaluatorConfig `json:"evaluators"`
+}
+
+// loadPrompts and loadTests wrap prompt.LoadFromDir/testcase.LoadFromDir
+// with the server's per-directory LRU cache, so repeated requests against
+// the same prompts/tests directory skip re-parsing disk until it changes.
+// When config.LenientPromptLoading is set, loadPrompts skips files that fail
+// to parse instead of failing the whole load; see loadPromptsLenient.
+func (s *Server) loadPrompts(dir string) ([]*prompt.Prompt, error) {
+	if s.config != nil && s.config.LenientPromptLoading {
+		return s.promptCache.get(dir, s.loadPromptsLenient)
+	}
+	return s.promptCache.get(dir, prompt.LoadFromDir)
+}
+
+// loadPromptsLenient adapts prompt.LoadFromDirLenient to the loader
+// signature dirLoaderCache expects: it stashes any per-file parse errors on
+// s (surfaced by handleListPrompts via the X-Prompt-Load-Errors header) and
+// returns only the prompts that parsed successfully.
+func (s *Server) loadPromptsLenient(dir string) ([]*prompt.Prompt, error) {
+	prompts, loadErrs, err := prompt.LoadFromDirLenient(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s.promptLoadErrsMu.Lock()
+	s.promptLoadErrs = loadErrs
+	s.promptLoadErrsMu.Unlock()
+
+	for _, le := range loadErrs {
+		fmt.Fprintf(os.Stderr, "api: skipping unparseable prompt file: %v\n", le)
+	}
+	return prompts, nil
+}
+
+// promptLoadErrorCount returns how many files were skipped during the most
+// recent lenient prompt load.
+func (s *Server) promptLoadErrorCount() int {
+	s.promptLoadErrsMu.Lock()
+	defer s.promptLoadErrsMu.Unlock()
+	return len(s.promptLoadErrs)
+}
+
+func (s *Server) loadTests(dir string) ([]*testcase.TestSuite, error) {
+	return s.testCache.get(dir, testcase.LoadFromDir)
+}
+
 func (s *Server) handleHealth(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"status": "ok",
@@ -52,12 +167,32 @@ func (s *Server) handleHealth(c *gin.Context) {
 	})
 }
 
+func (s *Server) handleVersion(c *gin.Context) {
+	c.JSON(http.StatusOK, version.Get())
+}
+
+// listEvaluatorsResponse is the JSON shape for GET /api/evaluators.
+type listEvaluatorsResponse struct {
+	Types   []string          `json:"types"`
+	Aliases map[string]string `json:"aliases"`
+}
+
+func (s *Server) handleListEvaluators(c *gin.Context) {
+	c.JSON(http.StatusOK, listEvaluatorsResponse{
+		Types:   testcase.KnownEvaluatorTypes(),
+		Aliases: testcase.EvaluatorAliases(),
+	})
+}
+
 func (s *Server) handleListPrompts(c *gin.Context) {
-	prompts, err := prompt.LoadFromDir(promptsDir)
+	prompts, err := s.loadPrompts(promptsDir)
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, err)
 		return
 	}
+	if n := s.promptLoadErrorCount(); n > 0 {
+		c.Header("X-Prompt-Load-Errors", strconv.Itoa(n))
+	}
 	prompts = compactPrompts(prompts)
 
 	name := strings.TrimSpace(c.Query("name"))
@@ -85,7 +220,7 @@ func (s *Server) handleGetPrompt(c *gin.Context) {
 		return
 	}
 
-	prompts, err := prompt.LoadFromDir(promptsDir)
+	prompts, err := s.loadPrompts(promptsDir)
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, err)
 		return
@@ -139,6 +274,7 @@ func (s *Server) handleUpsertPrompt(c *gin.Context) {
 		respondError(c, http.StatusInternalServerError, err)
 		return
 	}
+	s.promptCache.invalidate(promptsDir)
 
 	c.JSON(http.StatusOK, p)
 }
@@ -165,12 +301,13 @@ func (s *Server) handleDeletePrompt(c *gin.Context) {
 		respondError(c, http.StatusInternalServerError, err)
 		return
 	}
+	s.promptCache.invalidate(promptsDir)
 
 	c.Status(http.StatusNoContent)
 }
 
 func (s *Server) handleListTests(c *gin.Context) {
-	suites, err := testcase.LoadFromDir(testsDir)
+	suites, err := s.loadTests(testsDir)
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, err)
 		return
@@ -202,7 +339,7 @@ func (s *Server) handleGetTestSuite(c *gin.Context) {
 		return
 	}
 
-	suites, err := testcase.LoadFromDir(testsDir)
+	suites, err := s.loadTests(testsDir)
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, err)
 		return
@@ -265,7 +402,7 @@ func (s *Server) handleStartRun(c *gin.Context) {
 		concurrency = 1
 	}
 
-	prompts, err := prompt.LoadFromDir(promptsDir)
+	prompts, err := s.loadPrompts(promptsDir)
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, err)
 		return
@@ -276,7 +413,7 @@ func (s *Server) handleStartRun(c *gin.Context) {
 		return
 	}
 
-	suites, err := testcase.LoadFromDir(testsDir)
+	suites, err := s.loadTests(testsDir)
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, err)
 		return
@@ -312,11 +449,17 @@ func (s *Server) handleStartRun(c *gin.Context) {
 	reg.Register(evaluator.RegexEvaluator{})
 	reg.Register(evaluator.JSONSchemaEvaluator{})
 
+	runContext := mergeRunContext(s.config.Evaluation.Context, req.Context)
+
 	r := runner.NewRunner(s.provider, reg, runner.Config{
-		Trials:        trials,
-		PassThreshold: threshold,
-		Concurrency:   concurrency,
-		Timeout:       s.config.Evaluation.Timeout,
+		Trials:              trials,
+		PassThreshold:       threshold,
+		Concurrency:         concurrency,
+		Timeout:             s.config.Evaluation.Timeout,
+		AdaptiveConcurrency: s.config.Evaluation.AdaptiveConcurrency,
+		MinConcurrency:      s.config.Evaluation.MinConcurrency,
+		MaxConcurrency:      s.config.Evaluation.MaxConcurrency,
+		Context:             runContext,
 	})
 
 	ctx := c.Request.Context()
@@ -341,7 +484,7 @@ func (s *Server) handleStartRun(c *gin.Context) {
 	finishedAt := time.Now().UTC()
 	_, summary := app.SummarizeRuns(runs)
 
-	runRecord, err := app.SaveRun(ctx, s.store, runs, summary, startedAt, finishedAt, s.buildRunConfig(promptNames, req.All, trials, threshold, concurrency))
+	runRecord, err := app.SaveRun(ctx, s.store, runs, summary, startedAt, finishedAt, s.buildRunConfig(promptNames, req.All, trials, threshold, concurrency, runContext), s.config.Evaluation.PersistResponses, s.config.Redactor())
 	if err != nil {
 		respondError(c, http.StatusInternalServerError, err)
 		return
@@ -446,9 +589,80 @@ func (s *Server) handleGetRunResults(c *gin.Context) {
 		return
 	}
 
+	if !includesResponses(c.Query("include")) {
+		for _, r := range results {
+			for i := range r.CaseResults {
+				r.CaseResults[i].Responses = nil
+				r.CaseResults[i].RenderedSystem = ""
+				r.CaseResults[i].RenderedUser = ""
+			}
+		}
+	}
+
 	c.JSON(http.StatusOK, results)
 }
 
+// includesResponses reports whether the comma-separated ?include= query
+// param requests raw response bodies and rendered prompts alongside scores.
+// Responses and rendered system/user content are stripped by default even
+// when persisted (see config.EvaluationConfig.PersistResponses) since they
+// can be large and aren't needed for the common case of just checking
+// pass/fail.
+func includesResponses(include string) bool {
+	for _, part := range strings.Split(include, ",") {
+		if strings.TrimSpace(part) == "responses" {
+			return true
+		}
+	}
+	return false
+}
+
+// handleEvaluateResponse scores an already-generated response against a
+// synthetic case built from the request's expected assertions and
+// evaluators, without rendering a prompt or generating a response. It
+// reuses runner.Runner.EvaluateResponse, the same scoring path RunCase
+// takes for each trial, so results here match what a real run would have
+// produced for the same response.
+func (s *Server) handleEvaluateResponse(c *gin.Context) {
+	if s == nil {
+		respondError(c, http.StatusInternalServerError, errors.New("server not initialized"))
+		return
+	}
+
+	var req evaluateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	tc := &testcase.TestCase{
+		ID:         "dry-evaluate",
+		Expected:   req.Expected,
+		Evaluators: req.Evaluators,
+	}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	reg.Register(evaluator.NotContainsEvaluator{})
+	reg.Register(evaluator.RegexEvaluator{})
+	reg.Register(evaluator.JSONSchemaEvaluator{})
+
+	r := runner.NewRunner(s.provider, reg, runner.Config{
+		Trials:      1,
+		Concurrency: 1,
+		Timeout:     s.config.Evaluation.Timeout,
+	})
+
+	results, passed, score := r.EvaluateResponse(c.Request.Context(), tc, req.Response)
+
+	c.JSON(http.StatusOK, gin.H{
+		"passed":  passed,
+		"score":   score,
+		"results": results,
+	})
+}
+
 func (s *Server) handleGetPromptHistory(c *gin.Context) {
 	if s == nil || s.store == nil {
 		respondError(c, http.StatusInternalServerError, errors.New("server not initialized"))
@@ -506,10 +720,53 @@ func (s *Server) handleCompareVersions(c *gin.Context) {
 	c.JSON(http.StatusOK, cmp)
 }
 
+func (s *Server) handleGetStats(c *gin.Context) {
+	if s == nil || s.store == nil {
+		respondError(c, http.StatusInternalServerError, errors.New("server not initialized"))
+		return
+	}
+
+	since, err := parseTimeParam(c.Query("since"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	until, err := parseTimeParam(c.Query("until"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	filter := store.StatsFilter{
+		PromptName:    strings.TrimSpace(c.Query("prompt")),
+		PromptVersion: strings.TrimSpace(c.Query("version")),
+		Since:         since,
+		Until:         until,
+		Bucket:        strings.TrimSpace(c.Query("bucket")),
+	}
+
+	buckets, err := s.store.AggregateStats(c.Request.Context(), filter)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, buckets)
+}
+
 type diagnoseRequest struct {
 	PromptContent  string `json:"prompt_content" binding:"required"`
 	TestsYAML      string `json:"tests_yaml" binding:"required"`
 	MaxSuggestions int    `json:"max_suggestions,omitempty"`
+
+	// Concurrency overrides evaluation.concurrency for this call; bounds in
+	// resolveConcurrency.
+	Concurrency *int `json:"concurrency,omitempty"`
+
+	// TimeoutMs overrides the default 10-minute bound on the whole
+	// eval-then-diagnose call; bounds in resolveTimeout.
+	TimeoutMs *int `json:"timeout_ms,omitempty"`
 }
 
 type diagnoseResponse struct {
@@ -523,6 +780,18 @@ func (s *Server) handleDiagnose(c *gin.Context) {
 		return
 	}
 
+	rawBody, err := readCacheableBody(c, s.respCache)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+	cacheKeyStr := cacheKey("diagnose", rawBody)
+	if cached, ok := s.respCache.get(cacheKeyStr); ok {
+		c.Header("X-Cache", "HIT")
+		c.Data(http.StatusOK, "application/json; charset=utf-8", cached)
+		return
+	}
+
 	var req diagnoseRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		respondError(c, http.StatusBadRequest, err)
@@ -578,9 +847,16 @@ func (s *Server) handleDiagnose(c *gin.Context) {
 		return
 	}
 
-	concurrency := s.config.Evaluation.Concurrency
-	if concurrency <= 0 {
-		concurrency = 1
+	concurrency, err := resolveConcurrency(req.Concurrency, s.config.Evaluation.Concurrency)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	timeout, err := resolveTimeout(req.TimeoutMs, 10*time.Minute)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
 	}
 
 	reg := evaluator.NewRegistry()
@@ -591,13 +867,16 @@ func (s *Server) handleDiagnose(c *gin.Context) {
 	reg.Register(evaluator.JSONSchemaEvaluator{})
 
 	r := runner.NewRunner(s.provider, reg, runner.Config{
-		Trials:        trials,
-		PassThreshold: threshold,
-		Concurrency:   concurrency,
-		Timeout:       s.config.Evaluation.Timeout,
+		Trials:              trials,
+		PassThreshold:       threshold,
+		Concurrency:         concurrency,
+		Timeout:             s.config.Evaluation.Timeout,
+		AdaptiveConcurrency: s.config.Evaluation.AdaptiveConcurrency,
+		MinConcurrency:      s.config.Evaluation.MinConcurrency,
+		MaxConcurrency:      s.config.Evaluation.MaxConcurrency,
 	})
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Minute)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
 	defer cancel()
 
 	results := make([]*runner.SuiteResult, 0, len(suites))
@@ -631,24 +910,64 @@ func (s *Server) handleDiagnose(c *gin.Context) {
 		})
 	}
 
-	c.JSON(http.StatusOK, resp)
+	cacheAndRespond(c, s.respCache, cacheKeyStr, resp)
+}
+
+type validateTestsRequest struct {
+	TestsYAML string `json:"tests_yaml" binding:"required"`
+}
+
+type validateTestsResponse struct {
+	Suites []*testcase.TestSuite `json:"suites"`
+}
+
+// handleValidateTests parses and validates raw test suite YAML without
+// writing anything to disk, so an editor UI can offer inline feedback while
+// a suite is still being drafted.
+func (s *Server) handleValidateTests(c *gin.Context) {
+	var req validateTestsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	testsYAML := strings.TrimSpace(req.TestsYAML)
+	if testsYAML == "" {
+		respondError(c, http.StatusBadRequest, errors.New("tests_yaml is required"))
+		return
+	}
+
+	suites, err := decodeTestSuitesFromYAML(testsYAML)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+	if len(suites) == 0 {
+		respondError(c, http.StatusBadRequest, errors.New("no test suites provided"))
+		return
+	}
+
+	c.JSON(http.StatusOK, validateTestsResponse{Suites: suites})
 }
 
 func decodeTestSuitesFromYAML(raw string) ([]*testcase.TestSuite, error) {
 	dec := yaml.NewDecoder(strings.NewReader(raw))
 	var out []*testcase.TestSuite
 
-	for {
+	for i := 0; ; i++ {
 		s := new(testcase.TestSuite)
 		err := dec.Decode(s)
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("invalid tests_yaml: %w", err)
+			return nil, fmt.Errorf("invalid tests_yaml: document %d: %w", i, err)
 		}
 		if err := testcase.Validate(s); err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid tests_yaml: document %d: %w", i, err)
+		}
+		if err := testcase.ValidateReferences(s); err != nil {
+			return nil, fmt.Errorf("invalid tests_yaml: document %d: %w", i, err)
 		}
 		out = append(out, s)
 	}
@@ -770,7 +1089,7 @@ func compactSuites(suites []*testcase.TestSuite) []*testcase.TestSuite {
 	return out
 }
 
-func (s *Server) buildRunConfig(promptNames []string, all bool, trials int, threshold float64, concurrency int) map[string]any {
+func (s *Server) buildRunConfig(promptNames []string, all bool, trials int, threshold float64, concurrency int, runContext map[string]any) map[string]any {
 	cfg := map[string]any{
 		"trials":      trials,
 		"threshold":   threshold,
@@ -783,22 +1102,62 @@ func (s *Server) buildRunConfig(promptNames []string, all bool, trials int, thre
 	if s != nil && s.config != nil && s.config.Evaluation.Timeout > 0 {
 		cfg["timeout_ms"] = s.config.Evaluation.Timeout.Milliseconds()
 	}
+	if len(runContext) > 0 {
+		cfg["context"] = runContext
+	}
 	return cfg
 }
 
+// mergeRunContext merges override on top of base, with override's keys
+// winning on conflict, mirroring runner.mergeContext's precedence. Returns
+// nil if both are empty.
+func mergeRunContext(base, override map[string]any) map[string]any {
+	if len(base) == 0 && len(override) == 0 {
+		return nil
+	}
+
+	merged := make(map[string]any, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		merged[k] = v
+	}
+	return merged
+}
+
 type optimizeRequest struct {
 	PromptContent string `json:"prompt_content" binding:"required"`
 	PromptName    string `json:"prompt_name"`
 	NumCases      int    `json:"num_cases"`
+
+	// Trials overrides the number of trials used to evaluate the generated
+	// suite before optimizing; bounds in resolveTrials.
+	Trials *int `json:"trials,omitempty"`
+
+	// Concurrency overrides the eval concurrency for that same run; bounds
+	// in resolveConcurrency.
+	Concurrency *int `json:"concurrency,omitempty"`
+
+	// TimeoutMs overrides the default 10-minute bound on the whole
+	// generate-eval-optimize call; bounds in resolveTimeout.
+	TimeoutMs *int `json:"timeout_ms,omitempty"`
+
+	// IncludeTestsYAML, when true, returns the generated test suite as a
+	// YAML string in the response so callers can save it for reuse with
+	// the run command.
+	IncludeTestsYAML bool `json:"include_tests_yaml,omitempty"`
 }
 
 type optimizeResponse struct {
 	Analysis        string               `json:"analysis"`
 	Suggestions     []string             `json:"suggestions"`
+	InferredSchema  map[string]any       `json:"inferred_schema,omitempty"`
 	EvalResults     *evalSummaryResponse `json:"eval_results"`
 	OptimizedPrompt string               `json:"optimized_prompt"`
 	Changes         []optimizer.Change   `json:"changes"`
 	Summary         string               `json:"optimization_summary"`
+	TestsYAML       string               `json:"tests_yaml,omitempty"`
 }
 
 type evalSummaryResponse struct {
@@ -815,6 +1174,18 @@ func (s *Server) handleOptimize(c *gin.Context) {
 		return
 	}
 
+	rawBody, err := readCacheableBody(c, s.respCache)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+	cacheKeyStr := cacheKey("optimize", rawBody)
+	if cached, ok := s.respCache.get(cacheKeyStr); ok {
+		c.Header("X-Cache", "HIT")
+		c.Data(http.StatusOK, "application/json; charset=utf-8", cached)
+		return
+	}
+
 	var req optimizeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		respondError(c, http.StatusBadRequest, err)
@@ -837,7 +1208,23 @@ func (s *Server) handleOptimize(c *gin.Context) {
 		numCases = 5
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Minute)
+	trials, err := resolveTrials(req.Trials, 1)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+	concurrency, err := resolveConcurrency(req.Concurrency, 1)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+	timeout, err := resolveTimeout(req.TimeoutMs, 10*time.Minute)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
 	defer cancel()
 
 	gen := &generator.Generator{Provider: s.provider}
@@ -858,17 +1245,29 @@ func (s *Server) handleOptimize(c *gin.Context) {
 
 	registry := evaluator.NewRegistry()
 	r := runner.NewRunner(s.provider, registry, runner.Config{
-		Trials:        1,
-		Concurrency:   1,
+		Trials:        trials,
+		Concurrency:   concurrency,
 		PassThreshold: 0.6,
 		Timeout:       2 * time.Minute,
 	})
 
 	suiteResult, _ := r.RunSuite(ctx, p, genResult.Suite)
 
+	var testsYAML string
+	if req.IncludeTestsYAML {
+		b, err := yaml.Marshal(genResult.Suite)
+		if err != nil {
+			respondError(c, http.StatusInternalServerError, fmt.Errorf("failed to marshal test suite: %w", err))
+			return
+		}
+		testsYAML = string(b)
+	}
+
 	response := &optimizeResponse{
-		Analysis:    genResult.Analysis,
-		Suggestions: genResult.Suggestions,
+		Analysis:       genResult.Analysis,
+		Suggestions:    genResult.Suggestions,
+		InferredSchema: genResult.InferredSchema,
+		TestsYAML:      testsYAML,
 		EvalResults: &evalSummaryResponse{
 			PassRate:   suiteResult.PassRate,
 			AvgScore:   suiteResult.AvgScore,
@@ -881,7 +1280,7 @@ func (s *Server) handleOptimize(c *gin.Context) {
 	if suiteResult.PassRate >= 0.9 && suiteResult.AvgScore >= 0.9 {
 		response.OptimizedPrompt = promptContent
 		response.Summary = "Prompt is already performing well. No optimization needed."
-		c.JSON(http.StatusOK, response)
+		cacheAndRespond(c, s.respCache, cacheKeyStr, response)
 		return
 	}
 
@@ -900,5 +1299,5 @@ func (s *Server) handleOptimize(c *gin.Context) {
 	response.Changes = optResult.Changes
 	response.Summary = optResult.Summary
 
-	c.JSON(http.StatusOK, response)
+	cacheAndRespond(c, s.respCache, cacheKeyStr, response)
 }
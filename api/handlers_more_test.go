@@ -69,6 +69,54 @@ func TestHandlers_ListPrompts_FilterByName(t *testing.T) {
 	}
 }
 
+func TestHandlers_ListPrompts_LenientSkipsBadFile(t *testing.T) {
+	setupAPITestWorkspace(t)
+
+	if err := os.WriteFile(filepath.Join(promptsDir, "broken.yaml"), []byte(":\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile broken prompt: %v", err)
+	}
+
+	s := &Server{config: &config.Config{LenientPromptLoading: true}}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/prompts", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+	if got := rec.Header().Get("X-Prompt-Load-Errors"); got != "1" {
+		t.Fatalf("X-Prompt-Load-Errors: got %q want %q", got, "1")
+	}
+
+	var out []prompt.Prompt
+	if err := json.NewDecoder(rec.Body).Decode(&out); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(out) != 1 || out[0].Name != "example" {
+		t.Fatalf("prompts: got %#v", out)
+	}
+}
+
+func TestHandlers_ListPrompts_StrictFailsOnBadFile(t *testing.T) {
+	setupAPITestWorkspace(t)
+
+	if err := os.WriteFile(filepath.Join(promptsDir, "broken.yaml"), []byte(":\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile broken prompt: %v", err)
+	}
+
+	r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/prompts", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
 func TestHandlers_ListPrompts_Returns500WhenDirMissing(t *testing.T) {
 	dir := t.TempDir()
 	cwd, err := os.Getwd()
@@ -831,6 +879,66 @@ func TestHandlers_GetRunResults_Success(t *testing.T) {
 	}
 }
 
+func TestHandlers_GetRunResults_StripsResponsesByDefault(t *testing.T) {
+	st := &fakeStore{
+		GetRunFunc: func(ctx context.Context, id string) (*store.RunRecord, error) {
+			return &store.RunRecord{ID: id}, nil
+		},
+		GetSuiteResultsFunc: func(ctx context.Context, runID string) ([]*store.SuiteRecord, error) {
+			return []*store.SuiteRecord{{
+				RunID: runID,
+				CaseResults: []store.CaseRecord{{
+					CaseID:    "c1",
+					Responses: []store.TrialResponseRecord{{TrialNum: 1, Response: "hi"}},
+				}},
+			}}, nil
+		},
+	}
+	s := &Server{store: st}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/runs/r1/results", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+	if strings.Contains(rec.Body.String(), "\"hi\"") {
+		t.Fatalf("body: expected responses stripped, got %s", rec.Body.String())
+	}
+}
+
+func TestHandlers_GetRunResults_IncludeResponses(t *testing.T) {
+	st := &fakeStore{
+		GetRunFunc: func(ctx context.Context, id string) (*store.RunRecord, error) {
+			return &store.RunRecord{ID: id}, nil
+		},
+		GetSuiteResultsFunc: func(ctx context.Context, runID string) ([]*store.SuiteRecord, error) {
+			return []*store.SuiteRecord{{
+				RunID: runID,
+				CaseResults: []store.CaseRecord{{
+					CaseID:    "c1",
+					Responses: []store.TrialResponseRecord{{TrialNum: 1, Response: "hi"}},
+				}},
+			}}, nil
+		},
+	}
+	s := &Server{store: st}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/runs/r1/results?include=responses", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+	if !strings.Contains(rec.Body.String(), "\"hi\"") {
+		t.Fatalf("body: expected responses included, got %s", rec.Body.String())
+	}
+}
+
 func TestHandlers_GetPromptHistory_Success(t *testing.T) {
 	st := &fakeStore{
 		GetPromptHistoryFunc: func(ctx context.Context, promptName string, limit int) ([]*store.SuiteRecord, error) {
@@ -1164,8 +1272,31 @@ func TestCompactHelpersAndBuildRunConfig(t *testing.T) {
 	}
 
 	s := &Server{config: &config.Config{Evaluation: config.EvaluationConfig{Timeout: 123 * time.Millisecond}}}
-	cfg := s.buildRunConfig([]string{"p1"}, false, 1, 0.6, 2)
+	cfg := s.buildRunConfig([]string{"p1"}, false, 1, 0.6, 2, nil)
 	if cfg["timeout_ms"] != int64(123) {
 		t.Fatalf("timeout_ms: got %v want %v", cfg["timeout_ms"], int64(123))
 	}
+	if _, ok := cfg["context"]; ok {
+		t.Fatalf("expected no context key when unset, got %#v", cfg)
+	}
+
+	withContext := s.buildRunConfig([]string{"p1"}, false, 1, 0.6, 2, map[string]any{"tenant_id": "acme"})
+	got, ok := withContext["context"].(map[string]any)
+	if !ok || got["tenant_id"] != "acme" {
+		t.Fatalf("context: got %#v", withContext["context"])
+	}
+}
+
+func TestMergeRunContext(t *testing.T) {
+	if got := mergeRunContext(nil, nil); got != nil {
+		t.Fatalf("both empty: got %#v want nil", got)
+	}
+
+	got := mergeRunContext(map[string]any{"tenant_id": "acme", "region": "us"}, map[string]any{"tenant_id": "other"})
+	if got["tenant_id"] != "other" {
+		t.Fatalf("override should win: got %#v", got["tenant_id"])
+	}
+	if got["region"] != "us" {
+		t.Fatalf("base value should survive: got %#v", got["region"])
+	}
 }
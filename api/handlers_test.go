@@ -11,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
+	"github.com/stellarlinkco/ai-eval/internal/version"
 )
 
 func setupAPITestWorkspace(t *testing.T) {
@@ -85,6 +86,51 @@ func TestHandlers_Health(t *testing.T) {
 	}
 }
 
+func TestHandlers_Version(t *testing.T) {
+	setupAPITestWorkspace(t)
+	r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/version", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+
+	var body version.Info
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if body.GoVersion == "" {
+		t.Fatalf("GoVersion: got empty")
+	}
+}
+
+func TestHandlers_ListEvaluators(t *testing.T) {
+	setupAPITestWorkspace(t)
+	r := newTestRouter(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/evaluators", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+
+	var body listEvaluatorsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(body.Types) == 0 {
+		t.Fatalf("Types: got empty")
+	}
+	if body.Aliases["judge"] != "llm_judge" {
+		t.Fatalf("Aliases[judge]: got %q want llm_judge", body.Aliases["judge"])
+	}
+}
+
 func TestHandlers_ListPrompts(t *testing.T) {
 	setupAPITestWorkspace(t)
 	r := newTestRouter(t)
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"container/list"
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultLoaderCacheSize bounds the number of directories a dirLoaderCache
+// keeps parsed at once. Deployments only ever load a handful of distinct
+// prompts/tests directories, so this is generous headroom, not a tuning
+// knob most users need to touch.
+const defaultLoaderCacheSize = 32
+
+// dirLoaderCache is a small, bounded LRU cache in front of a
+// directory-scanning loader (prompt.LoadFromDir, testcase.LoadFromDir).
+// API handlers reload prompts/tests from disk on every request; caching by
+// directory mtime lets repeated requests skip the re-parse while still
+// picking up on-disk edits made outside the API (hand edits, another
+// process). invalidate additionally lets the upsert/delete handlers evict a
+// directory immediately, so a write is visible on the very next read even
+// if it lands within the filesystem's mtime granularity.
+type dirLoaderCache[T any] struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+
+	// stat is a seam for tests; defaults to os.Stat.
+	stat func(string) (os.FileInfo, error)
+}
+
+type loaderCacheEntry[T any] struct {
+	dir   string
+	mtime time.Time
+	value T
+}
+
+func newDirLoaderCache[T any](capacity int) *dirLoaderCache[T] {
+	if capacity <= 0 {
+		capacity = defaultLoaderCacheSize
+	}
+	return &dirLoaderCache[T]{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+		stat:     os.Stat,
+	}
+}
+
+// get returns the cached value for dir if its mtime matches the last load,
+// otherwise it calls load, caches the result (keyed by dir's current
+// mtime), and returns that. Directories that can't be stat'd (e.g. don't
+// exist yet) bypass the cache entirely so load's error reaches the caller
+// unchanged.
+func (c *dirLoaderCache[T]) get(dir string, load func(string) (T, error)) (T, error) {
+	if c == nil {
+		return load(dir)
+	}
+
+	fi, statErr := c.stat(dir)
+
+	if statErr == nil {
+		if value, ok := c.lookup(dir, fi.ModTime()); ok {
+			return value, nil
+		}
+	}
+
+	value, err := load(dir)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if statErr == nil {
+		c.put(dir, fi.ModTime(), value)
+	}
+	return value, nil
+}
+
+func (c *dirLoaderCache[T]) lookup(dir string, mtime time.Time) (T, bool) {
+	var zero T
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[dir]
+	if !ok {
+		return zero, false
+	}
+	entry := el.Value.(*loaderCacheEntry[T])
+	if !entry.mtime.Equal(mtime) {
+		return zero, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+func (c *dirLoaderCache[T]) put(dir string, mtime time.Time, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[dir]; ok {
+		entry := el.Value.(*loaderCacheEntry[T])
+		entry.mtime = mtime
+		entry.value = value
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	el := c.ll.PushFront(&loaderCacheEntry[T]{dir: dir, mtime: mtime, value: value})
+	c.items[dir] = el
+
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*loaderCacheEntry[T]).dir)
+	}
+}
+
+// invalidate drops any cached entry for dir, forcing the next get to reload
+// from disk regardless of mtime.
+func (c *dirLoaderCache[T]) invalidate(dir string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[dir]; ok {
+		c.ll.Remove(el)
+		delete(c.items, dir)
+	}
+}
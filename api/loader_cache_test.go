@@ -0,0 +1,153 @@
+package api
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func fakeStat(mtime time.Time, err error) func(string) (os.FileInfo, error) {
+	return func(string) (os.FileInfo, error) {
+		if err != nil {
+			return nil, err
+		}
+		return fakeFileInfo{mtime: mtime}, nil
+	}
+}
+
+type fakeFileInfo struct {
+	os.FileInfo
+	mtime time.Time
+}
+
+func (f fakeFileInfo) ModTime() time.Time { return f.mtime }
+
+func TestDirLoaderCache_ReusesUntilMtimeChanges(t *testing.T) {
+	t.Parallel()
+
+	c := newDirLoaderCache[[]string](0)
+	mtime := time.Now()
+	c.stat = fakeStat(mtime, nil)
+
+	calls := 0
+	load := func(string) ([]string, error) {
+		calls++
+		return []string{"a", "b"}, nil
+	}
+
+	got, err := c.get("dir", load)
+	if err != nil || calls != 1 || len(got) != 2 {
+		t.Fatalf("get(first): got=%v calls=%d err=%v", got, calls, err)
+	}
+
+	got, err = c.get("dir", load)
+	if err != nil || calls != 1 {
+		t.Fatalf("get(cached): expected no reload, calls=%d err=%v", calls, err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("get(cached): got=%v", got)
+	}
+
+	c.stat = fakeStat(mtime.Add(time.Second), nil)
+	if _, err := c.get("dir", load); err != nil || calls != 2 {
+		t.Fatalf("get(after mtime change): expected reload, calls=%d err=%v", calls, err)
+	}
+}
+
+func TestDirLoaderCache_InvalidateForcesReload(t *testing.T) {
+	t.Parallel()
+
+	c := newDirLoaderCache[[]string](0)
+	mtime := time.Now()
+	c.stat = fakeStat(mtime, nil)
+
+	calls := 0
+	load := func(string) ([]string, error) {
+		calls++
+		return []string{"a"}, nil
+	}
+
+	if _, err := c.get("dir", load); err != nil || calls != 1 {
+		t.Fatalf("get(first): calls=%d err=%v", calls, err)
+	}
+	c.invalidate("dir")
+	if _, err := c.get("dir", load); err != nil || calls != 2 {
+		t.Fatalf("get(after invalidate): expected reload, calls=%d err=%v", calls, err)
+	}
+}
+
+func TestDirLoaderCache_StatErrorBypassesCache(t *testing.T) {
+	t.Parallel()
+
+	c := newDirLoaderCache[[]string](0)
+	c.stat = fakeStat(time.Time{}, errors.New("stat failed"))
+
+	calls := 0
+	load := func(string) ([]string, error) {
+		calls++
+		return []string{"a"}, nil
+	}
+
+	if _, err := c.get("dir", load); err != nil || calls != 1 {
+		t.Fatalf("get(1): calls=%d err=%v", calls, err)
+	}
+	if _, err := c.get("dir", load); err != nil || calls != 2 {
+		t.Fatalf("get(2): expected reload since stat keeps failing, calls=%d err=%v", calls, err)
+	}
+}
+
+func TestDirLoaderCache_LoadErrorNotCached(t *testing.T) {
+	t.Parallel()
+
+	c := newDirLoaderCache[[]string](0)
+	c.stat = fakeStat(time.Now(), nil)
+
+	wantErr := errors.New("boom")
+	if _, err := c.get("dir", func(string) ([]string, error) { return nil, wantErr }); !errors.Is(err, wantErr) {
+		t.Fatalf("get: expected load error, got %v", err)
+	}
+
+	got, err := c.get("dir", func(string) ([]string, error) { return []string{"ok"}, nil })
+	if err != nil || len(got) != 1 || got[0] != "ok" {
+		t.Fatalf("get(after failed load): got=%v err=%v", got, err)
+	}
+}
+
+func TestDirLoaderCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	c := newDirLoaderCache[[]string](2)
+	c.stat = fakeStat(time.Now(), nil)
+
+	load := func(v string) func(string) ([]string, error) {
+		return func(string) ([]string, error) { return []string{v}, nil }
+	}
+
+	if _, err := c.get("a", load("a")); err != nil {
+		t.Fatalf("get(a): %v", err)
+	}
+	if _, err := c.get("b", load("b")); err != nil {
+		t.Fatalf("get(b): %v", err)
+	}
+	// Touch "a" so "b" becomes the least recently used entry.
+	if _, err := c.get("a", load("a")); err != nil {
+		t.Fatalf("get(a again): %v", err)
+	}
+	if _, err := c.get("c", load("c")); err != nil {
+		t.Fatalf("get(c): %v", err)
+	}
+
+	if len(c.items) != 2 {
+		t.Fatalf("expected capacity-bounded cache, got %d entries", len(c.items))
+	}
+	if _, ok := c.items["b"]; ok {
+		t.Fatalf("expected \"b\" to be evicted as least recently used")
+	}
+	if _, ok := c.items["a"]; !ok {
+		t.Fatalf("expected \"a\" to survive eviction")
+	}
+	if _, ok := c.items["c"]; !ok {
+		t.Fatalf("expected \"c\" to survive eviction")
+	}
+}
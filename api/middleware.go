@@ -1,18 +1,21 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stellarlinkco/ai-eval/internal/redact"
 )
 
 func (s *Server) registerMiddleware() {
 	if s == nil || s.router == nil {
 		return
 	}
-	s.router.Use(requestLoggingMiddleware(), recoveryMiddleware(), corsMiddleware())
+	s.router.Use(requestLoggingMiddleware(s.config.Redactor()), recoveryMiddleware(), corsMiddleware())
 }
 
 func corsMiddleware() gin.HandlerFunc {
@@ -72,8 +75,51 @@ func corsMiddleware() gin.HandlerFunc {
 	}
 }
 
-func requestLoggingMiddleware() gin.HandlerFunc {
-	return gin.Logger()
+// requestLoggingMiddleware logs requests in gin's default format, except
+// query parameters whose key looks like a credential (api_key, token,
+// secret, ...) are masked so they never reach request logs.
+func requestLoggingMiddleware(redactor *redact.Redactor) gin.HandlerFunc {
+	if redactor == nil {
+		redactor = redact.Default()
+	}
+	return gin.LoggerWithConfig(gin.LoggerConfig{
+		Formatter: func(p gin.LogFormatterParams) string {
+			// p.Path already has the unredacted raw query appended by
+			// gin's LoggerWithConfig; rebuild from the request URL's
+			// bare path so the credential-bearing query never reaches
+			// the log unmasked.
+			path := p.Request.URL.Path
+			if q := redactRawQuery(redactor, p.Request.URL.RawQuery); q != "" {
+				path += "?" + q
+			}
+			return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %s\n",
+				p.TimeStamp.Format("2006/01/02 - 15:04:05"),
+				p.StatusCode,
+				p.Latency,
+				p.ClientIP,
+				p.Method,
+				path,
+			)
+		},
+	})
+}
+
+// redactRawQuery masks the value of every query parameter whose key matches
+// redactor's secret patterns, returning the re-encoded query string.
+func redactRawQuery(redactor *redact.Redactor, raw string) string {
+	if raw == "" {
+		return ""
+	}
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return raw
+	}
+	for key := range values {
+		if redactor.KeyMatches(key) {
+			values.Set(key, redact.Mask)
+		}
+	}
+	return values.Encode()
 }
 
 func recoveryMiddleware() gin.HandlerFunc {
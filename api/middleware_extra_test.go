@@ -1,11 +1,16 @@
 package api
 
 import (
+	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/redact"
 )
 
 func TestRegisterMiddleware_NilSafe(t *testing.T) {
@@ -110,3 +115,66 @@ func TestAPIKeyAuthMiddleware_OptionsBypass(t *testing.T) {
 		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
 	}
 }
+
+func TestRedactRawQuery_MasksMatchingKeys(t *testing.T) {
+	redactor := redact.Default()
+
+	got := redactRawQuery(redactor, "api_key=sk-abc123&q=hello")
+	values, err := url.ParseQuery(got)
+	if err != nil {
+		t.Fatalf("ParseQuery: %v", err)
+	}
+	if values.Get("api_key") != redact.Mask {
+		t.Fatalf("api_key: got %q want masked", values.Get("api_key"))
+	}
+	if values.Get("q") != "hello" {
+		t.Fatalf("q: got %q want unchanged", values.Get("q"))
+	}
+}
+
+func TestRequestLoggingMiddleware_NeverLogsRawSecretQuery(t *testing.T) {
+	var buf bytes.Buffer
+	oldWriter := gin.DefaultWriter
+	gin.DefaultWriter = &buf
+	t.Cleanup(func() { gin.DefaultWriter = oldWriter })
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(requestLoggingMiddleware(redact.Default()))
+	r.GET("/x", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+
+	req := httptest.NewRequest(http.MethodGet, "/x?api_key=sk-secret&q=hello", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	logged := buf.String()
+	if strings.Contains(logged, "sk-secret") {
+		t.Fatalf("request log leaked the raw secret: %q", logged)
+	}
+	if !strings.Contains(logged, url.QueryEscape(redact.Mask)) {
+		t.Fatalf("request log missing masked value: %q", logged)
+	}
+	if strings.Count(logged, "?") != 1 {
+		t.Fatalf("request log should append the query string exactly once: %q", logged)
+	}
+}
+
+func TestRegisterMiddleware_UsesConfiguredRedactionPatterns(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	s := &Server{
+		router: r,
+		config: &config.Config{Redaction: config.RedactionConfig{KeyPatterns: []string{"internal_id"}}},
+	}
+	s.registerMiddleware()
+
+	r.GET("/x", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+
+	req := httptest.NewRequest(http.MethodGet, "/x?internal_id=abc123", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/stellarlinkco/ai-eval/internal/llm"
@@ -278,3 +279,200 @@ func TestHandleDiagnose_AdvisorError(t *testing.T) {
 		t.Fatalf("status: got %d want %d", rec.Code, http.StatusInternalServerError)
 	}
 }
+
+func TestHandleDiagnose_CacheHit(t *testing.T) {
+	calls := 0
+	p := &fakeProvider{
+		CompleteFunc: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+			calls++
+			return &llm.Response{Content: []llm.ContentBlock{{Type: "text", Text: `{
+  "failure_patterns": [],
+  "root_causes": [],
+  "suggestions": []
+}`}}}, nil
+		},
+		CompleteWithToolsFunc: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			return &llm.EvalResult{TextContent: "ok", InputTokens: 1, OutputTokens: 1}, nil
+		},
+	}
+
+	cfg := &config.Config{
+		Evaluation: config.EvaluationConfig{Threshold: 0.6},
+		APICache:   config.APICacheConfig{Enabled: true, TTL: time.Minute},
+	}
+	s := &Server{provider: p, config: cfg, respCache: newResponseCache(time.Minute)}
+	r := newTestRouterForServer(t, s)
+
+	testsYAML := "suite: s\nprompt: p\ncases:\n  - id: c1\n    input:\n      text: hi\n    expected:\n      contains:\n        - ok\n"
+	body, err := json.Marshal(map[string]any{
+		"prompt_content": "x",
+		"tests_yaml":     testsYAML,
+	})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	req1 := httptest.NewRequest(http.MethodPost, "/api/diagnose", bytes.NewReader(body))
+	req1.Header.Set("Content-Type", "application/json")
+	rec1 := httptest.NewRecorder()
+	r.ServeHTTP(rec1, req1)
+	if rec1.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec1.Code, http.StatusOK)
+	}
+	if got := rec1.Header().Get("X-Cache"); got != "MISS" {
+		t.Fatalf("X-Cache: got %q want %q", got, "MISS")
+	}
+
+	req2 := httptest.NewRequest(http.MethodPost, "/api/diagnose", bytes.NewReader(body))
+	req2.Header.Set("Content-Type", "application/json")
+	rec2 := httptest.NewRecorder()
+	r.ServeHTTP(rec2, req2)
+	if rec2.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec2.Code, http.StatusOK)
+	}
+	if got := rec2.Header().Get("X-Cache"); got != "HIT" {
+		t.Fatalf("X-Cache: got %q want %q", got, "HIT")
+	}
+	if rec2.Body.String() != rec1.Body.String() {
+		t.Fatalf("cached body mismatch: got %q want %q", rec2.Body.String(), rec1.Body.String())
+	}
+	if calls != 1 {
+		t.Fatalf("provider Complete calls: got %d want %d (second request should be served from cache)", calls, 1)
+	}
+}
+
+func TestHandleDiagnose_InvalidConcurrency(t *testing.T) {
+	testsYAML := "suite: s\nprompt: p\ncases:\n  - id: c1\n    input:\n      text: hi\n    expected:\n      contains:\n        - ok\n"
+
+	for _, concurrency := range []int{0, 100} {
+		concurrency := concurrency
+		t.Run("", func(t *testing.T) {
+			s := &Server{provider: &fakeProvider{}, config: &config.Config{Evaluation: config.EvaluationConfig{Threshold: 0.6}}}
+			r := newTestRouterForServer(t, s)
+
+			body, err := json.Marshal(map[string]any{
+				"prompt_content": "x",
+				"tests_yaml":     testsYAML,
+				"concurrency":    concurrency,
+			})
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/diagnose", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("concurrency=%d: status: got %d want %d", concurrency, rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestHandleDiagnose_InvalidTimeoutMs(t *testing.T) {
+	testsYAML := "suite: s\nprompt: p\ncases:\n  - id: c1\n    input:\n      text: hi\n    expected:\n      contains:\n        - ok\n"
+
+	for _, timeoutMs := range []int{500, 999999999} {
+		timeoutMs := timeoutMs
+		t.Run("", func(t *testing.T) {
+			s := &Server{provider: &fakeProvider{}, config: &config.Config{Evaluation: config.EvaluationConfig{Threshold: 0.6}}}
+			r := newTestRouterForServer(t, s)
+
+			body, err := json.Marshal(map[string]any{
+				"prompt_content": "x",
+				"tests_yaml":     testsYAML,
+				"timeout_ms":     timeoutMs,
+			})
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/diagnose", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("timeout_ms=%d: status: got %d want %d", timeoutMs, rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestHandleOptimize_InvalidTrials(t *testing.T) {
+	for _, trials := range []int{0, 50} {
+		trials := trials
+		t.Run("", func(t *testing.T) {
+			s := &Server{provider: &fakeProvider{}}
+			r := newTestRouterForServer(t, s)
+
+			body, err := json.Marshal(map[string]any{
+				"prompt_content": "x",
+				"trials":         trials,
+			})
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/optimize", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("trials=%d: status: got %d want %d", trials, rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestHandleOptimize_InvalidConcurrency(t *testing.T) {
+	for _, concurrency := range []int{0, 100} {
+		concurrency := concurrency
+		t.Run("", func(t *testing.T) {
+			s := &Server{provider: &fakeProvider{}}
+			r := newTestRouterForServer(t, s)
+
+			body, err := json.Marshal(map[string]any{
+				"prompt_content": "x",
+				"concurrency":    concurrency,
+			})
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/optimize", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("concurrency=%d: status: got %d want %d", concurrency, rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestHandleOptimize_InvalidTimeoutMs(t *testing.T) {
+	for _, timeoutMs := range []int{500, 999999999} {
+		timeoutMs := timeoutMs
+		t.Run("", func(t *testing.T) {
+			s := &Server{provider: &fakeProvider{}}
+			r := newTestRouterForServer(t, s)
+
+			body, err := json.Marshal(map[string]any{
+				"prompt_content": "x",
+				"timeout_ms":     timeoutMs,
+			})
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/optimize", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("timeout_ms=%d: status: got %d want %d", timeoutMs, rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
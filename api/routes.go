@@ -22,12 +22,16 @@ func (s *Server) registerRoutes() error {
 	}
 
 	api.GET("/health", s.handleHealth)
+	api.GET("/version", s.handleVersion)
 	api.GET("/prompts", s.handleListPrompts)
 	api.GET("/prompts/:name", s.handleGetPrompt)
 	api.POST("/prompts", s.handleUpsertPrompt)
 	api.DELETE("/prompts/:name", s.handleDeletePrompt)
 
+	api.GET("/evaluators", s.handleListEvaluators)
+
 	api.GET("/tests", s.handleListTests)
+	api.POST("/tests/validate", s.handleValidateTests)
 	api.GET("/tests/:suite", s.handleGetTestSuite)
 
 	api.POST("/runs", s.handleStartRun)
@@ -35,12 +39,19 @@ func (s *Server) registerRoutes() error {
 	api.GET("/runs/:id", s.handleGetRun)
 	api.GET("/runs/:id/results", s.handleGetRunResults)
 
+	// Evaluate endpoint - score an existing response without generating one
+	api.POST("/evaluate", s.handleEvaluateResponse)
+
 	api.GET("/history/:prompt", s.handleGetPromptHistory)
 	api.POST("/compare", s.handleCompareVersions)
+	api.GET("/stats", s.handleGetStats)
 
 	api.GET("/leaderboard", s.handleGetLeaderboard)
 	api.GET("/leaderboard/history", s.handleGetModelHistory)
 
+	api.POST("/benchmark", s.handleStartBenchmark)
+	api.GET("/benchmark/:id", s.handleGetBenchmarkJob)
+
 	// Optimize endpoint - auto evaluate and optimize prompt
 	api.POST("/optimize", s.handleOptimize)
 
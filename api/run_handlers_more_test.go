@@ -183,6 +183,68 @@ func TestHandlers_GetRunResults_GetSuiteResultsError(t *testing.T) {
 	}
 }
 
+func TestHandlers_GetRunResults_StripsRenderedContentByDefault(t *testing.T) {
+	st := &fakeStore{
+		GetRunFunc: func(ctx context.Context, id string) (*store.RunRecord, error) {
+			return &store.RunRecord{ID: id}, nil
+		},
+		GetSuiteResultsFunc: func(ctx context.Context, runID string) ([]*store.SuiteRecord, error) {
+			return []*store.SuiteRecord{{
+				CaseResults: []store.CaseRecord{{
+					CaseID:         "c1",
+					Responses:      []store.TrialResponseRecord{{TrialNum: 1, Response: "hi"}},
+					RenderedSystem: "sys",
+					RenderedUser:   "usr",
+				}},
+			}}, nil
+		},
+	}
+	s := &Server{store: st}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/runs/r1/results", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+	if bytes.Contains(rec.Body.Bytes(), []byte("rendered_system")) || bytes.Contains(rec.Body.Bytes(), []byte("responses")) {
+		t.Fatalf("expected responses/rendered content stripped, got %s", rec.Body.String())
+	}
+}
+
+func TestHandlers_GetRunResults_IncludesRenderedContentWhenRequested(t *testing.T) {
+	st := &fakeStore{
+		GetRunFunc: func(ctx context.Context, id string) (*store.RunRecord, error) {
+			return &store.RunRecord{ID: id}, nil
+		},
+		GetSuiteResultsFunc: func(ctx context.Context, runID string) ([]*store.SuiteRecord, error) {
+			return []*store.SuiteRecord{{
+				CaseResults: []store.CaseRecord{{
+					CaseID:         "c1",
+					Responses:      []store.TrialResponseRecord{{TrialNum: 1, Response: "hi"}},
+					RenderedSystem: "sys",
+					RenderedUser:   "usr",
+				}},
+			}}, nil
+		},
+	}
+	s := &Server{store: st}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/runs/r1/results?include=responses", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`"rendered_system":"sys"`)) {
+		t.Fatalf("expected rendered_system in response, got %s", rec.Body.String())
+	}
+}
+
 func TestHandlers_GetPromptHistory_MissingPromptName(t *testing.T) {
 	s := &Server{store: &fakeStore{}}
 	r := newTestRouterForServer(t, s)
@@ -300,3 +362,68 @@ func TestHandlers_CompareVersions_NotInitialized(t *testing.T) {
 		t.Fatalf("status: got %d want %d", rec.Code, http.StatusInternalServerError)
 	}
 }
+
+func TestHandlers_GetStats_NotInitialized(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/stats", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestHandlers_GetStats_InvalidSince(t *testing.T) {
+	s := &Server{store: &fakeStore{}}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/stats?since=wat", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlers_GetStats_StoreError(t *testing.T) {
+	st := &fakeStore{
+		AggregateStatsFunc: func(ctx context.Context, filter store.StatsFilter) ([]store.StatsBucket, error) {
+			return nil, errors.New("unsupported stats bucket")
+		},
+	}
+	s := &Server{store: st}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/stats?bucket=week", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandlers_GetStats_OK(t *testing.T) {
+	st := &fakeStore{
+		AggregateStatsFunc: func(ctx context.Context, filter store.StatsFilter) ([]store.StatsBucket, error) {
+			if filter.PromptName != "p1" {
+				t.Fatalf("PromptName: got %q", filter.PromptName)
+			}
+			return []store.StatsBucket{{Bucket: "2026-02-01", PassRate: 0.5, AvgScore: 0.6, TotalRuns: 2}}, nil
+		},
+	}
+	s := &Server{store: st}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/stats?prompt=p1", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusOK)
+	}
+}
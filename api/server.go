@@ -3,30 +3,51 @@ package api
 import (
 	"errors"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/stellarlinkco/ai-eval/internal/leaderboard"
 	"github.com/stellarlinkco/ai-eval/internal/llm"
+	"github.com/stellarlinkco/ai-eval/internal/prompt"
 	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
+const defaultAPICacheTTL = 10 * time.Minute
+
 type Server struct {
-	router   *gin.Engine
-	store    store.Store
-	provider llm.Provider
-	config   *config.Config
-	lbStore  *leaderboard.Store
+	router        *gin.Engine
+	store         store.Store
+	provider      llm.Provider
+	config        *config.Config
+	lbStore       *leaderboard.Store
+	respCache     *responseCache
+	benchmarkJobs *benchmarkJobStore
+	promptCache   *dirLoaderCache[[]*prompt.Prompt]
+	testCache     *dirLoaderCache[[]*testcase.TestSuite]
+
+	// promptLoadErrs holds the per-file parse errors from the most recent
+	// lenient prompt load (see loadPromptsLenient), surfaced to callers via
+	// the X-Prompt-Load-Errors response header. Only populated when
+	// config.LenientPromptLoading is set.
+	promptLoadErrsMu sync.Mutex
+	promptLoadErrs   []prompt.LoadError
 }
 
 func NewServer(cfg *config.Config, st store.Store, provider llm.Provider, lbStore *leaderboard.Store) (*Server, error) {
 	r := gin.New()
 	s := &Server{
-		router:   r,
-		store:    st,
-		provider: provider,
-		config:   cfg,
-		lbStore:  lbStore,
+		router:        r,
+		store:         st,
+		provider:      provider,
+		config:        cfg,
+		lbStore:       lbStore,
+		respCache:     newResponseCache(apiCacheTTL(cfg)),
+		benchmarkJobs: newBenchmarkJobStore(),
+		promptCache:   newDirLoaderCache[[]*prompt.Prompt](0),
+		testCache:     newDirLoaderCache[[]*testcase.TestSuite](0),
 	}
 	s.registerMiddleware()
 	if err := s.registerRoutes(); err != nil {
@@ -46,3 +67,15 @@ func (s *Server) Run(addr string) error {
 	}
 	return s.router.Run(addr)
 }
+
+// apiCacheTTL returns the effective response-cache TTL for cfg, or 0 to
+// disable caching (the default).
+func apiCacheTTL(cfg *config.Config) time.Duration {
+	if cfg == nil || !cfg.APICache.Enabled {
+		return 0
+	}
+	if cfg.APICache.TTL <= 0 {
+		return defaultAPICacheTTL
+	}
+	return cfg.APICache.TTL
+}
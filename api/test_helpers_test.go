@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/stellarlinkco/ai-eval/internal/llm"
 	"github.com/stellarlinkco/ai-eval/internal/store"
@@ -10,11 +11,17 @@ import (
 type fakeStore struct {
 	SaveRunFunc              func(ctx context.Context, run *store.RunRecord) error
 	SaveSuiteResultFunc      func(ctx context.Context, result *store.SuiteRecord) error
+	SaveRedteamResultFunc    func(ctx context.Context, result *store.RedteamRecord) error
 	GetRunFunc               func(ctx context.Context, id string) (*store.RunRecord, error)
 	ListRunsFunc             func(ctx context.Context, filter store.RunFilter) ([]*store.RunRecord, error)
 	GetSuiteResultsFunc      func(ctx context.Context, runID string) ([]*store.SuiteRecord, error)
+	GetRedteamResultsFunc    func(ctx context.Context, runID string) ([]*store.RedteamRecord, error)
 	GetPromptHistoryFunc     func(ctx context.Context, promptName string, limit int) ([]*store.SuiteRecord, error)
 	GetVersionComparisonFunc func(ctx context.Context, promptName, v1, v2 string) (*store.VersionComparison, error)
+	GetFlakyCasesFunc        func(ctx context.Context, promptName string, window int) ([]store.FlakyCase, error)
+	AggregateStatsFunc       func(ctx context.Context, filter store.StatsFilter) ([]store.StatsBucket, error)
+	PruneRunsFunc            func(ctx context.Context, before time.Time, keepLast int) (int, error)
+	CountPrunableRunsFunc    func(ctx context.Context, before time.Time, keepLast int) (int, error)
 	CloseFunc                func() error
 }
 
@@ -53,6 +60,20 @@ func (s *fakeStore) GetSuiteResults(ctx context.Context, runID string) ([]*store
 	return nil, nil
 }
 
+func (s *fakeStore) SaveRedteamResult(ctx context.Context, result *store.RedteamRecord) error {
+	if s.SaveRedteamResultFunc != nil {
+		return s.SaveRedteamResultFunc(ctx, result)
+	}
+	return nil
+}
+
+func (s *fakeStore) GetRedteamResults(ctx context.Context, runID string) ([]*store.RedteamRecord, error) {
+	if s.GetRedteamResultsFunc != nil {
+		return s.GetRedteamResultsFunc(ctx, runID)
+	}
+	return nil, nil
+}
+
 func (s *fakeStore) GetPromptHistory(ctx context.Context, promptName string, limit int) ([]*store.SuiteRecord, error) {
 	if s.GetPromptHistoryFunc != nil {
 		return s.GetPromptHistoryFunc(ctx, promptName, limit)
@@ -67,6 +88,34 @@ func (s *fakeStore) GetVersionComparison(ctx context.Context, promptName, v1, v2
 	return nil, nil
 }
 
+func (s *fakeStore) GetFlakyCases(ctx context.Context, promptName string, window int) ([]store.FlakyCase, error) {
+	if s.GetFlakyCasesFunc != nil {
+		return s.GetFlakyCasesFunc(ctx, promptName, window)
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) AggregateStats(ctx context.Context, filter store.StatsFilter) ([]store.StatsBucket, error) {
+	if s.AggregateStatsFunc != nil {
+		return s.AggregateStatsFunc(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) PruneRuns(ctx context.Context, before time.Time, keepLast int) (int, error) {
+	if s.PruneRunsFunc != nil {
+		return s.PruneRunsFunc(ctx, before, keepLast)
+	}
+	return 0, nil
+}
+
+func (s *fakeStore) CountPrunableRuns(ctx context.Context, before time.Time, keepLast int) (int, error) {
+	if s.CountPrunableRunsFunc != nil {
+		return s.CountPrunableRunsFunc(ctx, before, keepLast)
+	}
+	return 0, nil
+}
+
 func (s *fakeStore) Close() error {
 	if s.CloseFunc != nil {
 		return s.CloseFunc()
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestHandleValidateTests_Success(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	testsYAML := "suite: s\nprompt: p\ncases:\n  - id: c1\n    input: {text: hi}\n    expected: {contains: [ok]}\n"
+	body := `{"tests_yaml":` + strconv.Quote(testsYAML) + `}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d, body=%s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var out validateTestsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(out.Suites) != 1 || out.Suites[0].Suite != "s" {
+		t.Fatalf("suites=%#v", out.Suites)
+	}
+}
+
+func TestHandleValidateTests_MultiDoc(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	testsYAML := strings.Join([]string{
+		"suite: a\nprompt: p\ncases:\n  - id: c1\n    input: {text: hi}\n    expected: {contains: [ok]}\n",
+		"---\n",
+		"suite: b\nprompt: p\ncases:\n  - id: c2\n    input: {text: hi}\n    expected: {contains: [ok]}\n",
+	}, "")
+	body := `{"tests_yaml":` + strconv.Quote(testsYAML) + `}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d, body=%s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	var out validateTestsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(out.Suites) != 2 {
+		t.Fatalf("suites=%#v", out.Suites)
+	}
+}
+
+func TestHandleValidateTests_BadJSON(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString("{"))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleValidateTests_EmptyTestsYAML(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString(`{"tests_yaml":"   "}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleValidateTests_InvalidYAML(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString(`{"tests_yaml":"suite: ["}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+	if !strings.Contains(rec.Body.String(), "document 0") {
+		t.Fatalf("body missing document index: %s", rec.Body.String())
+	}
+}
+
+func TestHandleValidateTests_ValidationError(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString(`{"tests_yaml":"suite: x\nprompt: p\ncases: []\n"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+	if !strings.Contains(rec.Body.String(), "document 0") {
+		t.Fatalf("body missing document index: %s", rec.Body.String())
+	}
+}
+
+func TestHandleValidateTests_NoSuitesProvided(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString(`{"tests_yaml":"# comment\n"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHandleValidateTests_MissingOpenAPISpec(t *testing.T) {
+	s := &Server{}
+	r := newTestRouterForServer(t, s)
+
+	testsYAML := "suite: s\nprompt: p\ncases:\n  - id: c1\n    input: {body: \"{}\"}\n    evaluators:\n      - type: openapi\n        openapi_spec: does-not-exist.yaml\n        operation_id: createWidget\n"
+	body := `{"tests_yaml":` + strconv.Quote(testsYAML) + `}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tests/validate", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status: got %d want %d, body=%s", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), "openapi_spec") {
+		t.Fatalf("body missing openapi_spec context: %s", rec.Body.String())
+	}
+}
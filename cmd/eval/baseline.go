@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/baseline"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+)
+
+type baselineOptions struct {
+	promptName          string
+	trials              int
+	regressionThreshold float64
+}
+
+func newBaselineCmd(st *cliState) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "baseline",
+		Short: "Manage committed per-prompt metric baselines",
+		Args:  cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(st.configPath)
+			if err != nil {
+				return err
+			}
+			st.cfg = cfg
+			return nil
+		},
+	}
+
+	cmd.AddCommand(newBaselineUpdateCmd(st))
+	cmd.AddCommand(newBaselineCheckCmd(st))
+	return cmd
+}
+
+func newBaselineUpdateCmd(st *cliState) *cobra.Command {
+	var opts baselineOptions
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Run a prompt's suites and write its baseline file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBaselineUpdate(cmd, st, &opts)
+		},
+	}
+	cmd.Flags().StringVar(&opts.promptName, "prompt", "", "prompt name to baseline")
+	cmd.Flags().IntVar(&opts.trials, "trials", -1, "number of trials per case (overrides config)")
+	_ = cmd.MarkFlagRequired("prompt")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
+
+	return cmd
+}
+
+func newBaselineCheckCmd(st *cliState) *cobra.Command {
+	var opts baselineOptions
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Run a prompt's suites and compare against its committed baseline",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBaselineCheck(cmd, st, &opts)
+		},
+	}
+	cmd.Flags().StringVar(&opts.promptName, "prompt", "", "prompt name to check")
+	cmd.Flags().IntVar(&opts.trials, "trials", -1, "number of trials per case (overrides config)")
+	cmd.Flags().Float64Var(&opts.regressionThreshold, "regression-threshold", 0, "max allowed pass-rate/avg-score drop before the check fails")
+	_ = cmd.MarkFlagRequired("prompt")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
+
+	return cmd
+}
+
+// runBaselineSuites loads promptName's latest version and every suite that
+// references it, and runs them all. Shared by `baseline update` and
+// `baseline check`, which differ only in what they do with the results.
+func runBaselineSuites(ctx context.Context, st *cliState, opts *baselineOptions) (version string, results []*runner.SuiteResult, err error) {
+	if st == nil || st.cfg == nil {
+		return "", nil, fmt.Errorf("baseline: missing config (internal error)")
+	}
+	if opts == nil {
+		return "", nil, fmt.Errorf("baseline: nil options")
+	}
+
+	promptName := strings.TrimSpace(opts.promptName)
+	if promptName == "" {
+		return "", nil, fmt.Errorf("baseline: missing --prompt")
+	}
+
+	trials := st.cfg.Evaluation.Trials
+	if opts.trials >= 0 {
+		trials = opts.trials
+	}
+	if trials <= 0 {
+		return "", nil, fmt.Errorf("baseline: trials must be > 0 (got %d)", trials)
+	}
+
+	threshold := st.cfg.Evaluation.Threshold
+	if threshold < 0 || threshold > 1 {
+		return "", nil, fmt.Errorf("baseline: threshold must be between 0 and 1 (got %v)", threshold)
+	}
+
+	concurrency := st.cfg.Evaluation.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	prompts, err := app.LoadPromptsRecursive(defaultPromptsDir)
+	if err != nil {
+		return "", nil, err
+	}
+	p, err := app.FindPromptLatestByName(prompts, promptName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	suites, err := app.LoadTestSuites(defaultTestsDir)
+	if err != nil {
+		return "", nil, err
+	}
+	suites = app.FilterSuitesByPrompt(suites, promptName)
+	if len(suites) == 0 {
+		return "", nil, fmt.Errorf("baseline: no test suites found for prompt %q", promptName)
+	}
+	sort.Slice(suites, func(i, j int) bool { return strings.ToLower(suites[i].Suite) < strings.ToLower(suites[j].Suite) })
+
+	provider, err := defaultProviderFromConfig(st.cfg)
+	if err != nil {
+		return "", nil, fmt.Errorf("baseline: %w", err)
+	}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	reg.Register(evaluator.NotContainsEvaluator{})
+	reg.Register(evaluator.RegexEvaluator{})
+	reg.Register(evaluator.JSONSchemaEvaluator{})
+	reg.Register(evaluator.OpenAPIEvaluator{})
+	reg.Register(evaluator.DiversityEvaluator{})
+	reg.Register(evaluator.ConsistencyEvaluator{})
+
+	r := runner.NewRunner(provider, reg, runner.Config{
+		Trials:               trials,
+		PassThreshold:        threshold,
+		Concurrency:          concurrency,
+		EvaluatorConcurrency: st.cfg.Evaluation.EvaluatorConcurrency,
+		Timeout:              st.cfg.Evaluation.Timeout,
+		MaxStepsHardFail:     st.cfg.Evaluation.MaxStepsHardFail,
+		StrictSafety:         st.cfg.Evaluation.StrictSafety,
+		ScoreEpsilon:         st.cfg.Evaluation.ScoreEpsilon,
+		PromptWrapper:        st.cfg.Evaluation.PromptWrapper,
+	})
+
+	results = make([]*runner.SuiteResult, 0, len(suites))
+	for _, suite := range suites {
+		res, _ := r.RunSuite(ctx, p, suite)
+		results = append(results, res)
+	}
+
+	return p.Version, results, nil
+}
+
+func runBaselineUpdate(cmd *cobra.Command, st *cliState, opts *baselineOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	version, results, err := runBaselineSuites(ctx, st, opts)
+	if err != nil {
+		return err
+	}
+
+	b := baseline.FromSuiteResults(strings.TrimSpace(opts.promptName), version, results)
+	path := baseline.Path(defaultBaselineDir, b.Prompt)
+	if err := baseline.Save(path, b); err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "Baseline: wrote %s (prompt=%s version=%s suites=%d)\n", path, b.Prompt, b.Version, len(b.Suites))
+	for _, name := range sortedSuiteNames(b.Suites) {
+		s := b.Suites[name]
+		_, _ = fmt.Fprintf(out, "  %s: pass_rate=%.3f avg_score=%.3f\n", name, s.PassRate, s.AvgScore)
+	}
+	return nil
+}
+
+func runBaselineCheck(cmd *cobra.Command, st *cliState, opts *baselineOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	_, results, err := runBaselineSuites(ctx, st, opts)
+	if err != nil {
+		return err
+	}
+
+	promptName := strings.TrimSpace(opts.promptName)
+	path := baseline.Path(defaultBaselineDir, promptName)
+	b, err := baseline.Load(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("baseline: no baseline at %s; run `ai-eval baseline update --prompt %s` first", path, promptName)
+		}
+		return fmt.Errorf("baseline: %w", err)
+	}
+
+	diffs := baseline.Compare(b, results, opts.regressionThreshold)
+
+	out := cmd.OutOrStdout()
+	regressed := false
+	for _, d := range diffs {
+		if d.Missing {
+			_, _ = fmt.Fprintf(out, "Baseline: suite=%s has no baseline entry, skipping\n", d.Suite)
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "Baseline: suite=%s pass_rate=%.3f->%.3f (%+.3f) avg_score=%.3f->%.3f (%+.3f) regression=%v\n",
+			d.Suite, d.BaselinePassRate, d.CurrentPassRate, d.PassRateDelta, d.BaselineAvgScore, d.CurrentAvgScore, d.AvgScoreDelta, d.Regressed)
+		if d.Regressed {
+			regressed = true
+		}
+	}
+
+	if regressed {
+		return errRegression
+	}
+	return nil
+}
+
+func sortedSuiteNames(suites map[string]baseline.Suite) []string {
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
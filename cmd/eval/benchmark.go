@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/signal"
 	"sort"
 	"strings"
+	"sync"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -18,10 +21,19 @@ import (
 )
 
 type benchmarkOptions struct {
-	model      string
-	provider   string
-	dataset    string
-	sampleSize int
+	model          string
+	provider       string
+	providers      string
+	dataset        string
+	sampleSize     int
+	sampleStrategy string
+	seed           int64
+	format         string
+	warmup         int
+	concurrency    int
+	qps            float64
+	checkpoint     string
+	restart        bool
 }
 
 func newBenchmarkCmd(st *cliState) *cobra.Command {
@@ -46,8 +58,17 @@ func newBenchmarkCmd(st *cliState) *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.model, "model", "", "model name (overrides config)")
 	cmd.Flags().StringVar(&opts.provider, "provider", "", "provider name (overrides config)")
+	cmd.Flags().StringVar(&opts.providers, "providers", "", "comma-separated provider names to sweep (runs each against the same dataset, saving one leaderboard entry per provider)")
 	cmd.Flags().StringVar(&opts.dataset, "dataset", "", "dataset: mmlu|humaneval|gsm8k")
 	cmd.Flags().IntVar(&opts.sampleSize, "sample-size", 0, "sample size (0 = default)")
+	cmd.Flags().StringVar(&opts.sampleStrategy, "sample-strategy", "", "how to narrow the dataset to --sample-size: uniform|stratified|hard-weighted (default: first N rows)")
+	cmd.Flags().Int64Var(&opts.seed, "seed", 0, "seed for --sample-strategy (ignored for the default head-N strategy)")
+	cmd.Flags().StringVar(&opts.format, "format", "table", "sweep report format: table|json (only used with --providers)")
+	cmd.Flags().IntVar(&opts.warmup, "warmup", 0, "number of throwaway provider calls to issue before timed evaluation begins, to exclude cold-start latency from the reported latency")
+	cmd.Flags().IntVar(&opts.concurrency, "concurrency", 1, "number of questions to evaluate against the provider concurrently")
+	cmd.Flags().Float64Var(&opts.qps, "qps", 0, "max provider requests per second across all workers (0 = unlimited)")
+	cmd.Flags().StringVar(&opts.checkpoint, "checkpoint", "", "path to a checkpoint file recording completed questions, so an interrupted run can resume without re-evaluating them (not used with --providers)")
+	cmd.Flags().BoolVar(&opts.restart, "restart", false, "ignore any existing --checkpoint file and start over")
 
 	return cmd
 }
@@ -60,11 +81,25 @@ func runBenchmark(cmd *cobra.Command, st *cliState, opts *benchmarkOptions) erro
 		return fmt.Errorf("benchmark: nil options")
 	}
 
-	ds, err := resolveBenchmarkDataset(opts.dataset, opts.sampleSize)
+	if opts.concurrency == 0 {
+		opts.concurrency = 1
+	}
+	if opts.concurrency < 1 {
+		return fmt.Errorf("benchmark: --concurrency must be >= 1 (got %d)", opts.concurrency)
+	}
+	if opts.qps < 0 {
+		return fmt.Errorf("benchmark: --qps must be >= 0 (got %v)", opts.qps)
+	}
+
+	ds, err := resolveBenchmarkDataset(opts.dataset, opts.sampleSize, opts.sampleStrategy, opts.seed)
 	if err != nil {
 		return err
 	}
 
+	if providers := parseProviderList(opts.providers); len(providers) > 0 {
+		return runBenchmarkSweep(cmd, st, opts, ds, providers)
+	}
+
 	provider, modelName, err := benchmarkProviderFromConfig(st.cfg, opts.provider, opts.model)
 	if err != nil {
 		return err
@@ -83,9 +118,22 @@ func runBenchmark(cmd *cobra.Command, st *cliState, opts *benchmarkOptions) erro
 	ctx, stop := signal.NotifyContext(parent, os.Interrupt)
 	defer stop()
 
+	var cp *benchmark.Checkpoint
+	if strings.TrimSpace(opts.checkpoint) != "" {
+		cp, err = benchmark.OpenCheckpoint(opts.checkpoint, opts.restart, cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+		defer cp.Close()
+	}
+
 	r := &benchmark.BenchmarkRunner{
-		Provider: provider,
-		Store:    lb,
+		Provider:    provider,
+		Store:       lb,
+		Warmup:      opts.warmup,
+		Concurrency: opts.concurrency,
+		QPS:         opts.qps,
+		Checkpoint:  cp,
 	}
 	res, runErr := r.Run(ctx, ds)
 	if res == nil {
@@ -105,6 +153,7 @@ func runBenchmark(cmd *cobra.Command, st *cliState, opts *benchmarkOptions) erro
 		Latency:  res.TotalTime.Milliseconds(),
 		Cost:     0,
 		EvalDate: time.Now().UTC(),
+		Metadata: benchmarkMetadata(opts.sampleStrategy, opts.seed),
 	}
 	if err := lb.Save(cmd.Context(), entry); err != nil {
 		return err
@@ -125,7 +174,160 @@ func runBenchmark(cmd *cobra.Command, st *cliState, opts *benchmarkOptions) erro
 	return nil
 }
 
-func resolveBenchmarkDataset(name string, sampleSize int) (benchmark.Dataset, error) {
+// sweepResult holds the outcome of benchmarking a single provider as part of
+// a --providers sweep. Error is set instead of Entry when that provider
+// failed, so one bad provider doesn't take down the rest of the report.
+type sweepResult struct {
+	Provider string
+	Model    string
+	Entry    *leaderboard.Entry
+	Error    string
+}
+
+func parseProviderList(raw string) []string {
+	var out []string
+	seen := make(map[string]struct{})
+	for _, part := range strings.Split(raw, ",") {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		out = append(out, name)
+	}
+	return out
+}
+
+func runBenchmarkSweep(cmd *cobra.Command, st *cliState, opts *benchmarkOptions, ds benchmark.Dataset, providers []string) error {
+	lb, err := openLeaderboardStore(st.cfg)
+	if err != nil {
+		return err
+	}
+	defer lb.Close()
+
+	parent := cmd.Context()
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(parent, os.Interrupt)
+	defer stop()
+
+	maxParallel := st.cfg.Benchmark.MaxParallelProviders
+	if maxParallel <= 0 {
+		maxParallel = 1
+	}
+	if maxParallel > len(providers) {
+		maxParallel = len(providers)
+	}
+	sem := make(chan struct{}, maxParallel)
+
+	results := make([]sweepResult, len(providers))
+	var wg sync.WaitGroup
+	for i, name := range providers {
+		i, name := i, name
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i] = sweepResult{Provider: name, Error: ctx.Err().Error()}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			provider, modelName, err := benchmarkProviderFromConfig(st.cfg, name, opts.model)
+			if err != nil {
+				results[i] = sweepResult{Provider: name, Error: err.Error()}
+				return
+			}
+
+			r := &benchmark.BenchmarkRunner{Provider: provider, Store: lb, Warmup: opts.warmup, Concurrency: opts.concurrency, QPS: opts.qps}
+			res, runErr := r.Run(ctx, ds)
+			if runErr != nil {
+				results[i] = sweepResult{Provider: name, Model: modelName, Error: runErr.Error()}
+				return
+			}
+
+			entry := &leaderboard.Entry{
+				Model:    modelName,
+				Provider: provider.Name(),
+				Dataset:  ds.Name(),
+				Score:    res.Score,
+				Accuracy: res.Accuracy,
+				Latency:  res.TotalTime.Milliseconds(),
+				EvalDate: time.Now().UTC(),
+				Metadata: benchmarkMetadata(opts.sampleStrategy, opts.seed),
+			}
+			if err := lb.Save(ctx, entry); err != nil {
+				results[i] = sweepResult{Provider: name, Model: modelName, Error: err.Error()}
+				return
+			}
+			results[i] = sweepResult{Provider: name, Model: modelName, Entry: entry}
+		}()
+	}
+	wg.Wait()
+
+	if err := writeSweepReport(cmd, opts.format, ds.Name(), results); err != nil {
+		return err
+	}
+
+	succeeded := 0
+	for _, r := range results {
+		if r.Entry != nil {
+			succeeded++
+		}
+	}
+	if succeeded == 0 {
+		return fmt.Errorf("benchmark: all %d provider(s) in sweep failed", len(providers))
+	}
+	return nil
+}
+
+func writeSweepReport(cmd *cobra.Command, format string, dataset string, results []sweepResult) error {
+	ranked := make([]sweepResult, len(results))
+	copy(ranked, results)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		ei, ej := ranked[i].Entry, ranked[j].Entry
+		if ei == nil && ej == nil {
+			return false
+		}
+		if ei == nil || ej == nil {
+			return ej == nil // failures sort after successes
+		}
+		return ei.Score > ej.Score
+	})
+
+	out := cmd.OutOrStdout()
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", "table":
+		fmt.Fprintf(out, "Benchmark sweep: dataset=%s providers=%d\n", dataset, len(results))
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "RANK\tPROVIDER\tMODEL\tSCORE\tACCURACY\tLAT(ms)\tSTATUS")
+		for i, r := range ranked {
+			if r.Entry == nil {
+				fmt.Fprintf(tw, "-\t%s\t%s\t-\t-\t-\tFAILED: %s\n", r.Provider, r.Model, r.Error)
+				continue
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%.4f\t%.4f\t%d\tOK\n",
+				i+1, r.Entry.Provider, r.Entry.Model, r.Entry.Score, r.Entry.Accuracy, r.Entry.Latency)
+		}
+		return tw.Flush()
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(ranked)
+	default:
+		return fmt.Errorf("benchmark: invalid --format %q (expected table|json)", format)
+	}
+}
+
+func resolveBenchmarkDataset(name string, sampleSize int, sampleStrategy string, seed int64) (benchmark.Dataset, error) {
 	name = strings.ToLower(strings.TrimSpace(name))
 	if name == "" {
 		return nil, fmt.Errorf("benchmark: missing --dataset (mmlu|humaneval|gsm8k)")
@@ -133,19 +335,35 @@ func resolveBenchmarkDataset(name string, sampleSize int) (benchmark.Dataset, er
 	if sampleSize < 0 {
 		return nil, fmt.Errorf("benchmark: --sample-size must be >= 0 (got %d)", sampleSize)
 	}
+	strategy, err := benchmark.ParseSampleStrategy(sampleStrategy)
+	if err != nil {
+		return nil, err
+	}
 
 	switch name {
 	case "mmlu":
-		return &benchmark.MMLUDataset{SampleSize: sampleSize}, nil
+		return &benchmark.MMLUDataset{SampleSize: sampleSize, SampleStrategy: strategy, Seed: seed}, nil
 	case "humaneval":
-		return &benchmark.HumanEvalDataset{SampleSize: sampleSize}, nil
+		return &benchmark.HumanEvalDataset{SampleSize: sampleSize, SampleStrategy: strategy, Seed: seed}, nil
 	case "gsm8k":
-		return &benchmark.GSM8KDataset{SampleSize: sampleSize}, nil
+		return &benchmark.GSM8KDataset{SampleSize: sampleSize, SampleStrategy: strategy, Seed: seed}, nil
 	default:
 		return nil, fmt.Errorf("benchmark: unknown dataset %q (expected mmlu|humaneval|gsm8k)", name)
 	}
 }
 
+// benchmarkMetadata builds the leaderboard.Entry.Metadata recording how a
+// dataset sample was drawn, so a leaderboard entry can be traced back to a
+// reproducible --sample-strategy/--seed combination. Returns nil for the
+// default head-N strategy, which needs no seed to reproduce.
+func benchmarkMetadata(strategy string, seed int64) map[string]any {
+	strategy = strings.ToLower(strings.TrimSpace(strategy))
+	if strategy == "" {
+		return nil
+	}
+	return map[string]any{"sample_strategy": strategy, "seed": seed}
+}
+
 func resolveBenchmarkProvider(cfg *config.Config, providerFlag string, modelFlag string) (llm.Provider, string, error) {
 	if cfg == nil {
 		return nil, "", fmt.Errorf("benchmark: missing config")
@@ -183,7 +401,7 @@ func resolveBenchmarkProvider(cfg *config.Config, providerFlag string, modelFlag
 	case "claude":
 		return llm.NewClaudeProvider(pcfg.APIKey, pcfg.BaseURL, model), modelName, nil
 	case "openai":
-		return llm.NewOpenAIProvider(pcfg.APIKey, pcfg.BaseURL, model), modelName, nil
+		return llm.NewOpenAIProvider(pcfg.APIKey, pcfg.BaseURL, model, llm.WithOpenAIHeaders(pcfg.Headers)), modelName, nil
 	default:
 		return nil, "", fmt.Errorf("benchmark: unsupported provider %q", providerName)
 	}
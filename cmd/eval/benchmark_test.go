@@ -1,34 +1,45 @@
 package main
 
 import (
+	"context"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
 )
 
 func TestResolveBenchmarkDataset(t *testing.T) {
 	t.Parallel()
 
-	if _, err := resolveBenchmarkDataset("", 0); err == nil {
+	if _, err := resolveBenchmarkDataset("", 0, "", 0); err == nil {
 		t.Fatalf("expected error for missing dataset")
 	}
-	if _, err := resolveBenchmarkDataset("mmlu", -1); err == nil {
+	if _, err := resolveBenchmarkDataset("mmlu", -1, "", 0); err == nil {
 		t.Fatalf("expected error for negative sample size")
 	}
-	if _, err := resolveBenchmarkDataset("wat", 0); err == nil {
+	if _, err := resolveBenchmarkDataset("wat", 0, "", 0); err == nil {
 		t.Fatalf("expected error for unknown dataset")
 	}
+	if _, err := resolveBenchmarkDataset("mmlu", 0, "bogus", 0); err == nil {
+		t.Fatalf("expected error for invalid sample strategy")
+	}
 
-	if ds, err := resolveBenchmarkDataset("mmlu", 10); err != nil || ds == nil || ds.Name() != "mmlu" {
+	if ds, err := resolveBenchmarkDataset("mmlu", 10, "", 0); err != nil || ds == nil || ds.Name() != "mmlu" {
 		t.Fatalf("mmlu: ds=%v err=%v", ds, err)
 	}
-	if ds, err := resolveBenchmarkDataset("humaneval", 1); err != nil || ds == nil || ds.Name() != "humaneval" {
+	if ds, err := resolveBenchmarkDataset("humaneval", 1, "", 0); err != nil || ds == nil || ds.Name() != "humaneval" {
 		t.Fatalf("humaneval: ds=%v err=%v", ds, err)
 	}
-	if ds, err := resolveBenchmarkDataset("gsm8k", 1); err != nil || ds == nil || ds.Name() != "gsm8k" {
+	if ds, err := resolveBenchmarkDataset("gsm8k", 1, "", 0); err != nil || ds == nil || ds.Name() != "gsm8k" {
 		t.Fatalf("gsm8k: ds=%v err=%v", ds, err)
 	}
+	if ds, err := resolveBenchmarkDataset("gsm8k", 1, "hard-weighted", 42); err != nil || ds == nil || ds.Name() != "gsm8k" {
+		t.Fatalf("gsm8k with strategy: ds=%v err=%v", ds, err)
+	}
 }
 
 func TestNormalizeProvider(t *testing.T) {
@@ -119,3 +130,77 @@ func TestOpenLeaderboardStore(t *testing.T) {
 		t.Fatalf("expected error for unsupported storage type")
 	}
 }
+
+func TestRunBenchmarkSweep_MaxParallelProviders(t *testing.T) {
+	// Not parallel: mutates the package-level benchmarkProviderFromConfig var.
+	cliIntegrationMu.Lock()
+	defer cliIntegrationMu.Unlock()
+
+	runSweep := func(t *testing.T, maxParallel int) (peak int32) {
+		t.Helper()
+
+		var active, peakActive int32
+		prov := &stubProvider{
+			name: "stub",
+			completeWithTools: func(*llm.Request) string {
+				n := atomic.AddInt32(&active, 1)
+				for {
+					p := atomic.LoadInt32(&peakActive)
+					if n <= p || atomic.CompareAndSwapInt32(&peakActive, p, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				atomic.AddInt32(&active, -1)
+				return "A"
+			},
+		}
+
+		oldBenchProvider := benchmarkProviderFromConfig
+		benchmarkProviderFromConfig = func(_ *config.Config, providerFlag, _ string) (llm.Provider, string, error) {
+			return prov, providerFlag + "-model", nil
+		}
+		t.Cleanup(func() { benchmarkProviderFromConfig = oldBenchProvider })
+
+		st := &cliState{cfg: &config.Config{
+			Storage:   config.StorageConfig{Type: "memory"},
+			Benchmark: config.BenchmarkConfig{MaxParallelProviders: maxParallel},
+		}}
+		ds, err := resolveBenchmarkDataset("mmlu", 1, "", 0)
+		if err != nil {
+			t.Fatalf("resolveBenchmarkDataset: %v", err)
+		}
+
+		cmd := &cobra.Command{}
+		var buf strings.Builder
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runBenchmarkSweep(cmd, st, &benchmarkOptions{format: "table"}, ds, []string{"a", "b", "c", "d"}); err != nil {
+			t.Fatalf("runBenchmarkSweep: %v", err)
+		}
+
+		return atomic.LoadInt32(&peakActive)
+	}
+
+	if peak := runSweep(t, 1); peak != 1 {
+		t.Fatalf("MaxParallelProviders=1: peak concurrent providers = %d, want 1", peak)
+	}
+	if peak := runSweep(t, 2); peak > 2 {
+		t.Fatalf("MaxParallelProviders=2: peak concurrent providers = %d, want <= 2", peak)
+	}
+}
+
+func TestParseProviderList(t *testing.T) {
+	t.Parallel()
+
+	if got := parseProviderList(""); got != nil {
+		t.Fatalf("empty: got %v", got)
+	}
+	if got := parseProviderList(" , , "); got != nil {
+		t.Fatalf("blank parts: got %v", got)
+	}
+	if got := strings.Join(parseProviderList("claude, openai ,claude"), ","); got != "claude,openai" {
+		t.Fatalf("dedupe/trim: got %q", got)
+	}
+}
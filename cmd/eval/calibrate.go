@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/calibrate"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+)
+
+type calibrateOptions struct {
+	output string
+}
+
+func newCalibrateCmd(st *cliState) *cobra.Command {
+	var opts calibrateOptions
+
+	cmd := &cobra.Command{
+		Use:   "calibrate <dataset-file>",
+		Short: "Compare evaluator verdicts against human labels on a fixed dataset",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(st.configPath)
+			if err != nil {
+				return err
+			}
+			st.cfg = cfg
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCalibrate(cmd, st, args[0], &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.output, "output", "text", "output format: text|json")
+
+	return cmd
+}
+
+func runCalibrate(cmd *cobra.Command, st *cliState, path string, opts *calibrateOptions) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("calibrate: missing config (internal error)")
+	}
+	if opts == nil {
+		return fmt.Errorf("calibrate: nil options")
+	}
+
+	outFmt := strings.ToLower(strings.TrimSpace(opts.output))
+	if outFmt == "" {
+		outFmt = "text"
+	}
+	if outFmt != "text" && outFmt != "json" {
+		return fmt.Errorf("calibrate: invalid --output %q (expected text|json)", opts.output)
+	}
+
+	ds, err := calibrate.LoadDataset(path)
+	if err != nil {
+		return err
+	}
+
+	provider, err := defaultProviderFromConfig(st.cfg)
+	if err != nil {
+		return fmt.Errorf("calibrate: %w", err)
+	}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	reg.Register(evaluator.NotContainsEvaluator{})
+	reg.Register(evaluator.RegexEvaluator{})
+	reg.Register(evaluator.JSONSchemaEvaluator{})
+	reg.Register(evaluator.OpenAPIEvaluator{})
+	reg.Register(evaluator.DiversityEvaluator{})
+	reg.Register(evaluator.ConsistencyEvaluator{})
+
+	r := runner.NewRunner(provider, reg, runner.Config{
+		Trials:      1,
+		Concurrency: 1,
+		Timeout:     st.cfg.Evaluation.Timeout,
+	})
+
+	report, err := calibrate.Run(cmd.Context(), r, ds)
+	if err != nil {
+		return err
+	}
+
+	switch outFmt {
+	case "json":
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return fmt.Errorf("calibrate: marshal output: %w", err)
+		}
+		return nil
+	default:
+		printCalibrateText(cmd, report)
+		return nil
+	}
+}
+
+func printCalibrateText(cmd *cobra.Command, report *calibrate.Report) {
+	out := cmd.OutOrStdout()
+
+	_, _ = fmt.Fprintf(out, "Cases: %d\n", report.Total)
+	_, _ = fmt.Fprintf(out, "Accuracy: %.3f\n", report.Accuracy)
+	_, _ = fmt.Fprintf(out, "Precision: %.3f\n", report.Precision)
+	_, _ = fmt.Fprintf(out, "Recall: %.3f\n", report.Recall)
+	_, _ = fmt.Fprintf(out, "F1: %.3f\n", report.F1)
+	_, _ = fmt.Fprintf(out, "Correlation: %.3f\n", report.Correlation)
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CASE\tHUMAN_PASS\tEVAL_PASS\tHUMAN_SCORE\tEVAL_SCORE")
+	for _, c := range report.Cases {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%.3f\n",
+			c.ID,
+			optBoolLabel(c.HumanPass),
+			statusLabel(c.EvaluatorPass),
+			optScoreLabel(c.HumanScore),
+			c.EvaluatorScore,
+		)
+	}
+	_ = tw.Flush()
+}
+
+func optBoolLabel(b *bool) string {
+	if b == nil {
+		return "-"
+	}
+	return statusLabel(*b)
+}
+
+func optScoreLabel(f *float64) string {
+	if f == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.3f", *f)
+}
@@ -13,7 +13,12 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/ci"
 )
 
-const ciReportPath = "data/ci-results.json"
+const (
+	ciReportPath = "data/ci-results.json"
+
+	defaultCIOutputDir  = "data"
+	defaultCIOutputFile = "ci-results.json"
+)
 
 type ciReport struct {
 	StartedAt  string          `json:"started_at"`
@@ -54,20 +59,102 @@ func applyCIOutputDefaults(opts *runOptions, ciMode bool) {
 	}
 }
 
-func writeCIArtifacts(runs []app.SuiteRun, summary app.RunSummary, startedAt, finishedAt time.Time, threshold float64) {
+func writeCIArtifacts(runs []app.SuiteRun, summary app.RunSummary, startedAt, finishedAt time.Time, threshold float64, outputDir, outputFile string, promptNames []string) {
 	report := buildCIReport(runs, summary, startedAt, finishedAt, threshold)
 	if err := ci.SetJobSummary(buildCIMarkdown(report)); err != nil {
 		fmt.Fprintf(os.Stderr, "ci: write job summary: %v\n", err)
 	}
-	if err := writeCIReportFile(ciReportPath, report); err != nil {
+
+	reportPath := resolveCIReportPath(outputDir, outputFile, promptNames, finishedAt)
+	if err := writeCIReportFile(reportPath, report); err != nil {
 		fmt.Fprintf(os.Stderr, "ci: write report: %v\n", err)
 		return
 	}
-	if err := postPRComment(ciReportPath); err != nil {
+	if err := postPRComment(reportPath); err != nil {
 		fmt.Fprintf(os.Stderr, "ci: post PR comment: %v\n", err)
 	}
 }
 
+// resolveCIReportPath builds the --ci artifact path from outputDir/outputFile
+// (each falling back to its default, giving ciReportPath unchanged when
+// neither is set), expanding {prompt}/{timestamp} template variables in
+// outputFile so parallel CI jobs in the same workspace don't collide.
+func resolveCIReportPath(outputDir, outputFile string, promptNames []string, at time.Time) string {
+	outputDir = strings.TrimSpace(outputDir)
+	if outputDir == "" {
+		outputDir = defaultCIOutputDir
+	}
+	outputFile = strings.TrimSpace(outputFile)
+	if outputFile == "" {
+		outputFile = defaultCIOutputFile
+	}
+	outputFile = expandCIFileTemplate(outputFile, promptNames, at)
+	return filepath.Join(outputDir, outputFile)
+}
+
+// expandCIFileTemplate replaces {prompt} and {timestamp} in file with values
+// derived from the run, so a matrix CI job can produce one artifact per
+// prompt/model/shard instead of overwriting a single shared file.
+func expandCIFileTemplate(file string, promptNames []string, at time.Time) string {
+	replacer := strings.NewReplacer(
+		"{prompt}", ciPromptToken(promptNames),
+		"{timestamp}", at.Format("20060102-150405"),
+	)
+	return replacer.Replace(file)
+}
+
+// ciPromptToken summarizes promptNames as a single filename-safe token:
+// the slugified prompt name when there's exactly one, "all" when there are
+// none (e.g. --all with no prompts loaded), and "multi" otherwise, since a
+// single CI report can cover more than one prompt.
+func ciPromptToken(promptNames []string) string {
+	seen := make(map[string]struct{}, len(promptNames))
+	var unique []string
+	for _, name := range promptNames {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		unique = append(unique, name)
+	}
+
+	switch len(unique) {
+	case 0:
+		return "all"
+	case 1:
+		return slugifyCIToken(unique[0])
+	default:
+		return "multi"
+	}
+}
+
+func slugifyCIToken(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	var b strings.Builder
+	lastDash := false
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastDash = false
+		default:
+			if !lastDash && b.Len() > 0 {
+				b.WriteByte('-')
+				lastDash = true
+			}
+		}
+	}
+	out := strings.TrimRight(b.String(), "-")
+	if out == "" {
+		return "prompt"
+	}
+	return out
+}
+
 func buildCIReport(runs []app.SuiteRun, summary app.RunSummary, startedAt, finishedAt time.Time, threshold float64) ciReport {
 	report := ciReport{
 		StartedAt:  formatTime(startedAt),
@@ -36,6 +36,48 @@ func TestApplyCIOutputDefaults(t *testing.T) {
 	}
 }
 
+func TestResolveCIReportPath(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 2, 7, 15, 4, 5, 0, time.UTC)
+
+	if got := resolveCIReportPath("", "", nil, at); got != ciReportPath {
+		t.Fatalf("defaults: got %q want %q", got, ciReportPath)
+	}
+	if got := resolveCIReportPath("out", "report.json", nil, at); got != filepath.Join("out", "report.json") {
+		t.Fatalf("custom dir/file: got %q", got)
+	}
+	if got := resolveCIReportPath("out", "{prompt}-{timestamp}.json", []string{"p1"}, at); got != filepath.Join("out", "p1-20260207-150405.json") {
+		t.Fatalf("templated: got %q", got)
+	}
+	if got := resolveCIReportPath("out", "{prompt}.json", []string{"p1", "p2"}, at); got != filepath.Join("out", "multi.json") {
+		t.Fatalf("multi prompt: got %q", got)
+	}
+	if got := resolveCIReportPath("out", "{prompt}.json", nil, at); got != filepath.Join("out", "all.json") {
+		t.Fatalf("no prompt: got %q", got)
+	}
+}
+
+func TestCIPromptToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{names: nil, want: "all"},
+		{names: []string{"  "}, want: "all"},
+		{names: []string{"My Prompt!"}, want: "my-prompt"},
+		{names: []string{"p1", "p1"}, want: "p1"},
+		{names: []string{"p1", "p2"}, want: "multi"},
+	}
+	for _, tc := range tests {
+		if got := ciPromptToken(tc.names); got != tc.want {
+			t.Fatalf("ciPromptToken(%v): got %q want %q", tc.names, got, tc.want)
+		}
+	}
+}
+
 func TestBuildCIReportAndMarkdown(t *testing.T) {
 	t.Parallel()
 
@@ -213,7 +255,7 @@ func TestWriteCIArtifacts_Success(t *testing.T) {
 	started := time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)
 	finished := started.Add(time.Second)
 
-	writeCIArtifacts(runs, summary, started, finished, 0.5)
+	writeCIArtifacts(runs, summary, started, finished, 0.5, "", "", []string{"p1"})
 
 	if _, err := os.Stat(ciReportPath); err != nil {
 		t.Fatalf("expected report %q to exist: %v", ciReportPath, err)
@@ -267,7 +309,7 @@ func TestWriteCIArtifacts_ErrorPaths(t *testing.T) {
 	if err := os.WriteFile("data", []byte("x"), 0o644); err != nil {
 		t.Fatalf("WriteFile(data): %v", err)
 	}
-	writeCIArtifacts(runs, summary, started, finished, 0.5)
+	writeCIArtifacts(runs, summary, started, finished, 0.5, "", "", []string{"p1"})
 
 	_ = os.Remove("data")
 	if err := os.MkdirAll("data", 0o755); err != nil {
@@ -275,7 +317,7 @@ func TestWriteCIArtifacts_ErrorPaths(t *testing.T) {
 	}
 
 	// Let report write succeed but force postPRComment() to fail (missing script).
-	writeCIArtifacts(runs, summary, started, finished, 0.5)
+	writeCIArtifacts(runs, summary, started, finished, 0.5, "", "", []string{"p1"})
 	if _, err := os.Stat(ciReportPath); err != nil {
 		t.Fatalf("expected report %q to exist: %v", ciReportPath, err)
 	}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -314,6 +315,28 @@ func TestCLI_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("list_json_and_csv", func(t *testing.T) {
+		out, err := runCLI(t, "list", "prompts", "--output", "json")
+		if err != nil {
+			t.Fatalf("list prompts --output json: %v", err)
+		}
+		if !strings.Contains(out, `"name": "p1"`) {
+			t.Fatalf("list prompts json output: %q", out)
+		}
+
+		out, err = runCLI(t, "list", "tests", "--output", "csv")
+		if err != nil {
+			t.Fatalf("list tests --output csv: %v", err)
+		}
+		if !strings.Contains(out, "suite,prompt,cases,description") {
+			t.Fatalf("list tests csv output: %q", out)
+		}
+
+		if _, err := runCLI(t, "list", "prompts", "--output", "xml"); err == nil || !strings.Contains(err.Error(), "invalid --output") {
+			t.Fatalf("expected invalid --output error, got %v", err)
+		}
+	})
+
 	t.Run("history_empty", func(t *testing.T) {
 		out, err := runCLI(t, "history")
 		if err != nil {
@@ -412,6 +435,23 @@ func TestCLI_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("run_suites_filter", func(t *testing.T) {
+		out, err := runCLI(t, "run", "--prompt", "p1", "--suites", "suite*", "--trials", "1", "--threshold", "0.8")
+		if err != nil {
+			t.Fatalf("run --suites (matching): %v", err)
+		}
+		if !strings.Contains(out, "Suite: suite1") {
+			t.Fatalf("run --suites (matching) output: %q", out)
+		}
+
+		if _, err := runCLI(t, "run", "--prompt", "p1", "--suites", "nope_*"); err == nil || !strings.Contains(err.Error(), "no suites match") {
+			t.Fatalf("expected no-match error, got %v", err)
+		}
+		if _, err := runCLI(t, "run", "--prompt", "p1", "--exclude-suites", "suite1"); err == nil || !strings.Contains(err.Error(), "no suites match") {
+			t.Fatalf("expected no-match error, got %v", err)
+		}
+	})
+
 	t.Run("leaderboard", func(t *testing.T) {
 		if _, err := runCLI(t, "leaderboard"); err == nil || !strings.Contains(err.Error(), "missing --dataset") {
 			t.Fatalf("expected dataset error, got %v", err)
@@ -501,6 +541,49 @@ func TestCLI_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("baseline_update_and_check", func(t *testing.T) {
+		oldComplete := prov.completeWithTools
+		prov.completeWithTools = func(*llm.Request) string { return "ok" }
+		t.Cleanup(func() { prov.completeWithTools = oldComplete })
+
+		if _, err := runCLI(t, "baseline", "check", "--prompt", "p1", "--trials", "1"); err == nil || !strings.Contains(err.Error(), "run `ai-eval baseline update --prompt p1` first") {
+			t.Fatalf("expected missing baseline error, got %v", err)
+		}
+
+		out, err := runCLI(t, "baseline", "update", "--prompt", "p1", "--trials", "1")
+		if err != nil {
+			t.Fatalf("baseline update: %v", err)
+		}
+		if !strings.Contains(out, "Baseline: wrote") {
+			t.Fatalf("baseline update output: %q", out)
+		}
+		if _, err := os.Stat(filepath.Join(ws.dir, "baselines", "p1.yaml")); err != nil {
+			t.Fatalf("expected baselines/p1.yaml to exist: %v", err)
+		}
+
+		out, err = runCLI(t, "baseline", "check", "--prompt", "p1", "--trials", "1")
+		if err != nil {
+			t.Fatalf("baseline check: %v", err)
+		}
+		if !strings.Contains(out, "regression=false") {
+			t.Fatalf("baseline check output: %q", out)
+		}
+
+		prov.completeWithTools = func(*llm.Request) string { return "bad" }
+		if _, err := runCLI(t, "baseline", "check", "--prompt", "p1", "--trials", "1"); err == nil || !errors.Is(err, errRegression) {
+			t.Fatalf("expected errRegression, got %v", err)
+		}
+	})
+
+	t.Run("baseline_validation_errors", func(t *testing.T) {
+		if _, err := runCLI(t, "baseline", "update"); err == nil || !strings.Contains(err.Error(), "required flag(s) \"prompt\"") {
+			t.Fatalf("expected required prompt flag error, got %v", err)
+		}
+		if _, err := runCLI(t, "baseline", "update", "--prompt", "p1", "--trials", "0"); err == nil || !strings.Contains(err.Error(), "trials must be > 0") {
+			t.Fatalf("expected trials error, got %v", err)
+		}
+	})
+
 	t.Run("benchmark_error", func(t *testing.T) {
 		if _, err := runCLI(t, "benchmark"); err == nil || !strings.Contains(err.Error(), "missing --dataset") {
 			t.Fatalf("expected dataset error, got %v", err)
@@ -533,6 +616,74 @@ func TestCLI_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("benchmark_warmup", func(t *testing.T) {
+		oldBenchProvider := benchmarkProviderFromConfig
+		benchmarkProviderFromConfig = func(*config.Config, string, string) (llm.Provider, string, error) {
+			return prov, "stub-model", nil
+		}
+		t.Cleanup(func() { benchmarkProviderFromConfig = oldBenchProvider })
+
+		var calls int32
+		oldComplete := prov.completeWithTools
+		prov.completeWithTools = func(*llm.Request) string {
+			atomic.AddInt32(&calls, 1)
+			return "A"
+		}
+		t.Cleanup(func() { prov.completeWithTools = oldComplete })
+
+		out, err := runCLI(t, "benchmark", "--dataset", "mmlu", "--sample-size", "1", "--warmup", "2")
+		if err != nil {
+			t.Fatalf("benchmark: %v", err)
+		}
+		if !strings.Contains(out, "Benchmark saved:") {
+			t.Fatalf("benchmark output: %q", out)
+		}
+		if got := atomic.LoadInt32(&calls); got != 3 {
+			t.Fatalf("calls=%d, want 2 warm-up + 1 timed", got)
+		}
+		if !strings.Contains(out, "tokens=") {
+			t.Fatalf("benchmark output missing tokens: %q", out)
+		}
+	})
+
+	t.Run("benchmark_providers_sweep", func(t *testing.T) {
+		oldBenchProvider := benchmarkProviderFromConfig
+		benchmarkProviderFromConfig = func(_ *config.Config, providerFlag, _ string) (llm.Provider, string, error) {
+			if providerFlag == "broken" {
+				return nil, "", errors.New("boom")
+			}
+			return prov, providerFlag + "-model", nil
+		}
+		t.Cleanup(func() { benchmarkProviderFromConfig = oldBenchProvider })
+
+		oldComplete := prov.completeWithTools
+		prov.completeWithTools = func(*llm.Request) string { return "A" }
+		t.Cleanup(func() { prov.completeWithTools = oldComplete })
+
+		out, err := runCLI(t, "benchmark", "--dataset", "mmlu", "--sample-size", "1", "--providers", "claude,broken")
+		if err != nil {
+			t.Fatalf("benchmark sweep: %v", err)
+		}
+		if !strings.Contains(out, "Benchmark sweep: dataset=mmlu providers=2") {
+			t.Fatalf("sweep output missing header: %q", out)
+		}
+		if !strings.Contains(out, "FAILED: boom") {
+			t.Fatalf("sweep output missing failure: %q", out)
+		}
+	})
+
+	t.Run("benchmark_providers_sweep_all_fail", func(t *testing.T) {
+		oldBenchProvider := benchmarkProviderFromConfig
+		benchmarkProviderFromConfig = func(*config.Config, string, string) (llm.Provider, string, error) {
+			return nil, "", errors.New("boom")
+		}
+		t.Cleanup(func() { benchmarkProviderFromConfig = oldBenchProvider })
+
+		if _, err := runCLI(t, "benchmark", "--dataset", "mmlu", "--sample-size", "1", "--providers", "a,b"); err == nil || !strings.Contains(err.Error(), "all 2 provider(s)") {
+			t.Fatalf("expected all-failed error, got %v", err)
+		}
+	})
+
 	t.Run("redteam_single_and_all", func(t *testing.T) {
 		if _, err := runCLI(t, "redteam", "--prompt", "p1", "--output", "table"); err != nil {
 			t.Fatalf("redteam single: %v", err)
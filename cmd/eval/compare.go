@@ -14,17 +14,25 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/app"
 	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/prompt"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
 )
 
 var errRegression = errors.New("ai-eval: regression detected")
 
 type compareOptions struct {
-	promptName string
-	v1         string
-	v2         string
-	trials     int
-	output     string
+	promptName                string
+	v1                        string
+	v2                        string
+	baselineRun               string
+	versions                  []string
+	trials                    int
+	output                    string
+	commentFile               string
+	precision                 int
+	requireLatencyImprovement float64
+	requireCostImprovement    float64
 }
 
 func newCompareCmd(st *cliState) *cobra.Command {
@@ -48,14 +56,19 @@ func newCompareCmd(st *cliState) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&opts.promptName, "prompt", "", "prompt name to compare")
-	cmd.Flags().StringVar(&opts.v1, "v1", "", "version 1")
+	cmd.Flags().StringVar(&opts.v1, "v1", "", "version 1 (mutually exclusive with --baseline-run)")
 	cmd.Flags().StringVar(&opts.v2, "v2", "", "version 2")
+	cmd.Flags().StringVar(&opts.baselineRun, "baseline-run", "", "run ID to use as v1 instead of re-executing it; v1's results are reconstructed from the store, only v2 is run")
+	cmd.Flags().StringArrayVar(&opts.versions, "version", nil, "prompt version to include in a matrix compare (repeatable, at least 2 required); mutually exclusive with --v1/--v2/--baseline-run")
 	cmd.Flags().IntVar(&opts.trials, "trials", -1, "number of trials per case (overrides config)")
 	cmd.Flags().StringVar(&opts.output, "output", "", "output format: table|json|github")
+	cmd.Flags().StringVar(&opts.commentFile, "comment-file", "", "write a markdown PR comment summarizing the comparison to this path")
+	cmd.Flags().IntVar(&opts.precision, "precision", -1, "significant digits for pass_rate/avg_score/score in table/github/comment output (overrides config; JSON is always full precision)")
+	cmd.Flags().Float64Var(&opts.requireLatencyImprovement, "require-latency-improvement", 0, "minimum fractional reduction in total latency required from v1 to v2, e.g. 0.1 means v2 must be at least 10% faster (0 disables the gate); an unmet gate fails compare like a regression")
+	cmd.Flags().Float64Var(&opts.requireCostImprovement, "require-cost-improvement", 0, "minimum fractional reduction in total tokens (used as a cost proxy) required from v1 to v2, e.g. 0.1 means v2 must use at least 10% fewer tokens (0 disables the gate); an unmet gate fails compare like a regression")
 
 	_ = cmd.MarkFlagRequired("prompt")
-	_ = cmd.MarkFlagRequired("v1")
-	_ = cmd.MarkFlagRequired("v2")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
 
 	return cmd
 }
@@ -71,14 +84,28 @@ func runCompare(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
 		return fmt.Errorf("compare: missing config (internal error)")
 	}
 
+	if len(opts.versions) > 0 {
+		if opts.v1 != "" || opts.v2 != "" || opts.baselineRun != "" {
+			return fmt.Errorf("compare: --version is mutually exclusive with --v1/--v2/--baseline-run")
+		}
+		return runCompareMatrix(cmd, st, opts)
+	}
+
 	promptName := strings.TrimSpace(opts.promptName)
 	if promptName == "" {
 		return fmt.Errorf("compare: missing --prompt")
 	}
 	v1Version := strings.TrimSpace(opts.v1)
 	v2Version := strings.TrimSpace(opts.v2)
-	if v1Version == "" || v2Version == "" {
-		return fmt.Errorf("compare: missing --v1/--v2")
+	baselineRun := strings.TrimSpace(opts.baselineRun)
+	if v2Version == "" {
+		return fmt.Errorf("compare: missing --v2")
+	}
+	if v1Version == "" && baselineRun == "" {
+		return fmt.Errorf("compare: missing --v1 or --baseline-run")
+	}
+	if v1Version != "" && baselineRun != "" {
+		return fmt.Errorf("compare: --v1 and --baseline-run are mutually exclusive")
 	}
 	if v1Version == v2Version {
 		return fmt.Errorf("compare: --v1 and --v2 must differ")
@@ -89,6 +116,7 @@ func runCompare(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
 	if err != nil {
 		return fmt.Errorf("compare: %w", err)
 	}
+	precision := resolvePrecision(opts.precision, st.cfg.Evaluation.Precision)
 
 	trials := st.cfg.Evaluation.Trials
 	if opts.trials >= 0 {
@@ -103,6 +131,13 @@ func runCompare(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
 		return fmt.Errorf("compare: threshold must be between 0 and 1 (got %v)", threshold)
 	}
 
+	if opts.requireLatencyImprovement < 0 || opts.requireLatencyImprovement >= 1 {
+		return fmt.Errorf("compare: --require-latency-improvement must be in [0, 1) (got %v)", opts.requireLatencyImprovement)
+	}
+	if opts.requireCostImprovement < 0 || opts.requireCostImprovement >= 1 {
+		return fmt.Errorf("compare: --require-cost-improvement must be in [0, 1) (got %v)", opts.requireCostImprovement)
+	}
+
 	concurrency := st.cfg.Evaluation.Concurrency
 	if concurrency <= 0 {
 		concurrency = 1
@@ -113,9 +148,21 @@ func runCompare(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
 		return err
 	}
 
-	p1, err := app.FindPromptByNameVersion(prompts, promptName, v1Version)
-	if err != nil {
-		return err
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var p1 *prompt.Prompt
+	var baselineSuites map[string]*store.SuiteRecord
+	if baselineRun != "" {
+		baselineSuites, v1Version, err = loadBaselineSuiteRecords(ctx, st, baselineRun, promptName)
+		if err != nil {
+			return err
+		}
+	} else {
+		p1, err = app.FindPromptByNameVersion(prompts, promptName, v1Version)
+		if err != nil {
+			return err
+		}
 	}
 	p2, err := app.FindPromptByNameVersion(prompts, promptName, v2Version)
 	if err != nil {
@@ -132,52 +179,54 @@ func runCompare(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
 	}
 	sort.Slice(suites, func(i, j int) bool { return strings.ToLower(suites[i].Suite) < strings.ToLower(suites[j].Suite) })
 
-	provider, err := defaultProviderFromConfig(st.cfg)
+	r, err := newCompareRunner(st, trials, threshold, concurrency)
 	if err != nil {
-		return fmt.Errorf("compare: %w", err)
+		return err
 	}
 
-	reg := evaluator.NewRegistry()
-	reg.Register(evaluator.ExactEvaluator{})
-	reg.Register(evaluator.ContainsEvaluator{})
-	reg.Register(evaluator.NotContainsEvaluator{})
-	reg.Register(evaluator.RegexEvaluator{})
-	reg.Register(evaluator.JSONSchemaEvaluator{})
-
-	r := runner.NewRunner(provider, reg, runner.Config{
-		Trials:        trials,
-		PassThreshold: threshold,
-		Concurrency:   concurrency,
-		Timeout:       st.cfg.Evaluation.Timeout,
-	})
-
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
-	defer stop()
-
 	out := cmd.OutOrStdout()
 	regressed := false
 
 	switch output {
 	case FormatTable:
-		_, _ = fmt.Fprintf(out, "Prompt: %s v1=%s v2=%s\n\n", promptName, v1Version, v2Version)
+		if baselineRun != "" {
+			_, _ = fmt.Fprintf(out, "Prompt: %s v1=%s (from run %s) v2=%s\n\n", promptName, v1Version, baselineRun, v2Version)
+		} else {
+			_, _ = fmt.Fprintf(out, "Prompt: %s v1=%s v2=%s\n\n", promptName, v1Version, v2Version)
+		}
 	case FormatGitHub:
-		_, _ = fmt.Fprintf(out, "Summary: compare prompt=%s v1=%s v2=%s\n", promptName, v1Version, v2Version)
+		if baselineRun != "" {
+			_, _ = fmt.Fprintf(out, "Summary: compare prompt=%s v1=%s (from run %s) v2=%s\n", promptName, v1Version, baselineRun, v2Version)
+		} else {
+			_, _ = fmt.Fprintf(out, "Summary: compare prompt=%s v1=%s v2=%s\n", promptName, v1Version, v2Version)
+		}
 	case FormatJSON:
-		meta := map[string]any{
-			"compare": map[string]any{
-				"prompt": promptName,
-				"v1":     v1Version,
-				"v2":     v2Version,
-			},
+		compareMeta := map[string]any{
+			"prompt": promptName,
+			"v1":     v1Version,
+			"v2":     v2Version,
 		}
+		if baselineRun != "" {
+			compareMeta["baseline_run"] = baselineRun
+		}
+		meta := map[string]any{"compare": compareMeta}
 		if b, err := json.Marshal(meta); err == nil {
 			_, _ = fmt.Fprintln(out, string(b))
 		}
 	}
 
+	var pairs []compareSuitePair
+	var v1Latency, v2Latency, v1Tokens, v2Tokens int64
+
 	for _, suite := range suites {
-		res1, _ := r.RunSuite(ctx, p1, suite)
+		var res1 *runner.SuiteResult
+		if baselineRun != "" {
+			res1 = suiteResultFromRecord(baselineSuites[suite.Suite])
+		} else {
+			res1, _ = r.RunSuite(ctx, p1, suite)
+		}
 		res2, _ := r.RunSuite(ctx, p2, suite)
+		pairs = append(pairs, compareSuitePair{V1: res1, V2: res2})
 
 		_, diffs := buildCompare(res1, res2)
 		for _, d := range diffs {
@@ -187,7 +236,38 @@ func runCompare(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
 			}
 		}
 
-		_, _ = fmt.Fprint(out, FormatCompareResult(res1, res2, output))
+		if res1 != nil {
+			v1Latency += res1.TotalLatency
+			v1Tokens += int64(res1.TotalTokens)
+		}
+		if res2 != nil {
+			v2Latency += res2.TotalLatency
+			v2Tokens += int64(res2.TotalTokens)
+		}
+
+		_, _ = fmt.Fprint(out, FormatCompareResult(res1, res2, output, precision))
+	}
+
+	if opts.requireLatencyImprovement > 0 {
+		met := meetsImprovementGate(v1Latency, v2Latency, opts.requireLatencyImprovement)
+		_, _ = fmt.Fprintf(out, "Gate: latency v1=%dms v2=%dms required_improvement=%.3f met=%v\n", v1Latency, v2Latency, opts.requireLatencyImprovement, met)
+		if !met {
+			regressed = true
+		}
+	}
+	if opts.requireCostImprovement > 0 {
+		met := meetsImprovementGate(v1Tokens, v2Tokens, opts.requireCostImprovement)
+		_, _ = fmt.Fprintf(out, "Gate: cost(tokens) v1=%d v2=%d required_improvement=%.3f met=%v\n", v1Tokens, v2Tokens, opts.requireCostImprovement, met)
+		if !met {
+			regressed = true
+		}
+	}
+
+	if commentFile := strings.TrimSpace(opts.commentFile); commentFile != "" {
+		body := buildComparePRComment(promptName, v1Version, v2Version, pairs, precision)
+		if err := os.WriteFile(commentFile, []byte(body), 0o644); err != nil {
+			return fmt.Errorf("compare: write --comment-file: %w", err)
+		}
 	}
 
 	if regressed {
@@ -195,3 +275,49 @@ func runCompare(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
 	}
 	return nil
 }
+
+// newCompareRunner builds the runner.Runner used to execute prompt versions
+// for both a two-way compare and a --version matrix compare, wiring the same
+// evaluators and config-derived settings either path needs.
+func newCompareRunner(st *cliState, trials int, threshold float64, concurrency int) (*runner.Runner, error) {
+	provider, err := defaultProviderFromConfig(st.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("compare: %w", err)
+	}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	reg.Register(evaluator.NotContainsEvaluator{})
+	reg.Register(evaluator.RegexEvaluator{})
+	reg.Register(evaluator.JSONSchemaEvaluator{})
+	reg.Register(evaluator.OpenAPIEvaluator{})
+	reg.Register(evaluator.DiversityEvaluator{})
+	reg.Register(evaluator.ConsistencyEvaluator{})
+
+	return runner.NewRunner(provider, reg, runner.Config{
+		Trials:               trials,
+		PassThreshold:        threshold,
+		Concurrency:          concurrency,
+		EvaluatorConcurrency: st.cfg.Evaluation.EvaluatorConcurrency,
+		Timeout:              st.cfg.Evaluation.Timeout,
+		MaxStepsHardFail:     st.cfg.Evaluation.MaxStepsHardFail,
+		StrictSafety:         st.cfg.Evaluation.StrictSafety,
+		ScoreEpsilon:         st.cfg.Evaluation.ScoreEpsilon,
+		PromptWrapper:        st.cfg.Evaluation.PromptWrapper,
+	}), nil
+}
+
+// meetsImprovementGate reports whether v2 is at least minFraction lower than
+// v1 (e.g. minFraction=0.1 requires v2 <= 90% of v1). A gate of 0 always
+// passes; a non-positive v1 baseline can't be meaningfully improved on and
+// fails any positive gate.
+func meetsImprovementGate(v1, v2 int64, minFraction float64) bool {
+	if minFraction <= 0 {
+		return true
+	}
+	if v1 <= 0 {
+		return false
+	}
+	return float64(v1-v2)/float64(v1) >= minFraction
+}
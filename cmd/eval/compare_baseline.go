@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+// loadBaselineSuiteRecords fetches the suite results for baselineRun and
+// returns those belonging to promptName, keyed by suite name, along with the
+// prompt version they were run against (taken from the first matching
+// record; a baseline run only ever covers one version of a prompt). Errors
+// clearly if the run has no suites for promptName at all.
+func loadBaselineSuiteRecords(ctx context.Context, st *cliState, baselineRun, promptName string) (map[string]*store.SuiteRecord, string, error) {
+	if st == nil || st.cfg == nil {
+		return nil, "", fmt.Errorf("compare: missing config (internal error)")
+	}
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return nil, "", fmt.Errorf("compare: open store: %w", err)
+	}
+	defer stor.Close()
+
+	records, err := stor.GetSuiteResults(ctx, baselineRun)
+	if err != nil {
+		return nil, "", fmt.Errorf("compare: get baseline run %q: %w", baselineRun, err)
+	}
+
+	out := make(map[string]*store.SuiteRecord)
+	version := ""
+	for _, sr := range records {
+		if sr.PromptName != promptName {
+			continue
+		}
+		out[sr.SuiteName] = sr
+		if version == "" {
+			version = sr.PromptVersion
+		}
+	}
+	if len(out) == 0 {
+		return nil, "", fmt.Errorf("compare: baseline run %q has no suites for prompt %q", baselineRun, promptName)
+	}
+
+	return out, version, nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func TestLoadBaselineSuiteRecords(t *testing.T) {
+	t.Parallel()
+
+	if _, _, err := loadBaselineSuiteRecords(context.Background(), nil, "run_1", "p1"); err == nil {
+		t.Fatalf("expected error for nil cli state")
+	}
+	if _, _, err := loadBaselineSuiteRecords(context.Background(), &cliState{}, "run_1", "p1"); err == nil {
+		t.Fatalf("expected error for nil config")
+	}
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "run.db")
+	fileStore, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	runs := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Suite:         &testcase.TestSuite{Suite: "s1"},
+		Result:        &runner.SuiteResult{Suite: "s1", TotalCases: 1, PassedCases: 1, PassRate: 1, AvgScore: 1},
+	}}
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldRunIDFunc := app.RunIDFunc
+	app.RunIDFunc = func() (string, error) { return "run_baseline", nil }
+	t.Cleanup(func() { app.RunIDFunc = oldRunIDFunc })
+	if _, err := app.SaveRun(context.Background(), fileStore, runs, app.RunSummary{TotalSuites: 1}, started, started.Add(time.Second), nil, false, nil); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if err := fileStore.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
+
+	if _, _, err := loadBaselineSuiteRecords(context.Background(), st, "run_baseline", "other-prompt"); err == nil || !strings.Contains(err.Error(), "no suites for prompt") {
+		t.Fatalf("expected no-suites error, got %v", err)
+	}
+
+	records, version, err := loadBaselineSuiteRecords(context.Background(), st, "run_baseline", "p1")
+	if err != nil {
+		t.Fatalf("loadBaselineSuiteRecords: %v", err)
+	}
+	if version != "v1" {
+		t.Fatalf("version: got %q want %q", version, "v1")
+	}
+	if records["s1"] == nil || records["s1"].TotalCases != 1 {
+		t.Fatalf("records: got %#v", records)
+	}
+}
+
+func TestRunCompare_BaselineRun(t *testing.T) {
+	cliIntegrationMu.Lock()
+	t.Cleanup(cliIntegrationMu.Unlock)
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "run.db")
+	fileStore, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	baselineRuns := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Suite:         &testcase.TestSuite{Suite: "s"},
+		Result: &runner.SuiteResult{
+			Suite: "s", TotalCases: 1, PassedCases: 1, PassRate: 1, AvgScore: 1,
+			Results: []runner.RunResult{{Suite: "s", CaseID: "c1", Passed: true, Score: 1}},
+		},
+	}}
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldRunIDFunc := app.RunIDFunc
+	app.RunIDFunc = func() (string, error) { return "run_baseline", nil }
+	t.Cleanup(func() { app.RunIDFunc = oldRunIDFunc })
+	if _, err := app.SaveRun(context.Background(), fileStore, baselineRuns, app.RunSummary{TotalSuites: 1}, started, started.Add(time.Second), nil, false, nil); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if err := fileStore.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	promptsDir := t.TempDir()
+	oldCwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	if err := os.Chdir(promptsDir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+	mkdirAll(t, defaultPromptsDir)
+	mkdirAll(t, defaultTestsDir)
+	writeFile(t, filepath.Join(defaultPromptsDir, "v2.yaml"), "name: p1\nversion: v2\ntemplate: hi\n")
+	writeFile(t, filepath.Join(defaultTestsDir, "s.yaml"), "suite: s\nprompt: p1\ncases:\n  - id: c1\n    input: {}\n    expected:\n      exact_match: ok\n")
+
+	oldProviderFromConfig := defaultProviderFromConfig
+	t.Cleanup(func() { defaultProviderFromConfig = oldProviderFromConfig })
+	defaultProviderFromConfig = func(*config.Config) (llm.Provider, error) { return &stubProvider{name: "stub"}, nil }
+
+	st := &cliState{cfg: &config.Config{
+		Storage:    config.StorageConfig{Type: "sqlite", Path: dbPath},
+		Evaluation: config.EvaluationConfig{Trials: 1, Threshold: 0.8, Concurrency: 1},
+	}}
+
+	cmd := &cobra.Command{}
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	opts := &compareOptions{promptName: "p1", v2: "v2", baselineRun: "run_baseline", trials: -1}
+	if err := runCompare(cmd, st, opts); err != nil {
+		t.Fatalf("runCompare: %v", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "from run run_baseline") {
+		t.Fatalf("expected output to note the baseline run, got %q", got)
+	}
+	if !strings.Contains(got, "v1=v1") {
+		t.Fatalf("expected v1 version resolved from the baseline run, got %q", got)
+	}
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/prompt"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+// runCompareMatrix handles `compare --version v1 --version v2 ...`: it runs
+// every listed prompt version against the same test suites and renders a
+// pass-rate matrix (one row per suite, one column per version, plus an
+// overall row), instead of the two-way v1/v2 diff runCompare renders.
+// Regressions are judged relative to the first version listed, per suite,
+// reusing buildCompare so the case-level regression rules stay identical
+// across two-way, baseline-run, and matrix compare.
+func runCompareMatrix(cmd *cobra.Command, st *cliState, opts *compareOptions) error {
+	promptName := strings.TrimSpace(opts.promptName)
+	if promptName == "" {
+		return fmt.Errorf("compare: missing --prompt")
+	}
+	versions := make([]string, 0, len(opts.versions))
+	for _, v := range opts.versions {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	if len(versions) < 2 {
+		return fmt.Errorf("compare: --version requires at least 2 versions (got %d)", len(versions))
+	}
+
+	output, err := resolveOutputFormat(opts.output, "", false)
+	if err != nil {
+		return fmt.Errorf("compare: %w", err)
+	}
+	precision := resolvePrecision(opts.precision, st.cfg.Evaluation.Precision)
+
+	trials := st.cfg.Evaluation.Trials
+	if opts.trials >= 0 {
+		trials = opts.trials
+	}
+	if trials <= 0 {
+		return fmt.Errorf("compare: trials must be > 0 (got %d)", trials)
+	}
+
+	threshold := st.cfg.Evaluation.Threshold
+	if threshold < 0 || threshold > 1 {
+		return fmt.Errorf("compare: threshold must be between 0 and 1 (got %v)", threshold)
+	}
+
+	concurrency := st.cfg.Evaluation.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	prompts, err := app.LoadPromptsRecursive(defaultPromptsDir)
+	if err != nil {
+		return err
+	}
+
+	prs := make([]*prompt.Prompt, 0, len(versions))
+	for _, v := range versions {
+		p, err := app.FindPromptByNameVersion(prompts, promptName, v)
+		if err != nil {
+			return err
+		}
+		prs = append(prs, p)
+	}
+
+	suites, err := app.LoadTestSuites(defaultTestsDir)
+	if err != nil {
+		return err
+	}
+	suites = app.FilterSuitesByPrompt(suites, promptName)
+	if len(suites) == 0 {
+		return fmt.Errorf("compare: no test suites found for prompt %q", promptName)
+	}
+	sort.Slice(suites, func(i, j int) bool { return strings.ToLower(suites[i].Suite) < strings.ToLower(suites[j].Suite) })
+
+	r, err := newCompareRunner(st, trials, threshold, concurrency)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// results[suite name][version] holds that suite's outcome for that version.
+	results := make(map[string]map[string]*runner.SuiteResult, len(suites))
+	for _, suite := range suites {
+		perVersion := make(map[string]*runner.SuiteResult, len(versions))
+		for i, v := range versions {
+			res, _ := r.RunSuite(ctx, prs[i], suite)
+			perVersion[v] = res
+		}
+		results[suite.Suite] = perVersion
+	}
+
+	regressed := buildMatrixRegressions(versions, results)
+
+	out := cmd.OutOrStdout()
+	switch output {
+	case FormatTable:
+		_, _ = fmt.Fprintf(out, "Prompt: %s versions=%s\n\n", promptName, strings.Join(versions, ","))
+		_, _ = fmt.Fprint(out, formatMatrixTable(suites, versions, results, precision))
+	case FormatGitHub:
+		_, _ = fmt.Fprint(out, formatMatrixGitHub(promptName, suites, versions, results, precision, regressed))
+	case FormatJSON:
+		_, _ = fmt.Fprintln(out, formatMatrixJSON(promptName, suites, versions, results, regressed))
+	}
+
+	if regressed {
+		return errRegression
+	}
+	return nil
+}
+
+// buildMatrixRegressions reports whether any version after versions[0]
+// regressed against it, on any suite, reusing buildCompare's per-case
+// regression rules.
+func buildMatrixRegressions(versions []string, results map[string]map[string]*runner.SuiteResult) bool {
+	baseline := versions[0]
+	for _, suiteResults := range results {
+		base := suiteResults[baseline]
+		for _, v := range versions[1:] {
+			summary, _ := buildCompare(base, suiteResults[v])
+			if summary.Regressed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matrixOverall computes the weighted (by total cases) pass rate and average
+// score for a version across all suites, mirroring how buildCompare treats a
+// single suite's PassRate/AvgScore but rolled up across the whole matrix.
+func matrixOverall(suites []string, version string, results map[string]map[string]*runner.SuiteResult) (passRate, avgScore float64) {
+	var totalCases int
+	var passedCases int
+	var scoreSum float64
+	for _, suite := range suites {
+		res := results[suite][version]
+		if res == nil {
+			continue
+		}
+		totalCases += res.TotalCases
+		passedCases += res.PassedCases
+		scoreSum += res.AvgScore * float64(res.TotalCases)
+	}
+	if totalCases == 0 {
+		return 0, 0
+	}
+	return float64(passedCases) / float64(totalCases), scoreSum / float64(totalCases)
+}
+
+func formatMatrixTable(suites []*testcase.TestSuite, versions []string, results map[string]map[string]*runner.SuiteResult, precision int) string {
+	var buf strings.Builder
+	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	header := "SUITE"
+	for _, v := range versions {
+		header += "\t" + v
+	}
+	fmt.Fprintln(tw, header)
+
+	names := make([]string, 0, len(suites))
+	for _, s := range suites {
+		names = append(names, s.Suite)
+	}
+
+	for _, name := range names {
+		row := name
+		for _, v := range versions {
+			res := results[name][v]
+			passRate := 0.0
+			if res != nil {
+				passRate = res.PassRate
+			}
+			row += "\t" + formatMetric(passRate, precision)
+		}
+		fmt.Fprintln(tw, row)
+	}
+
+	overall := "OVERALL"
+	for _, v := range versions {
+		passRate, _ := matrixOverall(names, v, results)
+		overall += "\t" + formatMetric(passRate, precision)
+	}
+	fmt.Fprintln(tw, overall)
+
+	_ = tw.Flush()
+	buf.WriteByte('\n')
+	return buf.String()
+}
+
+func formatMatrixGitHub(promptName string, suites []*testcase.TestSuite, versions []string, results map[string]map[string]*runner.SuiteResult, precision int, regressed bool) string {
+	var buf strings.Builder
+	names := make([]string, 0, len(suites))
+	for _, s := range suites {
+		names = append(names, s.Suite)
+	}
+
+	for _, name := range names {
+		var parts []string
+		for _, v := range versions {
+			res := results[name][v]
+			passRate := 0.0
+			if res != nil {
+				passRate = res.PassRate
+			}
+			parts = append(parts, fmt.Sprintf("%s=%s", v, formatMetric(passRate, precision)))
+		}
+		fmt.Fprintf(&buf, "Summary: suite=%s %s\n", name, strings.Join(parts, " "))
+	}
+
+	var overallParts []string
+	for _, v := range versions {
+		passRate, _ := matrixOverall(names, v, results)
+		overallParts = append(overallParts, fmt.Sprintf("%s=%s", v, formatMetric(passRate, precision)))
+	}
+	fmt.Fprintf(&buf, "Summary: compare prompt=%s overall %s\n", promptName, strings.Join(overallParts, " "))
+
+	if regressed {
+		fmt.Fprintf(&buf, "::error::compare prompt=%s regression against baseline version %s\n", promptName, versions[0])
+	}
+
+	return buf.String()
+}
+
+type jsonMatrixResult struct {
+	Prompt    string              `json:"prompt"`
+	Baseline  string              `json:"baseline_version"`
+	Versions  []jsonMatrixVersion `json:"versions"`
+	Regressed bool                `json:"regressed"`
+}
+
+type jsonMatrixVersion struct {
+	Version string                   `json:"version"`
+	Suites  []jsonMatrixSuiteSummary `json:"suites"`
+	Overall jsonCompareSummary       `json:"overall"`
+}
+
+type jsonMatrixSuiteSummary struct {
+	Suite    string  `json:"suite"`
+	PassRate float64 `json:"pass_rate"`
+	AvgScore float64 `json:"avg_score"`
+}
+
+func formatMatrixJSON(promptName string, suites []*testcase.TestSuite, versions []string, results map[string]map[string]*runner.SuiteResult, regressed bool) string {
+	names := make([]string, 0, len(suites))
+	for _, s := range suites {
+		names = append(names, s.Suite)
+	}
+
+	out := jsonMatrixResult{
+		Prompt:    promptName,
+		Baseline:  versions[0],
+		Versions:  make([]jsonMatrixVersion, 0, len(versions)),
+		Regressed: regressed,
+	}
+
+	for _, v := range versions {
+		mv := jsonMatrixVersion{Version: v, Suites: make([]jsonMatrixSuiteSummary, 0, len(names))}
+		for _, name := range names {
+			res := results[name][v]
+			if res == nil {
+				mv.Suites = append(mv.Suites, jsonMatrixSuiteSummary{Suite: name})
+				continue
+			}
+			mv.Suites = append(mv.Suites, jsonMatrixSuiteSummary{Suite: name, PassRate: res.PassRate, AvgScore: res.AvgScore})
+		}
+		passRate, avgScore := matrixOverall(names, v, results)
+		mv.Overall = jsonCompareSummary{PassRate: passRate, AvgScore: avgScore}
+		out.Versions = append(out.Versions, mv)
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Sprintf("{\"error\":%q}", err.Error())
+	}
+	return string(b)
+}
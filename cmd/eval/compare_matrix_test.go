@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+)
+
+func TestRunCompareMatrix_Table(t *testing.T) {
+	cliIntegrationMu.Lock()
+	t.Cleanup(cliIntegrationMu.Unlock)
+
+	dir := t.TempDir()
+	oldCwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+	mkdirAll(t, defaultPromptsDir)
+	mkdirAll(t, defaultTestsDir)
+	writeFile(t, filepath.Join(defaultPromptsDir, "v1.yaml"), "name: p1\nversion: v1\ntemplate: right\n")
+	writeFile(t, filepath.Join(defaultPromptsDir, "v2.yaml"), "name: p1\nversion: v2\ntemplate: right\n")
+	writeFile(t, filepath.Join(defaultPromptsDir, "v3.yaml"), "name: p1\nversion: v3\ntemplate: wrong\n")
+	writeFile(t, filepath.Join(defaultTestsDir, "s.yaml"), "suite: s\nprompt: p1\ncases:\n  - id: c1\n    input: {}\n    expected:\n      exact_match: right\n")
+
+	oldProviderFromConfig := defaultProviderFromConfig
+	t.Cleanup(func() { defaultProviderFromConfig = oldProviderFromConfig })
+	defaultProviderFromConfig = func(*config.Config) (llm.Provider, error) {
+		return &stubProvider{
+			name: "stub",
+			completeWithTools: func(req *llm.Request) string {
+				if req != nil && len(req.Messages) > 0 {
+					return req.Messages[0].Content
+				}
+				return ""
+			},
+		}, nil
+	}
+
+	st := &cliState{cfg: &config.Config{Evaluation: config.EvaluationConfig{Trials: 1, Threshold: 0.8, Concurrency: 1}}}
+
+	cmd := &cobra.Command{}
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	opts := &compareOptions{promptName: "p1", versions: []string{"v1", "v2", "v3"}, trials: -1}
+	if err := runCompare(cmd, st, opts); err != errRegression {
+		t.Fatalf("runCompare: got %v, want errRegression (v3 regresses against v1)", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "v1") || !strings.Contains(got, "v2") || !strings.Contains(got, "v3") {
+		t.Fatalf("expected table to mention every version, got %q", got)
+	}
+	if !strings.Contains(got, "OVERALL") {
+		t.Fatalf("expected an OVERALL row, got %q", got)
+	}
+}
+
+func TestRunCompareMatrix_JSON(t *testing.T) {
+	cliIntegrationMu.Lock()
+	t.Cleanup(cliIntegrationMu.Unlock)
+
+	dir := t.TempDir()
+	oldCwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+	mkdirAll(t, defaultPromptsDir)
+	mkdirAll(t, defaultTestsDir)
+	writeFile(t, filepath.Join(defaultPromptsDir, "v1.yaml"), "name: p1\nversion: v1\ntemplate: right\n")
+	writeFile(t, filepath.Join(defaultPromptsDir, "v2.yaml"), "name: p1\nversion: v2\ntemplate: right\n")
+	writeFile(t, filepath.Join(defaultTestsDir, "s.yaml"), "suite: s\nprompt: p1\ncases:\n  - id: c1\n    input: {}\n    expected:\n      exact_match: right\n")
+
+	oldProviderFromConfig := defaultProviderFromConfig
+	t.Cleanup(func() { defaultProviderFromConfig = oldProviderFromConfig })
+	defaultProviderFromConfig = func(*config.Config) (llm.Provider, error) {
+		return &stubProvider{
+			name: "stub",
+			completeWithTools: func(req *llm.Request) string {
+				if req != nil && len(req.Messages) > 0 {
+					return req.Messages[0].Content
+				}
+				return ""
+			},
+		}, nil
+	}
+
+	st := &cliState{cfg: &config.Config{Evaluation: config.EvaluationConfig{Trials: 1, Threshold: 0.8, Concurrency: 1}}}
+
+	cmd := &cobra.Command{}
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	opts := &compareOptions{promptName: "p1", versions: []string{"v1", "v2"}, trials: -1, output: "json"}
+	if err := runCompare(cmd, st, opts); err != nil {
+		t.Fatalf("runCompare: %v", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, `"versions"`) || !strings.Contains(got, `"baseline_version":"v1"`) {
+		t.Fatalf("expected versions array with baseline_version, got %q", got)
+	}
+	if strings.Contains(got, `"regressed":true`) {
+		t.Fatalf("expected no regression between two identical prompts, got %q", got)
+	}
+}
+
+func TestMatrixOverall(t *testing.T) {
+	t.Parallel()
+
+	results := map[string]map[string]*runner.SuiteResult{
+		"s1": {"v1": {TotalCases: 2, PassedCases: 1, AvgScore: 0.5}},
+		"s2": {"v1": {TotalCases: 2, PassedCases: 2, AvgScore: 1.0}},
+	}
+	passRate, avgScore := matrixOverall([]string{"s1", "s2"}, "v1", results)
+	if passRate != 0.75 {
+		t.Fatalf("passRate: got %v want 0.75", passRate)
+	}
+	if avgScore != 0.75 {
+		t.Fatalf("avgScore: got %v want 0.75", avgScore)
+	}
+
+	if passRate, avgScore := matrixOverall([]string{"missing"}, "v1", results); passRate != 0 || avgScore != 0 {
+		t.Fatalf("expected zero for missing suite, got %v/%v", passRate, avgScore)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+// checkRegressionsAgainstPrevious compares each just-completed suite run
+// against the most recent prior run of the same prompt/version in the
+// store, prints per-suite deltas to out, and reports whether any suite
+// regressed beyond threshold. It is a no-op (no output, no regression)
+// for prompts that have no prior run recorded yet.
+func checkRegressionsAgainstPrevious(ctx context.Context, st *cliState, out io.Writer, runs []app.SuiteRun, threshold float64) (bool, error) {
+	if st == nil || st.cfg == nil {
+		return false, fmt.Errorf("run: missing config (internal error)")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return false, fmt.Errorf("run: open store: %w", err)
+	}
+	defer stor.Close()
+
+	regressed := false
+	prevRunID := make(map[string]string) // promptName+version -> resolved previous run id
+	for _, run := range runs {
+		if run.Result == nil {
+			continue
+		}
+
+		cacheKey := run.PromptName + "@" + run.PromptVersion
+		runID, ok := prevRunID[cacheKey]
+		if !ok {
+			prior, err := stor.ListRuns(ctx, store.RunFilter{
+				PromptName:    run.PromptName,
+				PromptVersion: run.PromptVersion,
+				Limit:         1,
+			})
+			if err != nil {
+				return false, fmt.Errorf("run: list previous runs: %w", err)
+			}
+			if len(prior) > 0 {
+				runID = prior[0].ID
+			}
+			prevRunID[cacheKey] = runID
+		}
+		if runID == "" {
+			_, _ = fmt.Fprintf(out, "Compare-previous: prompt=%s suite=%s no prior run found, skipping\n", run.PromptName, run.Result.Suite)
+			continue
+		}
+
+		prevSuites, err := stor.GetSuiteResults(ctx, runID)
+		if err != nil {
+			return false, fmt.Errorf("run: get previous suite results: %w", err)
+		}
+
+		var prev *store.SuiteRecord
+		for _, sr := range prevSuites {
+			if sr.SuiteName == run.Result.Suite {
+				prev = sr
+				break
+			}
+		}
+		if prev == nil {
+			_, _ = fmt.Fprintf(out, "Compare-previous: prompt=%s suite=%s no prior result for this suite, skipping\n", run.PromptName, run.Result.Suite)
+			continue
+		}
+
+		passRateDelta := run.Result.PassRate - prev.PassRate
+		avgScoreDelta := run.Result.AvgScore - prev.AvgScore
+		suiteRegressed := passRateDelta < -threshold || avgScoreDelta < -threshold
+
+		_, _ = fmt.Fprintf(out, "Compare-previous: prompt=%s suite=%s pass_rate=%.3f->%.3f (%+.3f) avg_score=%.3f->%.3f (%+.3f) regression=%v\n",
+			run.PromptName, run.Result.Suite, prev.PassRate, run.Result.PassRate, passRateDelta, prev.AvgScore, run.Result.AvgScore, avgScoreDelta, suiteRegressed)
+
+		if suiteRegressed {
+			regressed = true
+		}
+	}
+
+	return regressed, nil
+}
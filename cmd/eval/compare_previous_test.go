@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func TestCheckRegressionsAgainstPrevious_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := checkRegressionsAgainstPrevious(context.Background(), nil, &bytes.Buffer{}, nil, 0); err == nil {
+		t.Fatalf("expected error for nil cli state")
+	}
+	if _, err := checkRegressionsAgainstPrevious(context.Background(), &cliState{}, &bytes.Buffer{}, nil, 0); err == nil {
+		t.Fatalf("expected error for nil config")
+	}
+}
+
+func TestCheckRegressionsAgainstPrevious_NoPriorRun(t *testing.T) {
+	t.Parallel()
+
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "memory"}}}
+	runs := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Result:        &runner.SuiteResult{Suite: "s1", PassRate: 1, AvgScore: 1},
+	}}
+
+	var buf bytes.Buffer
+	regressed, err := checkRegressionsAgainstPrevious(context.Background(), st, &buf, runs, 0)
+	if err != nil {
+		t.Fatalf("checkRegressionsAgainstPrevious: %v", err)
+	}
+	if regressed {
+		t.Fatalf("expected no regression when there is no prior run")
+	}
+	if !strings.Contains(buf.String(), "no prior run found") {
+		t.Fatalf("expected note about missing prior run, got %q", buf.String())
+	}
+}
+
+func TestCheckRegressionsAgainstPrevious_RegressionDetected(t *testing.T) {
+	t.Parallel()
+
+	// checkRegressionsAgainstPrevious opens its own store from config, so
+	// exercise it against a file-backed sqlite path shared across the
+	// "previous run" write and the function's own read.
+	dir := t.TempDir()
+	dbPath := dir + "/run.db"
+	fileStore, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	prevRuns := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Suite:         &testcase.TestSuite{Suite: "s1"},
+		Result:        &runner.SuiteResult{Suite: "s1", TotalCases: 2, PassedCases: 2, PassRate: 1, AvgScore: 0.95},
+	}}
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := app.SaveRun(context.Background(), fileStore, prevRuns, app.RunSummary{TotalSuites: 1}, started, started.Add(time.Second), nil, false, nil); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if err := fileStore.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	cfg := &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}
+	st := &cliState{cfg: cfg}
+
+	curRuns := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Result:        &runner.SuiteResult{Suite: "s1", TotalCases: 2, PassedCases: 1, PassRate: 0.5, AvgScore: 0.6},
+	}}
+
+	var buf bytes.Buffer
+	regressed, err := checkRegressionsAgainstPrevious(context.Background(), st, &buf, curRuns, 0)
+	if err != nil {
+		t.Fatalf("checkRegressionsAgainstPrevious: %v", err)
+	}
+	if !regressed {
+		t.Fatalf("expected regression to be detected, got output: %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "regression=true") {
+		t.Fatalf("expected regression=true in output, got %q", buf.String())
+	}
+}
+
+func TestCheckRegressionsAgainstPrevious_WithinThresholdIsNotRegression(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbPath := dir + "/run.db"
+	fileStore, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	prevRuns := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Suite:         &testcase.TestSuite{Suite: "s1"},
+		Result:        &runner.SuiteResult{Suite: "s1", TotalCases: 2, PassedCases: 2, PassRate: 1, AvgScore: 0.95},
+	}}
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := app.SaveRun(context.Background(), fileStore, prevRuns, app.RunSummary{TotalSuites: 1}, started, started.Add(time.Second), nil, false, nil); err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if err := fileStore.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	cfg := &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}
+	st := &cliState{cfg: cfg}
+
+	curRuns := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Result:        &runner.SuiteResult{Suite: "s1", TotalCases: 2, PassedCases: 2, PassRate: 0.97, AvgScore: 0.93},
+	}}
+
+	var buf bytes.Buffer
+	regressed, err := checkRegressionsAgainstPrevious(context.Background(), st, &buf, curRuns, 0.05)
+	if err != nil {
+		t.Fatalf("checkRegressionsAgainstPrevious: %v", err)
+	}
+	if regressed {
+		t.Fatalf("expected small drop within threshold to not count as regression, got output: %q", buf.String())
+	}
+}
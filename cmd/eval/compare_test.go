@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestMeetsImprovementGate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		v1, v2      int64
+		minFraction float64
+		want        bool
+	}{
+		{name: "gate disabled", v1: 100, v2: 100, minFraction: 0, want: true},
+		{name: "exact improvement met", v1: 100, v2: 90, minFraction: 0.1, want: true},
+		{name: "improvement exceeded", v1: 100, v2: 50, minFraction: 0.1, want: true},
+		{name: "improvement short", v1: 100, v2: 95, minFraction: 0.1, want: false},
+		{name: "v2 regressed", v1: 100, v2: 110, minFraction: 0.1, want: false},
+		{name: "zero baseline", v1: 0, v2: 0, minFraction: 0.1, want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := meetsImprovementGate(tc.v1, tc.v2, tc.minFraction); got != tc.want {
+				t.Fatalf("meetsImprovementGate(%d, %d, %v): got %v want %v", tc.v1, tc.v2, tc.minFraction, got, tc.want)
+			}
+		})
+	}
+}
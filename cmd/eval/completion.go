@@ -0,0 +1,38 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+)
+
+// completePromptNames returns a cobra flag-completion function that suggests
+// prompt names by reading dir (normally defaultPromptsDir), for wiring onto
+// flags like --prompt via RegisterFlagCompletionFunc. Errors reading dir are
+// swallowed in favor of no suggestions, matching how the built-in shells
+// treat completion failures.
+func completePromptNames(dir string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		prompts, err := app.LoadPrompts(dir)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		seen := make(map[string]struct{}, len(prompts))
+		names := make([]string, 0, len(prompts))
+		for _, p := range prompts {
+			if !strings.HasPrefix(p.Name, toComplete) {
+				continue
+			}
+			if _, ok := seen[p.Name]; ok {
+				continue
+			}
+			seen[p.Name] = struct{}{}
+			names = append(names, p.Name)
+		}
+		sort.Strings(names)
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestCompletePromptNames(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("name: alpha\nversion: v1\ntemplate: hi\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "b.yaml"), []byte("name: beta\nversion: v1\ntemplate: hi\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	complete := completePromptNames(dir)
+
+	names, directive := complete(&cobra.Command{}, nil, "")
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Fatalf("directive: got %v", directive)
+	}
+	if len(names) != 2 || names[0] != "alpha" || names[1] != "beta" {
+		t.Fatalf("names: got %v", names)
+	}
+
+	names, _ = complete(&cobra.Command{}, nil, "al")
+	if len(names) != 1 || names[0] != "alpha" {
+		t.Fatalf("prefix filter: got %v", names)
+	}
+}
+
+func TestCompletePromptNames_MissingDir(t *testing.T) {
+	t.Parallel()
+
+	complete := completePromptNames(filepath.Join(t.TempDir(), "does-not-exist"))
+	names, directive := complete(&cobra.Command{}, nil, "")
+	if names != nil {
+		t.Fatalf("names: got %v, want nil", names)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Fatalf("directive: got %v", directive)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"os"
 	"path/filepath"
@@ -84,6 +86,13 @@ func TestConfigLoadErrorInCmdPreRuns(t *testing.T) {
 				return cmd.PersistentPreRunE(cmd, nil)
 			},
 		},
+		{
+			name: "baseline",
+			pre: func() error {
+				cmd := newBaselineCmd(st)
+				return cmd.PersistentPreRunE(cmd, nil)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -447,6 +456,123 @@ func TestRunEvaluations_ErrorPaths(t *testing.T) {
 		}
 	})
 
+	t.Run("jsonl_output_streams_case_and_summary_lines", func(t *testing.T) {
+		dir := mkWorkspace(t)
+		writePrompt(t, dir, "p1.yaml", "p1")
+		writeSuite(t, dir, "s.yaml", "s1", "p1")
+
+		oldCwd, _ := os.Getwd()
+		_ = os.Chdir(dir)
+		t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+		var out bytes.Buffer
+		jsonlCmd := &cobra.Command{}
+		jsonlCmd.SetOut(&out)
+		jsonlCmd.SetContext(context.Background())
+
+		st := &cliState{cfg: baseCfg()}
+		if err := runEvaluations(jsonlCmd, st, &runOptions{promptName: "p1", output: "jsonl", trials: -1, threshold: -1}); err != nil {
+			t.Fatalf("expected success, got %v", err)
+		}
+
+		lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+		if len(lines) > 0 && strings.HasPrefix(lines[0], "Seed:") {
+			lines = lines[1:]
+		}
+		if len(lines) != 2 {
+			t.Fatalf("expected one case line and one summary line, got %d: %q", len(lines), out.String())
+		}
+		var caseLine jsonlCaseLine
+		if err := json.Unmarshal([]byte(lines[0]), &caseLine); err != nil {
+			t.Fatalf("unmarshal case line: %v (%q)", err, lines[0])
+		}
+		if caseLine.Suite != "s1" || caseLine.CaseID != "c1" || !caseLine.Passed {
+			t.Fatalf("case line: got %#v", caseLine)
+		}
+		var sumLine jsonRunSummaryLine
+		if err := json.Unmarshal([]byte(lines[1]), &sumLine); err != nil {
+			t.Fatalf("unmarshal summary line: %v (%q)", err, lines[1])
+		}
+		if sumLine.Summary.TotalCases != 1 || sumLine.Summary.PassedCases != 1 {
+			t.Fatalf("summary line: got %#v", sumLine.Summary)
+		}
+	})
+
+	t.Run("junit_output_writes_testsuites_document", func(t *testing.T) {
+		dir := mkWorkspace(t)
+		writePrompt(t, dir, "p1.yaml", "p1")
+		writeSuite(t, dir, "s.yaml", "s1", "p1")
+
+		oldCwd, _ := os.Getwd()
+		_ = os.Chdir(dir)
+		t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+		var out bytes.Buffer
+		junitCmd := &cobra.Command{}
+		junitCmd.SetOut(&out)
+		junitCmd.SetContext(context.Background())
+
+		junitFile := filepath.Join(dir, "junit.xml")
+		st := &cliState{cfg: baseCfg()}
+		if err := runEvaluations(junitCmd, st, &runOptions{promptName: "p1", output: "junit", junitFile: junitFile, trials: -1, threshold: -1}); err != nil {
+			t.Fatalf("expected success, got %v", err)
+		}
+
+		var doc junitTestSuites
+		if err := xml.Unmarshal(out.Bytes(), &doc); err != nil {
+			t.Fatalf("unmarshal stdout junit xml: %v (%q)", err, out.String())
+		}
+		if len(doc.Suites) != 1 || len(doc.Suites[0].Cases) != 1 || doc.Suites[0].Cases[0].Name != "c1" {
+			t.Fatalf("junit doc: got %#v", doc)
+		}
+
+		fileBytes, err := os.ReadFile(junitFile)
+		if err != nil {
+			t.Fatalf("read --junit-file: %v", err)
+		}
+		var fileDoc junitTestSuites
+		if err := xml.Unmarshal(fileBytes, &fileDoc); err != nil {
+			t.Fatalf("unmarshal --junit-file: %v (%q)", err, string(fileBytes))
+		}
+		if len(fileDoc.Suites) != 1 || fileDoc.Suites[0].Cases[0].Name != "c1" {
+			t.Fatalf("junit file doc: got %#v", fileDoc)
+		}
+	})
+
+	t.Run("junit_file_without_junit_output_rejected", func(t *testing.T) {
+		dir := mkWorkspace(t)
+		writePrompt(t, dir, "p1.yaml", "p1")
+		writeSuite(t, dir, "s.yaml", "s1", "p1")
+
+		oldCwd, _ := os.Getwd()
+		_ = os.Chdir(dir)
+		t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+		st := &cliState{cfg: baseCfg()}
+		if err := runEvaluations(cmd, st, &runOptions{promptName: "p1", output: "table", junitFile: "out.xml", trials: -1, threshold: -1}); err == nil || !strings.Contains(err.Error(), "--junit-file requires --output junit") {
+			t.Fatalf("expected junit-file rejection error, got %v", err)
+		}
+	})
+
+	t.Run("canceled_context_skips_persistence", func(t *testing.T) {
+		dir := mkWorkspace(t)
+		writePrompt(t, dir, "p1.yaml", "p1")
+		writeSuite(t, dir, "s.yaml", "s1", "p1")
+
+		oldCwd, _ := os.Getwd()
+		_ = os.Chdir(dir)
+		t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+		canceledCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		st := &cliState{cfg: baseCfg()}
+		_, err := runOnce(canceledCtx, cmd, st, &runOptions{promptName: "p1", output: "table", trials: -1, threshold: -1})
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
 	t.Run("save_run_error", func(t *testing.T) {
 		dir := mkWorkspace(t)
 		writePrompt(t, dir, "p1.yaml", "p1")
@@ -492,8 +618,23 @@ func TestRunCompare_ErrorPaths(t *testing.T) {
 	if err := runCompare(cmd, st, &compareOptions{v1: "v1", v2: "v2"}); err == nil || !strings.Contains(err.Error(), "missing --prompt") {
 		t.Fatalf("expected missing prompt error, got %v", err)
 	}
-	if err := runCompare(cmd, st, &compareOptions{promptName: "p1"}); err == nil || !strings.Contains(err.Error(), "missing --v1/--v2") {
-		t.Fatalf("expected missing v1/v2 error, got %v", err)
+	if err := runCompare(cmd, st, &compareOptions{promptName: "p1"}); err == nil || !strings.Contains(err.Error(), "missing --v2") {
+		t.Fatalf("expected missing v2 error, got %v", err)
+	}
+	if err := runCompare(cmd, st, &compareOptions{promptName: "p1", v2: "v2"}); err == nil || !strings.Contains(err.Error(), "missing --v1 or --baseline-run") {
+		t.Fatalf("expected missing v1/baseline-run error, got %v", err)
+	}
+	if err := runCompare(cmd, st, &compareOptions{promptName: "p1", v1: "v1", v2: "v2", baselineRun: "run_1"}); err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Fatalf("expected mutually exclusive error, got %v", err)
+	}
+	if err := runCompare(cmd, st, &compareOptions{promptName: "p1", v1: "v1", versions: []string{"v1", "v2"}}); err == nil || !strings.Contains(err.Error(), "--version is mutually exclusive") {
+		t.Fatalf("expected --version mutually exclusive error, got %v", err)
+	}
+	if err := runCompare(cmd, st, &compareOptions{promptName: "p1", versions: []string{"v1"}}); err == nil || !strings.Contains(err.Error(), "at least 2 versions") {
+		t.Fatalf("expected too-few-versions error, got %v", err)
+	}
+	if err := runCompare(cmd, st, &compareOptions{versions: []string{"v1", "v2"}}); err == nil || !strings.Contains(err.Error(), "missing --prompt") {
+		t.Fatalf("expected missing prompt error for matrix compare, got %v", err)
 	}
 	if err := runCompare(cmd, st, &compareOptions{promptName: "p1", v1: "v1", v2: "v2", output: "wat"}); err == nil || !strings.Contains(err.Error(), "invalid --output") {
 		t.Fatalf("expected invalid output error, got %v", err)
@@ -509,6 +650,13 @@ func TestRunCompare_ErrorPaths(t *testing.T) {
 		t.Fatalf("expected threshold error, got %v", err)
 	}
 
+	if err := runCompare(cmd, st, &compareOptions{promptName: "p1", v1: "v1", v2: "v2", trials: -1, requireLatencyImprovement: 1}); err == nil || !strings.Contains(err.Error(), "--require-latency-improvement must be in [0, 1)") {
+		t.Fatalf("expected latency gate range error, got %v", err)
+	}
+	if err := runCompare(cmd, st, &compareOptions{promptName: "p1", v1: "v1", v2: "v2", trials: -1, requireCostImprovement: -0.5}); err == nil || !strings.Contains(err.Error(), "--require-cost-improvement must be in [0, 1)") {
+		t.Fatalf("expected cost gate range error, got %v", err)
+	}
+
 	t.Run("load_prompts_recursive_error", func(t *testing.T) {
 		dir := t.TempDir()
 		oldCwd, _ := os.Getwd()
@@ -725,18 +873,18 @@ func TestRunHistory_ErrorPaths(t *testing.T) {
 		t.Fatalf("expected list runs error")
 	}
 
-	if err := runHistoryShow(cmd, nil, "x"); err == nil || !strings.Contains(err.Error(), "missing config") {
+	if err := runHistoryShow(cmd, nil, "x", defaultPrecision, ""); err == nil || !strings.Contains(err.Error(), "missing config") {
 		t.Fatalf("expected missing config error, got %v", err)
 	}
-	if err := runHistoryShow(cmd, stMem, " "); err == nil || !strings.Contains(err.Error(), "missing run id") {
+	if err := runHistoryShow(cmd, stMem, " ", defaultPrecision, ""); err == nil || !strings.Contains(err.Error(), "missing run id") {
 		t.Fatalf("expected missing run id error, got %v", err)
 	}
-	if err := runHistoryShow(cmd, stBadStore, "x"); err == nil || !strings.Contains(err.Error(), "unsupported type") {
+	if err := runHistoryShow(cmd, stBadStore, "x", defaultPrecision, ""); err == nil || !strings.Contains(err.Error(), "unsupported type") {
 		t.Fatalf("expected open store error, got %v", err)
 	}
 
 	cmd.SetContext(ctxCanceled)
-	if err := runHistoryShow(cmd, stMem, "x"); err == nil {
+	if err := runHistoryShow(cmd, stMem, "x", defaultPrecision, ""); err == nil {
 		t.Fatalf("expected GetRun error")
 	}
 
@@ -773,7 +921,7 @@ func TestRunHistory_ErrorPaths(t *testing.T) {
 		cmd.SetOut(&bytes.Buffer{})
 		cmd.SetContext(context.Background())
 		st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
-		if err := runHistoryShow(cmd, st, "run_only"); err != nil {
+		if err := runHistoryShow(cmd, st, "run_only", defaultPrecision, ""); err != nil {
 			t.Fatalf("expected show to succeed, got %v", err)
 		}
 	})
@@ -825,7 +973,7 @@ func TestRunHistory_ErrorPaths(t *testing.T) {
 		cmd := &cobra.Command{}
 		cmd.SetContext(context.Background())
 		st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
-		if err := runHistoryShow(cmd, st, "run_bad"); err == nil {
+		if err := runHistoryShow(cmd, st, "run_bad", defaultPrecision, ""); err == nil {
 			t.Fatalf("expected suite results parse error")
 		}
 	})
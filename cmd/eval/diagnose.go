@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,19 +15,23 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
 	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/stellarlinkco/ai-eval/internal/evaluator"
 	"github.com/stellarlinkco/ai-eval/internal/llm"
 	"github.com/stellarlinkco/ai-eval/internal/optimizer"
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
 type diagnoseOptions struct {
 	promptPath string
+	promptsDir string
 	testsPath  string
 	output     string
+	runID      string
 }
 
 func newDiagnoseCmd(st *cliState) *cobra.Command {
@@ -50,8 +55,10 @@ func newDiagnoseCmd(st *cliState) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&opts.promptPath, "prompt", "", "path to prompt file (.yaml/.yml or plain text); if omitted, read from stdin")
+	cmd.Flags().StringVar(&opts.promptsDir, "prompts-dir", defaultPromptsDir, "directory to resolve the prompt from when --run is set and --prompt is omitted")
 	cmd.Flags().StringVar(&opts.testsPath, "tests", defaultTestsDir, "path to test suite file or directory")
 	cmd.Flags().StringVar(&opts.output, "output", "text", "output format: text|json")
+	cmd.Flags().StringVar(&opts.runID, "run", "", "reuse a stored run's case results instead of re-evaluating the suites")
 
 	return cmd
 }
@@ -87,6 +94,10 @@ func runDiagnose(cmd *cobra.Command, st *cliState, opts *diagnoseOptions) error
 		return fmt.Errorf("diagnose: %w", err)
 	}
 
+	if strings.TrimSpace(opts.runID) != "" {
+		return runDiagnoseFromStore(cmd, st, provider, opts, outFmt)
+	}
+
 	r, err := newRunnerFromConfig(provider, st.cfg)
 	if err != nil {
 		return err
@@ -140,6 +151,137 @@ func runDiagnose(cmd *cobra.Command, st *cliState, opts *diagnoseOptions) error
 	}
 }
 
+// runDiagnoseFromStore serves `diagnose --run <run_id>`: it reconstructs
+// runner.SuiteResult values from the stored CaseRecords instead of calling
+// r.RunSuite, so diagnosing a run already in the database doesn't cost a
+// fresh evaluation pass against the provider.
+func runDiagnoseFromStore(cmd *cobra.Command, st *cliState, provider llm.Provider, opts *diagnoseOptions, outFmt string) error {
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	runID := strings.TrimSpace(opts.runID)
+	if _, err := stor.GetRun(ctx, runID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("diagnose: run %q not found", runID)
+		}
+		return err
+	}
+
+	records, err := stor.GetSuiteResults(ctx, runID)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("diagnose: run %q has no suite results", runID)
+	}
+
+	storedPromptName, storedPromptVersion, err := singlePromptRef(records)
+	if err != nil {
+		return err
+	}
+
+	pIn, err := resolveDiagnosePromptInput(opts, storedPromptName, storedPromptVersion)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return strings.ToLower(strings.TrimSpace(records[i].SuiteName)) < strings.ToLower(strings.TrimSpace(records[j].SuiteName))
+	})
+
+	results := make([]*runner.SuiteResult, 0, len(records))
+	for _, rec := range records {
+		results = append(results, suiteResultFromRecord(rec))
+	}
+
+	isSystem := false
+	if pIn.SystemHint != nil {
+		isSystem = *pIn.SystemHint
+	}
+	p := buildPromptForRun(pIn, storedPromptName, isSystem)
+
+	advisor := &optimizer.Advisor{Provider: provider}
+	diag, err := advisor.Diagnose(ctx, &optimizer.DiagnoseRequest{
+		PromptContent: pIn.PromptText,
+		EvalResults:   results,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch outFmt {
+	case "json":
+		payload := buildDiagnoseJSONOutput(p, nil, results, diag)
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(payload); err != nil {
+			return fmt.Errorf("diagnose: marshal output: %w", err)
+		}
+		return nil
+	default:
+		printDiagnoseText(cmd, p, nil, results, diag)
+		return nil
+	}
+}
+
+// resolveDiagnosePromptInput loads the prompt to diagnose against, honoring
+// --prompt when given and otherwise resolving storedName/storedVersion from
+// --prompts-dir. Either way, a prompt name that disagrees with the stored
+// suite's prompt reference is rejected rather than silently diagnosing the
+// wrong prompt.
+func resolveDiagnosePromptInput(opts *diagnoseOptions, storedName, storedVersion string) (*promptInput, error) {
+	if strings.TrimSpace(opts.promptPath) != "" {
+		pIn, err := loadPromptInput(opts.promptPath)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSpace(pIn.NameHint)
+		if pIn.IsYAML && pIn.Prompt != nil {
+			name = strings.TrimSpace(pIn.Prompt.Name)
+		}
+		if name != "" && name != storedName {
+			return nil, fmt.Errorf("diagnose: prompt name mismatch: prompt=%q run=%q", name, storedName)
+		}
+		return pIn, nil
+	}
+
+	prompts, err := app.LoadPrompts(opts.promptsDir)
+	if err != nil {
+		return nil, fmt.Errorf("diagnose: load prompts %q: %w", opts.promptsDir, err)
+	}
+
+	var p *prompt.Prompt
+	if strings.TrimSpace(storedVersion) != "" {
+		p, err = app.FindPromptByNameVersion(prompts, storedName, storedVersion)
+	} else {
+		p, err = app.FindPromptLatestByName(prompts, storedName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("diagnose: %w", err)
+	}
+	if strings.TrimSpace(p.Template) == "" {
+		return nil, fmt.Errorf("diagnose: prompt %q has empty template", storedName)
+	}
+
+	system := p.IsSystemPrompt
+	return &promptInput{
+		IsYAML:      true,
+		Prompt:      p,
+		PromptText:  p.Template,
+		NameHint:    strings.TrimSpace(p.Name),
+		SystemHint:  &system,
+		SourceLabel: fmt.Sprintf("%s (from %s)", storedName, opts.promptsDir),
+	}, nil
+}
+
 func newRunnerFromConfig(provider llm.Provider, cfg *config.Config) (*runner.Runner, error) {
 	if provider == nil {
 		return nil, fmt.Errorf("diagnose: nil llm provider")
@@ -169,12 +311,20 @@ func newRunnerFromConfig(provider llm.Provider, cfg *config.Config) (*runner.Run
 	reg.Register(evaluator.NotContainsEvaluator{})
 	reg.Register(evaluator.RegexEvaluator{})
 	reg.Register(evaluator.JSONSchemaEvaluator{})
+	reg.Register(evaluator.OpenAPIEvaluator{})
+	reg.Register(evaluator.DiversityEvaluator{})
+	reg.Register(evaluator.ConsistencyEvaluator{})
 
 	return runner.NewRunner(provider, reg, runner.Config{
-		Trials:        trials,
-		PassThreshold: threshold,
-		Concurrency:   concurrency,
-		Timeout:       cfg.Evaluation.Timeout,
+		Trials:               trials,
+		PassThreshold:        threshold,
+		Concurrency:          concurrency,
+		EvaluatorConcurrency: cfg.Evaluation.EvaluatorConcurrency,
+		Timeout:              cfg.Evaluation.Timeout,
+		MaxStepsHardFail:     cfg.Evaluation.MaxStepsHardFail,
+		StrictSafety:         cfg.Evaluation.StrictSafety,
+		ScoreEpsilon:         cfg.Evaluation.ScoreEpsilon,
+		PromptWrapper:        cfg.Evaluation.PromptWrapper,
 	}), nil
 }
 
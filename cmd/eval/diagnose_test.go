@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveDiagnosePromptInput_FromPromptsDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "p.yaml"), []byte("name: p1\nversion: v1\ntemplate: hello\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	pIn, err := resolveDiagnosePromptInput(&diagnoseOptions{promptsDir: dir}, "p1", "v1")
+	if err != nil {
+		t.Fatalf("resolveDiagnosePromptInput: %v", err)
+	}
+	if pIn.PromptText != "hello" || !pIn.IsYAML {
+		t.Fatalf("resolveDiagnosePromptInput: got %#v", pIn)
+	}
+
+	if _, err := resolveDiagnosePromptInput(&diagnoseOptions{promptsDir: dir}, "missing", "v1"); err == nil {
+		t.Fatalf("expected error for unknown prompt")
+	}
+}
+
+func TestResolveDiagnosePromptInput_ExplicitPromptMismatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	promptPath := filepath.Join(dir, "other.yaml")
+	if err := os.WriteFile(promptPath, []byte("name: other\ntemplate: hi\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := resolveDiagnosePromptInput(&diagnoseOptions{promptPath: promptPath}, "p1", ""); err == nil {
+		t.Fatalf("expected error for prompt name mismatch")
+	}
+
+	pIn, err := resolveDiagnosePromptInput(&diagnoseOptions{promptPath: promptPath}, "other", "")
+	if err != nil {
+		t.Fatalf("resolveDiagnosePromptInput: %v", err)
+	}
+	if pIn.PromptText != "hi" {
+		t.Fatalf("resolveDiagnosePromptInput: got %#v", pIn)
+	}
+}
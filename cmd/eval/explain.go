@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/optimizer"
+	"github.com/stellarlinkco/ai-eval/internal/prompt"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+type explainOptions struct {
+	promptsDir string
+	output     string
+}
+
+func newExplainCmd(st *cliState) *cobra.Command {
+	var opts explainOptions
+
+	cmd := &cobra.Command{
+		Use:   "explain <run-id>",
+		Short: "Summarize a stored run's failures and suggest fixes, without re-running the suite",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(st.configPath)
+			if err != nil {
+				return err
+			}
+			st.cfg = cfg
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExplain(cmd, st, args[0], &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.promptsDir, "prompts-dir", defaultPromptsDir, "directory containing prompt definitions")
+	cmd.Flags().StringVar(&opts.output, "output", "text", "output format: text|json")
+
+	return cmd
+}
+
+func runExplain(cmd *cobra.Command, st *cliState, runID string, opts *explainOptions) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("explain: missing config (internal error)")
+	}
+	if opts == nil {
+		return fmt.Errorf("explain: nil options")
+	}
+
+	runID = strings.TrimSpace(runID)
+	if runID == "" {
+		return fmt.Errorf("explain: missing run id")
+	}
+
+	outFmt := strings.ToLower(strings.TrimSpace(opts.output))
+	if outFmt == "" {
+		outFmt = "text"
+	}
+	if outFmt != "text" && outFmt != "json" {
+		return fmt.Errorf("explain: invalid --output %q (expected text|json)", opts.output)
+	}
+
+	provider, err := defaultProviderFromConfig(st.cfg)
+	if err != nil {
+		return fmt.Errorf("explain: %w", err)
+	}
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if _, err := stor.GetRun(ctx, runID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("explain: run %q not found", runID)
+		}
+		return err
+	}
+
+	suites, err := stor.GetSuiteResults(ctx, runID)
+	if err != nil {
+		return err
+	}
+	if len(suites) == 0 {
+		return fmt.Errorf("explain: run %q has no suite results", runID)
+	}
+
+	promptName, promptVersion, err := singlePromptRef(suites)
+	if err != nil {
+		return err
+	}
+
+	promptContent, err := loadPromptContentByRef(opts.promptsDir, promptName, promptVersion)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(suites, func(i, j int) bool {
+		return strings.ToLower(strings.TrimSpace(suites[i].SuiteName)) < strings.ToLower(strings.TrimSpace(suites[j].SuiteName))
+	})
+
+	results := make([]*runner.SuiteResult, 0, len(suites))
+	for _, s := range suites {
+		results = append(results, suiteResultFromRecord(s))
+	}
+
+	advisor := &optimizer.Advisor{Provider: provider}
+	diag, err := advisor.Diagnose(ctx, &optimizer.DiagnoseRequest{
+		PromptContent: promptContent,
+		EvalResults:   results,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch outFmt {
+	case "json":
+		payload := buildExplainJSONOutput(runID, promptName, results, diag)
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(payload); err != nil {
+			return fmt.Errorf("explain: marshal output: %w", err)
+		}
+		return nil
+	default:
+		printExplainText(cmd, runID, promptName, results, diag)
+		return nil
+	}
+}
+
+// singlePromptRef requires that every suite in the run reference the same
+// prompt name/version, since optimizer.Advisor.Diagnose takes one prompt.
+func singlePromptRef(suites []*store.SuiteRecord) (name string, version string, err error) {
+	seen := make(map[string]struct{}, 1)
+	for _, s := range suites {
+		if s == nil {
+			continue
+		}
+		name = strings.TrimSpace(s.PromptName)
+		version = strings.TrimSpace(s.PromptVersion)
+		key := name + "@" + version
+		seen[key] = struct{}{}
+	}
+	if len(seen) != 1 {
+		keys := make([]string, 0, len(seen))
+		for k := range seen {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return "", "", fmt.Errorf("explain: run has multiple prompt versions (%s); expected exactly one", strings.Join(keys, ", "))
+	}
+	return name, version, nil
+}
+
+func loadPromptContentByRef(dir, name, version string) (string, error) {
+	prompts, err := app.LoadPrompts(dir)
+	if err != nil {
+		return "", fmt.Errorf("explain: load prompts %q: %w", dir, err)
+	}
+
+	var p *prompt.Prompt
+	if strings.TrimSpace(version) != "" {
+		p, err = app.FindPromptByNameVersion(prompts, name, version)
+	} else {
+		p, err = app.FindPromptLatestByName(prompts, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("explain: %w", err)
+	}
+
+	if strings.TrimSpace(p.Template) == "" {
+		return "", fmt.Errorf("explain: prompt %q has empty template", name)
+	}
+	return p.Template, nil
+}
+
+// suiteResultFromRecord adapts a persisted store.SuiteRecord back into a
+// runner.SuiteResult so it can feed optimizer.Advisor.Diagnose, or stand in
+// for a baseline run in a compare, without re-running the suite. Per-trial
+// evidence (raw responses, evaluator messages) isn't persisted, so
+// Results[i].Trials is left empty; callers still have case-level scores and
+// errors to work from. RenderedSystem/RenderedUser carry over only when the
+// run persisted them (see config.EvaluationConfig.PersistResponses);
+// otherwise they're empty. Returns nil if rec is nil, so callers can pass
+// through a map lookup for a suite the baseline run never covered.
+func suiteResultFromRecord(rec *store.SuiteRecord) *runner.SuiteResult {
+	if rec == nil {
+		return nil
+	}
+
+	res := &runner.SuiteResult{
+		Suite:        rec.SuiteName,
+		TotalCases:   rec.TotalCases,
+		PassedCases:  rec.PassedCases,
+		FailedCases:  rec.FailedCases,
+		SkippedCases: rec.SkippedCases,
+		PassRate:     rec.PassRate,
+		AvgScore:     rec.AvgScore,
+		TotalLatency: rec.TotalLatency,
+		TotalTokens:  rec.TotalTokens,
+	}
+
+	res.Results = make([]runner.RunResult, 0, len(rec.CaseResults))
+	for _, cr := range rec.CaseResults {
+		rr := runner.RunResult{
+			Suite:            rec.SuiteName,
+			CaseID:           cr.CaseID,
+			Passed:           cr.Passed,
+			Score:            cr.Score,
+			PassAtK:          cr.PassAtK,
+			PassExpK:         cr.PassExpK,
+			LatencyMs:        cr.LatencyMs,
+			TokensUsed:       cr.TokensUsed,
+			PromptTokens:     cr.PromptTokens,
+			CompletionTokens: cr.CompletionTokens,
+			Skipped:          cr.Skipped,
+			RenderedSystem:   cr.RenderedSystem,
+			RenderedUser:     cr.RenderedUser,
+		}
+		if msg := strings.TrimSpace(cr.Error); msg != "" {
+			rr.Error = errors.New(msg)
+		}
+		res.Results = append(res.Results, rr)
+	}
+	return res
+}
+
+type explainJSONOutput struct {
+	RunID      string `json:"run_id"`
+	PromptName string `json:"prompt_name"`
+	Suites     []struct {
+		Suite      string  `json:"suite"`
+		PassRate   float64 `json:"pass_rate"`
+		AvgScore   float64 `json:"avg_score"`
+		TotalCases int     `json:"total_cases"`
+		Passed     int     `json:"passed"`
+		Failed     int     `json:"failed"`
+	} `json:"suites"`
+	Diagnosis   *optimizer.DiagnoseResult `json:"diagnosis"`
+	FailedCases []explainFailedCase       `json:"failed_cases,omitempty"`
+}
+
+// explainFailedCase surfaces a failed case's rendered prompt content, when
+// the run persisted it (see config.EvaluationConfig.PersistResponses), so a
+// failure can be debugged against exactly what was sent without re-running
+// the suite. Omitted from FailedCases entirely when neither field was
+// persisted, since there'd be nothing new to show beyond the suite summary.
+type explainFailedCase struct {
+	Suite          string `json:"suite"`
+	CaseID         string `json:"case_id"`
+	Error          string `json:"error,omitempty"`
+	RenderedSystem string `json:"rendered_system,omitempty"`
+	RenderedUser   string `json:"rendered_user,omitempty"`
+}
+
+func buildExplainJSONOutput(runID, promptName string, results []*runner.SuiteResult, diag *optimizer.DiagnoseResult) explainJSONOutput {
+	out := explainJSONOutput{
+		RunID:      runID,
+		PromptName: promptName,
+		Diagnosis:  diag,
+	}
+	out.Suites = make([]struct {
+		Suite      string  `json:"suite"`
+		PassRate   float64 `json:"pass_rate"`
+		AvgScore   float64 `json:"avg_score"`
+		TotalCases int     `json:"total_cases"`
+		Passed     int     `json:"passed"`
+		Failed     int     `json:"failed"`
+	}, 0, len(results))
+
+	for _, res := range results {
+		if res == nil {
+			continue
+		}
+		out.Suites = append(out.Suites, struct {
+			Suite      string  `json:"suite"`
+			PassRate   float64 `json:"pass_rate"`
+			AvgScore   float64 `json:"avg_score"`
+			TotalCases int     `json:"total_cases"`
+			Passed     int     `json:"passed"`
+			Failed     int     `json:"failed"`
+		}{
+			Suite:      strings.TrimSpace(res.Suite),
+			PassRate:   res.PassRate,
+			AvgScore:   res.AvgScore,
+			TotalCases: res.TotalCases,
+			Passed:     res.PassedCases,
+			Failed:     res.FailedCases,
+		})
+
+		for _, rr := range res.Results {
+			if rr.Passed || (rr.RenderedSystem == "" && rr.RenderedUser == "") {
+				continue
+			}
+			errMsg := ""
+			if rr.Error != nil {
+				errMsg = rr.Error.Error()
+			}
+			out.FailedCases = append(out.FailedCases, explainFailedCase{
+				Suite:          strings.TrimSpace(res.Suite),
+				CaseID:         rr.CaseID,
+				Error:          errMsg,
+				RenderedSystem: rr.RenderedSystem,
+				RenderedUser:   rr.RenderedUser,
+			})
+		}
+	}
+
+	return out
+}
+
+func printExplainText(cmd *cobra.Command, runID, promptName string, results []*runner.SuiteResult, diag *optimizer.DiagnoseResult) {
+	out := cmd.OutOrStdout()
+
+	_, _ = fmt.Fprintf(out, "Run: %s\n", runID)
+	if promptName != "" {
+		_, _ = fmt.Fprintf(out, "Prompt: %s\n", promptName)
+	}
+
+	for _, res := range results {
+		if res == nil {
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "\nSuite: %s\n", strings.TrimSpace(res.Suite))
+		_, _ = fmt.Fprintf(out, "Cases: %d passed=%d failed=%d pass_rate=%.2f avg_score=%.2f\n",
+			res.TotalCases, res.PassedCases, res.FailedCases, res.PassRate, res.AvgScore)
+
+		for _, rr := range res.Results {
+			if rr.Passed || (rr.RenderedSystem == "" && rr.RenderedUser == "") {
+				continue
+			}
+			_, _ = fmt.Fprintf(out, "\n  Failed case %q rendered input:\n", rr.CaseID)
+			if rr.RenderedSystem != "" {
+				_, _ = fmt.Fprintf(out, "    system: %s\n", rr.RenderedSystem)
+			}
+			if rr.RenderedUser != "" {
+				_, _ = fmt.Fprintf(out, "    user: %s\n", rr.RenderedUser)
+			}
+		}
+	}
+
+	if diag == nil {
+		_, _ = fmt.Fprintln(out, "\nDiagnosis: <nil>")
+		return
+	}
+
+	_, _ = fmt.Fprintln(out, "\nFailure Patterns:")
+	if len(diag.FailurePatterns) == 0 {
+		_, _ = fmt.Fprintln(out, "- (none)")
+	} else {
+		for _, p := range diag.FailurePatterns {
+			_, _ = fmt.Fprintf(out, "- %s\n", p)
+		}
+	}
+
+	_, _ = fmt.Fprintln(out, "\nRoot Causes:")
+	if len(diag.RootCauses) == 0 {
+		_, _ = fmt.Fprintln(out, "- (none)")
+	} else {
+		for _, rc := range diag.RootCauses {
+			_, _ = fmt.Fprintf(out, "- %s\n", rc)
+		}
+	}
+
+	_, _ = fmt.Fprintln(out, "\nSuggestions:")
+	if len(diag.Suggestions) == 0 {
+		_, _ = fmt.Fprintln(out, "- (none)")
+		return
+	}
+	for _, s := range diag.Suggestions {
+		_, _ = fmt.Fprintf(out, "- [%s] (priority=%d impact=%s type=%s) %s\n", s.ID, s.Priority, s.Impact, s.Type, s.Description)
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+func TestSinglePromptRef(t *testing.T) {
+	t.Parallel()
+
+	if _, _, err := singlePromptRef(nil); err == nil {
+		t.Fatalf("expected error for no suites")
+	}
+
+	name, version, err := singlePromptRef([]*store.SuiteRecord{
+		{PromptName: "p1", PromptVersion: "v1"},
+		{PromptName: "p1", PromptVersion: "v1"},
+	})
+	if err != nil || name != "p1" || version != "v1" {
+		t.Fatalf("singlePromptRef: name=%q version=%q err=%v", name, version, err)
+	}
+
+	if _, _, err := singlePromptRef([]*store.SuiteRecord{
+		{PromptName: "p1", PromptVersion: "v1"},
+		{PromptName: "p2", PromptVersion: "v1"},
+	}); err == nil {
+		t.Fatalf("expected error for mixed prompt names")
+	}
+}
+
+func TestSuiteResultFromRecord(t *testing.T) {
+	t.Parallel()
+
+	rec := &store.SuiteRecord{
+		SuiteName:   "s1",
+		TotalCases:  2,
+		PassedCases: 1,
+		FailedCases: 1,
+		PassRate:    0.5,
+		AvgScore:    0.6,
+		CaseResults: []store.CaseRecord{
+			{CaseID: "c1", Passed: true, Score: 1},
+			{CaseID: "c2", Passed: false, Score: 0, Error: "boom"},
+		},
+	}
+
+	res := suiteResultFromRecord(rec)
+	if res.Suite != "s1" || res.TotalCases != 2 || len(res.Results) != 2 {
+		t.Fatalf("suiteResultFromRecord: got %#v", res)
+	}
+	if res.Results[1].Error == nil || res.Results[1].Error.Error() != "boom" {
+		t.Fatalf("suiteResultFromRecord: expected case error, got %#v", res.Results[1])
+	}
+	if res.Results[0].Error != nil {
+		t.Fatalf("suiteResultFromRecord: expected no error for passing case, got %v", res.Results[0].Error)
+	}
+}
+
+func TestSuiteResultFromRecord_RenderedContent(t *testing.T) {
+	t.Parallel()
+
+	rec := &store.SuiteRecord{
+		SuiteName: "s1",
+		CaseResults: []store.CaseRecord{
+			{CaseID: "c1", Passed: false, RenderedSystem: "sys", RenderedUser: "usr"},
+			{CaseID: "c2", Passed: true},
+		},
+	}
+
+	res := suiteResultFromRecord(rec)
+	if res.Results[0].RenderedSystem != "sys" || res.Results[0].RenderedUser != "usr" {
+		t.Fatalf("suiteResultFromRecord: expected rendered content carried over, got %#v", res.Results[0])
+	}
+	if res.Results[1].RenderedSystem != "" || res.Results[1].RenderedUser != "" {
+		t.Fatalf("suiteResultFromRecord: expected no rendered content for c2, got %#v", res.Results[1])
+	}
+}
+
+func TestBuildExplainJSONOutput_FailedCases(t *testing.T) {
+	t.Parallel()
+
+	results := []*runner.SuiteResult{
+		{
+			Suite: "s1",
+			Results: []runner.RunResult{
+				{CaseID: "c1", Passed: false, RenderedSystem: "sys", RenderedUser: "usr"},
+				{CaseID: "c2", Passed: false},
+				{CaseID: "c3", Passed: true, RenderedSystem: "sys", RenderedUser: "usr"},
+			},
+		},
+	}
+
+	out := buildExplainJSONOutput("run1", "p1", results, nil)
+	if len(out.FailedCases) != 1 || out.FailedCases[0].CaseID != "c1" {
+		t.Fatalf("buildExplainJSONOutput: expected only c1 in FailedCases, got %#v", out.FailedCases)
+	}
+	if out.FailedCases[0].RenderedSystem != "sys" || out.FailedCases[0].RenderedUser != "usr" {
+		t.Fatalf("buildExplainJSONOutput: expected rendered content, got %#v", out.FailedCases[0])
+	}
+}
+
+func TestLoadPromptContentByRef(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "p.yaml"), []byte("name: p1\nversion: v1\ntemplate: hello\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	got, err := loadPromptContentByRef(dir, "p1", "v1")
+	if err != nil || got != "hello" {
+		t.Fatalf("loadPromptContentByRef(with version): got=%q err=%v", got, err)
+	}
+
+	got, err = loadPromptContentByRef(dir, "p1", "")
+	if err != nil || got != "hello" {
+		t.Fatalf("loadPromptContentByRef(latest): got=%q err=%v", got, err)
+	}
+
+	if _, err := loadPromptContentByRef(dir, "missing", "v1"); err == nil {
+		t.Fatalf("expected error for unknown prompt")
+	}
+
+	if _, err := loadPromptContentByRef(filepath.Join(dir, "does-not-exist"), "p1", "v1"); err == nil {
+		t.Fatalf("expected error for missing prompts dir")
+	}
+}
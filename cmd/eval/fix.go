@@ -1,16 +1,19 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/mattn/go-isatty"
 	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/stellarlinkco/ai-eval/internal/llm"
 	"github.com/stellarlinkco/ai-eval/internal/optimizer"
@@ -20,10 +23,13 @@ import (
 )
 
 type fixOptions struct {
-	promptPath string
-	testsPath  string
-	apply      bool
-	dryRun     bool
+	promptPath  string
+	testsPath   string
+	apply       bool
+	dryRun      bool
+	diff        bool
+	interactive bool
+	suggestions string
 }
 
 func newFixCmd(st *cliState) *cobra.Command {
@@ -50,6 +56,9 @@ func newFixCmd(st *cliState) *cobra.Command {
 	cmd.Flags().StringVar(&opts.testsPath, "tests", defaultTestsDir, "path to test suite file or directory")
 	cmd.Flags().BoolVar(&opts.apply, "apply", false, "apply the fixed prompt back to --prompt file")
 	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "print the fixed prompt but do not write any files")
+	cmd.Flags().BoolVar(&opts.diff, "diff", false, "print a unified diff of the fixed prompt instead of the full text")
+	cmd.Flags().BoolVar(&opts.interactive, "interactive", false, "interactively accept/reject each suggestion before rewriting (requires a terminal)")
+	cmd.Flags().StringVar(&opts.suggestions, "suggestions", "", "comma-separated suggestion IDs to apply (non-interactive alternative to --interactive)")
 
 	return cmd
 }
@@ -64,6 +73,9 @@ func runFix(cmd *cobra.Command, st *cliState, opts *fixOptions) error {
 	if opts.apply && strings.TrimSpace(opts.promptPath) == "" {
 		return fmt.Errorf("fix: --apply requires --prompt (file path)")
 	}
+	if opts.interactive && strings.TrimSpace(opts.suggestions) != "" {
+		return fmt.Errorf("fix: --interactive and --suggestions are mutually exclusive")
+	}
 
 	provider, err := defaultProviderFromConfig(st.cfg)
 	if err != nil {
@@ -106,6 +118,11 @@ func runFix(cmd *cobra.Command, st *cliState, opts *fixOptions) error {
 		return err
 	}
 
+	diag, err = selectSuggestions(cmd, opts, diag)
+	if err != nil {
+		return err
+	}
+
 	fixedPrompt := extractRewritePrompt(diag)
 	if strings.TrimSpace(fixedPrompt) == "" {
 		fixedPrompt, err = rewritePromptFallback(ctx, provider, pIn.PromptText, diag)
@@ -118,11 +135,26 @@ func runFix(cmd *cobra.Command, st *cliState, opts *fixOptions) error {
 		return errors.New("fix: empty fixed prompt (internal error)")
 	}
 
+	var diffText string
+	if opts.diff {
+		diffText, err = renderFixDiff(pIn, fixedPrompt, isTerminalWriter(cmd.OutOrStdout()))
+		if err != nil {
+			return err
+		}
+	}
+
 	if !opts.apply || opts.dryRun {
-		_, _ = fmt.Fprintln(cmd.OutOrStdout(), fixedPrompt)
+		if opts.diff {
+			_, _ = fmt.Fprint(cmd.OutOrStdout(), diffText)
+		} else {
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), fixedPrompt)
+		}
 	}
 
 	if opts.apply && !opts.dryRun {
+		if opts.diff {
+			_, _ = fmt.Fprint(cmd.OutOrStdout(), diffText)
+		}
 		if err := writeFixedPromptFunc(pIn, fixedPrompt); err != nil {
 			return err
 		}
@@ -243,3 +275,265 @@ func writeFixedPrompt(pIn *promptInput, fixedPrompt string) error {
 }
 
 var writeFixedPromptFunc = writeFixedPrompt
+
+// isTerminalReader reports whether r is a TTY, for gating --interactive
+// prompts. A var so tests can force it on/off without a real terminal.
+var isTerminalReader = func(r io.Reader) bool {
+	f, ok := r.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+// selectSuggestions narrows diag.Suggestions down to the ones the caller
+// accepted, via --suggestions (non-interactive) or --interactive stdin
+// prompts. diag is returned unchanged if neither flag is set.
+func selectSuggestions(cmd *cobra.Command, opts *fixOptions, diag *optimizer.DiagnoseResult) (*optimizer.DiagnoseResult, error) {
+	if diag == nil {
+		return nil, errors.New("fix: nil diagnosis")
+	}
+
+	wantIDs := strings.TrimSpace(opts.suggestions)
+	if !opts.interactive && wantIDs == "" {
+		return diag, nil
+	}
+
+	var selected []optimizer.FixSuggestion
+	if wantIDs != "" {
+		want := make(map[string]bool)
+		for _, id := range strings.Split(wantIDs, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				want[id] = true
+			}
+		}
+		for _, s := range diag.Suggestions {
+			if want[s.ID] {
+				selected = append(selected, s)
+			}
+		}
+	} else {
+		in := cmd.InOrStdin()
+		if !isTerminalReader(in) {
+			return nil, errors.New("fix: --interactive requires a terminal (use --suggestions for scripting)")
+		}
+		selected = promptForSuggestions(cmd, in, diag.Suggestions)
+	}
+
+	if len(selected) == 0 {
+		return nil, errors.New("fix: no suggestions accepted; nothing to fix")
+	}
+
+	filtered := *diag
+	filtered.Suggestions = selected
+	return &filtered, nil
+}
+
+// promptForSuggestions lists each suggestion and reads a y/n answer from in,
+// defaulting to accept on a blank line.
+func promptForSuggestions(cmd *cobra.Command, in io.Reader, suggestions []optimizer.FixSuggestion) []optimizer.FixSuggestion {
+	out := cmd.OutOrStdout()
+	scanner := bufio.NewScanner(in)
+
+	var selected []optimizer.FixSuggestion
+	for _, s := range suggestions {
+		_, _ = fmt.Fprintf(out, "\n[%s] %s (impact: %s)\n%s\n", s.ID, s.Type, s.Impact, s.Description)
+		if s.Before != "" {
+			_, _ = fmt.Fprintf(out, "  before: %s\n", s.Before)
+		}
+		if s.After != "" {
+			_, _ = fmt.Fprintf(out, "  after:  %s\n", s.After)
+		}
+		_, _ = fmt.Fprint(out, "Accept? [Y/n]: ")
+
+		accept := true
+		if scanner.Scan() {
+			if answer := strings.ToLower(strings.TrimSpace(scanner.Text())); answer == "n" || answer == "no" {
+				accept = false
+			}
+		}
+		if accept {
+			selected = append(selected, s)
+		}
+	}
+	return selected
+}
+
+// renderFixDiff renders a unified diff between the original prompt and
+// fixedPrompt. For YAML prompts the full marshalled YAML is diffed (not just
+// the template field) so version bumps and other field changes are visible
+// too.
+func renderFixDiff(pIn *promptInput, fixedPrompt string, color bool) (string, error) {
+	if pIn == nil {
+		return "", errors.New("fix: nil prompt input")
+	}
+
+	label := strings.TrimSpace(pIn.Path)
+	if label == "" {
+		label = "prompt"
+	}
+
+	before, after := pIn.PromptText, fixedPrompt
+
+	if pIn.IsYAML {
+		if pIn.Prompt == nil {
+			return "", errors.New("fix: nil yaml prompt")
+		}
+		beforeYAML, err := yaml.Marshal(pIn.Prompt)
+		if err != nil {
+			return "", fmt.Errorf("fix: marshal yaml: %w", err)
+		}
+
+		p := *pIn.Prompt
+		p.Template = fixedPrompt
+		afterYAML, err := yaml.Marshal(&p)
+		if err != nil {
+			return "", fmt.Errorf("fix: marshal yaml: %w", err)
+		}
+
+		before, after = string(beforeYAML), string(afterYAML)
+	}
+
+	return unifiedDiff(label+" (before)", label+" (after)", before, after, color), nil
+}
+
+// diffContextLines is the number of unchanged lines kept around each change
+// in a unifiedDiff hunk, matching the conventional `diff -u` default.
+const diffContextLines = 3
+
+// diffLine is one line of a computeDiffOps alignment between two texts.
+type diffLine struct {
+	kind  byte // ' ' (context), '-' (removed), '+' (added)
+	text  string
+	aLine int // 1-based line number in the "before" text, 0 if not present
+	bLine int // 1-based line number in the "after" text, 0 if not present
+}
+
+// computeDiffOps aligns a and b via their longest common subsequence,
+// producing the ordered sequence of context/removed/added lines a unified
+// diff renders.
+func computeDiffOps(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]diffLine, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffLine{kind: ' ', text: a[i], aLine: i + 1, bLine: j + 1})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffLine{kind: '-', text: a[i], aLine: i + 1})
+			i++
+		default:
+			ops = append(ops, diffLine{kind: '+', text: b[j], bLine: j + 1})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffLine{kind: '-', text: a[i], aLine: i + 1})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffLine{kind: '+', text: b[j], bLine: j + 1})
+	}
+	return ops
+}
+
+// unifiedDiff renders a `diff -u`-style unified diff between before and
+// after, with fromLabel/toLabel used in the "---"/"+++" header lines. Returns
+// "" when the two texts are identical. When color is true, added/removed
+// lines are wrapped in ANSI color codes.
+func unifiedDiff(fromLabel, toLabel, before, after string, color bool) string {
+	ops := computeDiffOps(strings.Split(before, "\n"), strings.Split(after, "\n"))
+
+	var changed []int
+	for idx, op := range ops {
+		if op.kind != ' ' {
+			changed = append(changed, idx)
+		}
+	}
+	if len(changed) == 0 {
+		return ""
+	}
+
+	type hunk struct{ start, end int } // ops[start:end)
+	hunks := []hunk{{changed[0], changed[0] + 1}}
+	for _, idx := range changed[1:] {
+		last := &hunks[len(hunks)-1]
+		if idx-last.end > diffContextLines*2 {
+			hunks = append(hunks, hunk{idx, idx + 1})
+			continue
+		}
+		last.end = idx + 1
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n", fromLabel)
+	fmt.Fprintf(&sb, "+++ %s\n", toLabel)
+
+	for _, h := range hunks {
+		start := h.start - diffContextLines
+		if start < 0 {
+			start = 0
+		}
+		end := h.end + diffContextLines
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		var aStart, bStart, aCount, bCount int
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				if aStart == 0 {
+					aStart = op.aLine
+				}
+				aCount++
+			}
+			if op.kind != '-' {
+				if bStart == 0 {
+					bStart = op.bLine
+				}
+				bCount++
+			}
+		}
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount)
+		for _, op := range ops[start:end] {
+			sb.WriteString(formatDiffLine(op, color))
+			sb.WriteByte('\n')
+		}
+	}
+
+	return sb.String()
+}
+
+func formatDiffLine(op diffLine, color bool) string {
+	line := string(op.kind) + op.text
+	if !color {
+		return line
+	}
+	switch op.kind {
+	case '+':
+		return colorGreen + line + colorReset
+	case '-':
+		return colorRed + line + colorReset
+	default:
+		return line
+	}
+}
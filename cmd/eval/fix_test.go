@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stellarlinkco/ai-eval/internal/llm"
 	"github.com/stellarlinkco/ai-eval/internal/optimizer"
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
@@ -153,3 +154,138 @@ func TestWriteFixedPrompt(t *testing.T) {
 		t.Fatalf("unexpected text output: %q", string(b))
 	}
 }
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+
+	if got := unifiedDiff("a", "b", "same\ntext", "same\ntext", false); got != "" {
+		t.Fatalf("expected empty diff for identical text, got %q", got)
+	}
+
+	diff := unifiedDiff("before", "after", "line1\nline2\nline3\n", "line1\nchanged\nline3\n", false)
+	if !strings.Contains(diff, "--- before\n") || !strings.Contains(diff, "+++ after\n") {
+		t.Fatalf("missing diff headers: %q", diff)
+	}
+	if !strings.Contains(diff, "-line2") || !strings.Contains(diff, "+changed") {
+		t.Fatalf("expected -line2/+changed, got %q", diff)
+	}
+	if !strings.Contains(diff, " line1") || !strings.Contains(diff, " line3") {
+		t.Fatalf("expected surrounding context lines, got %q", diff)
+	}
+
+	colored := unifiedDiff("before", "after", "line1\n", "line2\n", true)
+	if !strings.Contains(colored, colorRed) || !strings.Contains(colored, colorGreen) {
+		t.Fatalf("expected ANSI color codes when color=true, got %q", colored)
+	}
+}
+
+func TestRenderFixDiff(t *testing.T) {
+	t.Parallel()
+
+	if _, err := renderFixDiff(nil, "x", false); err == nil {
+		t.Fatalf("expected error for nil prompt input")
+	}
+
+	textIn := &promptInput{Path: "p.txt", PromptText: "old text"}
+	diff, err := renderFixDiff(textIn, "new text", false)
+	if err != nil {
+		t.Fatalf("renderFixDiff(text): %v", err)
+	}
+	if !strings.Contains(diff, "-old text") || !strings.Contains(diff, "+new text") {
+		t.Fatalf("unexpected text diff: %q", diff)
+	}
+
+	if _, err := renderFixDiff(&promptInput{Path: "p.yaml", IsYAML: true}, "x", false); err == nil {
+		t.Fatalf("expected error for nil yaml prompt")
+	}
+
+	yamlIn := &promptInput{
+		Path:   "p.yaml",
+		IsYAML: true,
+		Prompt: &prompt.Prompt{Name: "p", Version: "v1", Template: "old"},
+	}
+	diff, err = renderFixDiff(yamlIn, "new", false)
+	if err != nil {
+		t.Fatalf("renderFixDiff(yaml): %v", err)
+	}
+	if !strings.Contains(diff, "-template: old") || !strings.Contains(diff, "+template: new") {
+		t.Fatalf("expected yaml diff to show template change, got %q", diff)
+	}
+}
+
+func TestSelectSuggestions(t *testing.T) {
+	t.Parallel()
+
+	diag := &optimizer.DiagnoseResult{
+		RootCauses: []string{"root"},
+		Suggestions: []optimizer.FixSuggestion{
+			{ID: "S1", Type: "add_constraint"},
+			{ID: "S2", Type: "rewrite_prompt", After: "fixed"},
+		},
+	}
+
+	cmd := &cobra.Command{}
+
+	if _, err := selectSuggestions(cmd, &fixOptions{}, nil); err == nil {
+		t.Fatalf("expected error for nil diagnosis")
+	}
+
+	// Neither --interactive nor --suggestions: unchanged.
+	got, err := selectSuggestions(cmd, &fixOptions{}, diag)
+	if err != nil {
+		t.Fatalf("selectSuggestions: %v", err)
+	}
+	if len(got.Suggestions) != 2 {
+		t.Fatalf("expected suggestions untouched, got %d", len(got.Suggestions))
+	}
+
+	// --suggestions filters to the named IDs.
+	got, err = selectSuggestions(cmd, &fixOptions{suggestions: "S1"}, diag)
+	if err != nil {
+		t.Fatalf("selectSuggestions(S1): %v", err)
+	}
+	if len(got.Suggestions) != 1 || got.Suggestions[0].ID != "S1" {
+		t.Fatalf("expected only S1, got %+v", got.Suggestions)
+	}
+	if len(got.RootCauses) != 1 {
+		t.Fatalf("expected root causes preserved, got %+v", got.RootCauses)
+	}
+
+	// --suggestions matching nothing rejects with a clear error.
+	if _, err := selectSuggestions(cmd, &fixOptions{suggestions: "nope"}, diag); err == nil {
+		t.Fatalf("expected error when no suggestions match")
+	}
+
+	// --interactive on a non-TTY reader fails clearly.
+	cmd.SetIn(strings.NewReader("y\ny\n"))
+	if _, err := selectSuggestions(cmd, &fixOptions{interactive: true}, diag); err == nil || !strings.Contains(err.Error(), "requires a terminal") {
+		t.Fatalf("expected non-TTY error, got %v", err)
+	}
+}
+
+func TestPromptForSuggestions(t *testing.T) {
+	t.Parallel()
+
+	suggestions := []optimizer.FixSuggestion{
+		{ID: "S1", Type: "add_constraint", Before: "b1", After: "a1"},
+		{ID: "S2", Type: "remove_ambiguity"},
+		{ID: "S3", Type: "restructure"},
+	}
+
+	cmd := &cobra.Command{}
+	var out strings.Builder
+	cmd.SetOut(&out)
+
+	got := promptForSuggestions(cmd, strings.NewReader("y\nn\n\n"), suggestions)
+
+	var gotIDs []string
+	for _, s := range got {
+		gotIDs = append(gotIDs, s.ID)
+	}
+	if strings.Join(gotIDs, ",") != "S1,S3" {
+		t.Fatalf("expected S1 and S3 accepted (S2 rejected, S3 defaults to accept), got %v", gotIDs)
+	}
+	if !strings.Contains(out.String(), "before: b1") || !strings.Contains(out.String(), "after:  a1") {
+		t.Fatalf("expected before/after snippets printed, got %q", out.String())
+	}
+}
@@ -2,8 +2,13 @@ package main
 
 import (
 	"database/sql"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -17,6 +22,8 @@ type historyOptions struct {
 	promptName string
 	limit      int
 	since      string
+	output     string
+	runType    string
 }
 
 func newHistoryCmd(st *cliState) *cobra.Command {
@@ -42,20 +49,101 @@ func newHistoryCmd(st *cliState) *cobra.Command {
 	cmd.Flags().StringVar(&opts.promptName, "prompt", "", "prompt name to filter")
 	cmd.Flags().IntVar(&opts.limit, "limit", 20, "max runs to list")
 	cmd.Flags().StringVar(&opts.since, "since", "", "only runs since date (YYYY-MM-DD or RFC3339)")
+	cmd.Flags().StringVar(&opts.output, "output", "table", "output format: table|json|csv")
+	cmd.Flags().StringVar(&opts.runType, "type", "", "filter by run type (e.g. redteam); default shows all")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
 
 	cmd.AddCommand(newHistoryShowCmd(st))
+	cmd.AddCommand(newHistoryFlakyCmd(st))
+	cmd.AddCommand(newHistoryDiffCmd(st))
+	cmd.AddCommand(newHistoryPruneCmd(st))
 	return cmd
 }
 
+type historyFlakyOptions struct {
+	promptName string
+	window     int
+	precision  int
+}
+
+func newHistoryFlakyCmd(st *cliState) *cobra.Command {
+	var opts historyFlakyOptions
+
+	cmd := &cobra.Command{
+		Use:   "flaky",
+		Short: "List cases whose pass/fail outcome flips across recent runs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHistoryFlaky(cmd, st, &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.promptName, "prompt", "", "prompt name to check")
+	cmd.Flags().IntVar(&opts.window, "window", 20, "number of recent runs to scan")
+	cmd.Flags().IntVar(&opts.precision, "precision", -1, "significant digits for flip_rate (overrides config)")
+	_ = cmd.MarkFlagRequired("prompt")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
+
+	return cmd
+}
+
+func runHistoryFlaky(cmd *cobra.Command, st *cliState, opts *historyFlakyOptions) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("history: missing config (internal error)")
+	}
+	if opts == nil {
+		return fmt.Errorf("history: nil options")
+	}
+
+	promptName := strings.TrimSpace(opts.promptName)
+	if promptName == "" {
+		return fmt.Errorf("history: missing --prompt")
+	}
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	var analytics store.Analytics = stor
+	flaky, err := analytics.GetFlakyCases(cmd.Context(), promptName, opts.window)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if len(flaky) == 0 {
+		_, _ = fmt.Fprintln(out, "No flaky cases found.")
+		return nil
+	}
+
+	precision := resolvePrecision(opts.precision, st.cfg.Evaluation.Precision)
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SUITE\tCASE\tRUNS\tFLIPS\tFLIP_RATE")
+	for _, f := range flaky {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\n", f.SuiteName, f.CaseID, f.Runs, f.Flips, formatMetric(f.FlipRate, precision))
+	}
+	return tw.Flush()
+}
+
 func newHistoryShowCmd(st *cliState) *cobra.Command {
-	return &cobra.Command{
+	var precision int
+	var output string
+
+	cmd := &cobra.Command{
 		Use:   "show <run-id>",
 		Short: "Show details for a run",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runHistoryShow(cmd, st, args[0])
+			return runHistoryShow(cmd, st, args[0], precision, output)
 		},
 	}
+
+	cmd.Flags().IntVar(&precision, "precision", -1, "significant digits for pass_rate/avg_score/score/pass@k (overrides config)")
+	cmd.Flags().StringVar(&output, "output", "table", "output format: table|json|csv")
+	return cmd
 }
 
 func runHistoryList(cmd *cobra.Command, st *cliState, opts *historyOptions) error {
@@ -88,32 +176,150 @@ func runHistoryList(cmd *cobra.Command, st *cliState, opts *historyOptions) erro
 	if err != nil {
 		return err
 	}
+	runs = filterRunsByType(runs, opts.runType)
 
 	out := cmd.OutOrStdout()
-	if len(runs) == 0 {
+	output := strings.ToLower(strings.TrimSpace(opts.output))
+
+	if len(runs) == 0 && output != "json" && output != "csv" {
 		_, _ = fmt.Fprintln(out, "No runs found.")
 		return nil
 	}
 
-	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(tw, "RUN_ID\tSTARTED\tFINISHED\tSUITES\tPASSED\tFAILED")
+	rows := make([]historyRunRow, len(runs))
+	for i, r := range runs {
+		rows[i] = historyRunRowFromRecord(r)
+	}
+
+	switch output {
+	case "", "table":
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "RUN_ID\tSTARTED\tFINISHED\tSUITES\tPASSED\tFAILED")
+		for _, r := range runs {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%d\n",
+				r.ID,
+				formatTime(r.StartedAt),
+				formatTime(r.FinishedAt),
+				r.TotalSuites,
+				r.PassedSuites,
+				r.FailedSuites,
+			)
+		}
+		return tw.Flush()
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	case "csv":
+		w := csv.NewWriter(out)
+		if err := w.Write([]string{"id", "started_at", "finished_at", "total_suites", "passed_suites", "failed_suites", "trials", "threshold"}); err != nil {
+			return err
+		}
+		for _, r := range rows {
+			if err := w.Write([]string{
+				r.ID,
+				r.StartedAt,
+				r.FinishedAt,
+				strconv.Itoa(r.TotalSuites),
+				strconv.Itoa(r.PassedSuites),
+				strconv.Itoa(r.FailedSuites),
+				r.Trials,
+				r.Threshold,
+			}); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	default:
+		return fmt.Errorf("history: invalid --output %q (expected table|json|csv)", opts.output)
+	}
+}
+
+// historyRunRow is the JSON/CSV shape for `history` list output: one row per
+// store.RunRecord, with trials/threshold flattened out of RunRecord.Config
+// (a free-form map) since those are the two settings most reports care
+// about. Trials/Threshold are strings so a run whose config predates a given
+// key (and so has neither) renders as an empty CSV cell rather than "0".
+type historyRunRow struct {
+	ID           string `json:"id"`
+	StartedAt    string `json:"started_at"`
+	FinishedAt   string `json:"finished_at"`
+	TotalSuites  int    `json:"total_suites"`
+	PassedSuites int    `json:"passed_suites"`
+	FailedSuites int    `json:"failed_suites"`
+	Trials       string `json:"trials,omitempty"`
+	Threshold    string `json:"threshold,omitempty"`
+}
+
+func historyRunRowFromRecord(r *store.RunRecord) historyRunRow {
+	row := historyRunRow{
+		ID:           r.ID,
+		StartedAt:    formatTime(r.StartedAt),
+		FinishedAt:   formatTime(r.FinishedAt),
+		TotalSuites:  r.TotalSuites,
+		PassedSuites: r.PassedSuites,
+		FailedSuites: r.FailedSuites,
+	}
+	if v, ok := configNumber(r.Config, "trials"); ok {
+		row.Trials = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	if v, ok := configNumber(r.Config, "threshold"); ok {
+		row.Threshold = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return row
+}
+
+// configNumber reads a numeric value out of a RunRecord.Config map. Config
+// round-trips through JSON, so numbers always decode as float64 regardless
+// of the type they were saved with.
+func configNumber(cfg map[string]any, key string) (float64, bool) {
+	v, ok := cfg[key]
+	if !ok {
+		return 0, false
+	}
+	f, ok := v.(float64)
+	return f, ok
+}
+
+// configString reads a string value out of a RunRecord.Config map.
+func configString(cfg map[string]any, key string) (string, bool) {
+	v, ok := cfg[key]
+	if !ok {
+		return "", false
+	}
+	s, ok := v.(string)
+	return s, ok
+}
+
+// filterRunsByType keeps only runs whose Config["type"] matches runType
+// (case-insensitive), or all runs when runType is empty. There's no
+// first-class "kind" column on the shared runs table (see
+// saveRedteamRunToStore), so this filters client-side on the free-form
+// Config map after ListRuns rather than pushing it into SQL.
+func filterRunsByType(runs []*store.RunRecord, runType string) []*store.RunRecord {
+	runType = strings.TrimSpace(runType)
+	if runType == "" {
+		return runs
+	}
+
+	out := make([]*store.RunRecord, 0, len(runs))
 	for _, r := range runs {
-		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%d\n",
-			r.ID,
-			formatTime(r.StartedAt),
-			formatTime(r.FinishedAt),
-			r.TotalSuites,
-			r.PassedSuites,
-			r.FailedSuites,
-		)
+		if r == nil {
+			continue
+		}
+		if v, ok := configString(r.Config, "type"); ok && strings.EqualFold(v, runType) {
+			out = append(out, r)
+		}
 	}
-	return tw.Flush()
+	return out
 }
 
-func runHistoryShow(cmd *cobra.Command, st *cliState, runID string) error {
+func runHistoryShow(cmd *cobra.Command, st *cliState, runID string, precisionFlag int, outputFlag string) error {
 	if st == nil || st.cfg == nil {
 		return fmt.Errorf("history: missing config (internal error)")
 	}
+	precision := resolvePrecision(precisionFlag, st.cfg.Evaluation.Precision)
 
 	runID = strings.TrimSpace(runID)
 	if runID == "" {
@@ -141,32 +347,73 @@ func runHistoryShow(cmd *cobra.Command, st *cliState, runID string) error {
 		return err
 	}
 
+	redteamResults, err := reader.GetRedteamResults(cmd.Context(), runID)
+	if err != nil {
+		return err
+	}
+
 	out := cmd.OutOrStdout()
+	output := strings.ToLower(strings.TrimSpace(outputFlag))
+	switch output {
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(historySuiteRowsFromRecords(suites))
+	case "csv":
+		return writeHistorySuiteRowsCSV(out, suites)
+	case "", "table":
+		// falls through to the table rendering below
+	default:
+		return fmt.Errorf("history: invalid --output %q (expected table|json|csv)", outputFlag)
+	}
+
 	_, _ = fmt.Fprintf(out, "Run: %s\n", run.ID)
 	_, _ = fmt.Fprintf(out, "Started: %s\n", formatTime(run.StartedAt))
 	_, _ = fmt.Fprintf(out, "Finished: %s\n", formatTime(run.FinishedAt))
 	_, _ = fmt.Fprintf(out, "Suites: %d passed=%d failed=%d\n", run.TotalSuites, run.PassedSuites, run.FailedSuites)
 
-	if len(suites) == 0 {
+	if len(suites) == 0 && len(redteamResults) == 0 {
 		return nil
 	}
 
 	for _, s := range suites {
 		_, _ = fmt.Fprintf(out, "\nSuite: %s (prompt=%s version=%s)\n", s.SuiteName, s.PromptName, s.PromptVersion)
-		_, _ = fmt.Fprintf(out, "Cases: %d passed=%d failed=%d pass_rate=%.2f avg_score=%.2f latency_ms=%d tokens=%d\n",
-			s.TotalCases, s.PassedCases, s.FailedCases, s.PassRate, s.AvgScore, s.TotalLatency, s.TotalTokens)
+		if len(s.Metadata) > 0 {
+			_, _ = fmt.Fprintf(out, "Metadata: %s\n", formatMetadata(s.Metadata))
+		}
+		_, _ = fmt.Fprintf(out, "Cases: %d passed=%d failed=%d pass_rate=%s avg_score=%s latency_ms=%d tokens=%d\n",
+			s.TotalCases, s.PassedCases, s.FailedCases, formatMetric(s.PassRate, precision), formatMetric(s.AvgScore, precision), s.TotalLatency, s.TotalTokens)
 
 		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(tw, "CASE\tRESULT\tSCORE\tPASS@K\tLAT(ms)\tTOKENS\tERROR")
+		fmt.Fprintln(tw, "CASE\tRESULT\tSCORE\tPASS@K\tLAT(ms)\tTOKENS\tPROMPT_TOK\tCOMPLETION_TOK\tERROR\tMETADATA")
 		for _, cr := range s.CaseResults {
-			fmt.Fprintf(tw, "%s\t%s\t%.3f\t%.3f\t%d\t%d\t%s\n",
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\t%s\n",
 				cr.CaseID,
 				statusLabel(cr.Passed),
-				cr.Score,
-				cr.PassAtK,
+				formatMetric(cr.Score, precision),
+				formatMetric(cr.PassAtK, precision),
 				cr.LatencyMs,
 				cr.TokensUsed,
+				cr.PromptTokens,
+				cr.CompletionTokens,
 				cr.Error,
+				formatMetadata(cr.Metadata),
+			)
+		}
+		_ = tw.Flush()
+	}
+
+	if len(redteamResults) > 0 {
+		_, _ = fmt.Fprintln(out, "\nRedteam results:")
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "PROMPT\tCATEGORY\tRESULT\tSCORE\tATTACK")
+		for _, rr := range redteamResults {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+				rr.PromptName,
+				rr.Category,
+				statusLabel(rr.Passed),
+				formatMetric(rr.Score, precision),
+				rr.Attack,
 			)
 		}
 		_ = tw.Flush()
@@ -175,6 +422,56 @@ func runHistoryShow(cmd *cobra.Command, st *cliState, runID string) error {
 	return nil
 }
 
+// historySuiteRow is the JSON/CSV shape for `history show` output: one row
+// per store.SuiteRecord, symmetric with historyRunRow for `history` itself.
+type historySuiteRow struct {
+	Suite         string  `json:"suite"`
+	Prompt        string  `json:"prompt"`
+	PromptVersion string  `json:"prompt_version"`
+	TotalCases    int     `json:"total_cases"`
+	PassedCases   int     `json:"passed_cases"`
+	FailedCases   int     `json:"failed_cases"`
+	PassRate      float64 `json:"pass_rate"`
+}
+
+func historySuiteRowsFromRecords(suites []*store.SuiteRecord) []historySuiteRow {
+	rows := make([]historySuiteRow, len(suites))
+	for i, s := range suites {
+		rows[i] = historySuiteRow{
+			Suite:         s.SuiteName,
+			Prompt:        s.PromptName,
+			PromptVersion: s.PromptVersion,
+			TotalCases:    s.TotalCases,
+			PassedCases:   s.PassedCases,
+			FailedCases:   s.FailedCases,
+			PassRate:      s.PassRate,
+		}
+	}
+	return rows
+}
+
+func writeHistorySuiteRowsCSV(out io.Writer, suites []*store.SuiteRecord) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"suite", "prompt", "prompt_version", "total_cases", "passed_cases", "failed_cases", "pass_rate"}); err != nil {
+		return err
+	}
+	for _, r := range historySuiteRowsFromRecords(suites) {
+		if err := w.Write([]string{
+			r.Suite,
+			r.Prompt,
+			r.PromptVersion,
+			strconv.Itoa(r.TotalCases),
+			strconv.Itoa(r.PassedCases),
+			strconv.Itoa(r.FailedCases),
+			strconv.FormatFloat(r.PassRate, 'f', -1, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
 func parseSince(s string) (time.Time, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
@@ -189,6 +486,22 @@ func parseSince(s string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("history: invalid --since %q (expected YYYY-MM-DD or RFC3339)", s)
 }
 
+func formatMetadata(m map[string]string) string {
+	if len(m) == 0 {
+		return "-"
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + m[k]
+	}
+	return strings.Join(parts, ",")
+}
+
 func formatTime(ts time.Time) string {
 	if ts.IsZero() {
 		return "-"
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+type historyDiffOptions struct {
+	minDelta  float64
+	output    string
+	precision int
+}
+
+func newHistoryDiffCmd(st *cliState) *cobra.Command {
+	var opts historyDiffOptions
+
+	cmd := &cobra.Command{
+		Use:   "diff <run1> <run2>",
+		Short: "Diff case-level results between two stored runs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHistoryDiff(cmd, st, args[0], args[1], &opts)
+		},
+	}
+
+	cmd.Flags().Float64Var(&opts.minDelta, "min-delta", 0, "minimum absolute score delta to report (0 reports every nonzero delta)")
+	cmd.Flags().StringVar(&opts.output, "output", "table", "output format: table|json")
+	cmd.Flags().IntVar(&opts.precision, "precision", -1, "significant digits for score deltas in table output (overrides config; JSON is always full precision)")
+	return cmd
+}
+
+func runHistoryDiff(cmd *cobra.Command, st *cliState, run1ID, run2ID string, opts *historyDiffOptions) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("history: missing config (internal error)")
+	}
+	if opts == nil {
+		return fmt.Errorf("history: nil options")
+	}
+
+	run1ID = strings.TrimSpace(run1ID)
+	run2ID = strings.TrimSpace(run2ID)
+	if run1ID == "" || run2ID == "" {
+		return fmt.Errorf("history: missing run id")
+	}
+	if opts.minDelta < 0 {
+		return fmt.Errorf("history: --min-delta must be >= 0 (got %v)", opts.minDelta)
+	}
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	var reader store.RunReader = stor
+
+	suites1, err := loadRunSuites(cmd, reader, run1ID)
+	if err != nil {
+		return err
+	}
+	suites2, err := loadRunSuites(cmd, reader, run2ID)
+	if err != nil {
+		return err
+	}
+
+	diff := buildHistoryDiff(run1ID, run2ID, suites1, suites2, opts.minDelta)
+
+	out := cmd.OutOrStdout()
+	output := strings.ToLower(strings.TrimSpace(opts.output))
+	switch output {
+	case "", "table":
+		precision := resolvePrecision(opts.precision, st.cfg.Evaluation.Precision)
+		writeHistoryDiffTable(out, diff, precision)
+		return nil
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diff)
+	default:
+		return fmt.Errorf("history: invalid --output %q (expected table|json)", opts.output)
+	}
+}
+
+func loadRunSuites(cmd *cobra.Command, reader store.RunReader, runID string) ([]*store.SuiteRecord, error) {
+	if _, err := reader.GetRun(cmd.Context(), runID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("history: run %q not found", runID)
+		}
+		return nil, err
+	}
+	return reader.GetSuiteResults(cmd.Context(), runID)
+}
+
+// historyDiffCase identifies a single case by the join key `history diff`
+// matches on: prompt name, suite name, and case ID. Prompt version is
+// deliberately excluded from the key so a case is still matched across a
+// version bump between the two runs.
+type historyDiffCase struct {
+	Prompt string `json:"prompt"`
+	Suite  string `json:"suite"`
+	CaseID string `json:"case_id"`
+}
+
+// historyDiffScoreDelta reports a case's score change between the two runs,
+// included when abs(Delta) is at least the requested --min-delta.
+type historyDiffScoreDelta struct {
+	historyDiffCase
+	Score1 float64 `json:"score1"`
+	Score2 float64 `json:"score2"`
+	Delta  float64 `json:"delta"`
+}
+
+// historyDiffResult is the JSON/table shape for `history diff <run1> <run2>`.
+type historyDiffResult struct {
+	Run1         string                  `json:"run1"`
+	Run2         string                  `json:"run2"`
+	NewlyFailing []historyDiffCase       `json:"newly_failing"`
+	NewlyPassing []historyDiffCase       `json:"newly_passing"`
+	ScoreDeltas  []historyDiffScoreDelta `json:"score_deltas"`
+	Added        []historyDiffCase       `json:"added"`
+	Removed      []historyDiffCase       `json:"removed"`
+}
+
+type historyDiffCaseInfo struct {
+	Passed bool
+	Score  float64
+}
+
+func collectHistoryDiffCases(suites []*store.SuiteRecord) map[historyDiffCase]historyDiffCaseInfo {
+	cases := make(map[historyDiffCase]historyDiffCaseInfo)
+	for _, s := range suites {
+		for _, c := range s.CaseResults {
+			key := historyDiffCase{Prompt: s.PromptName, Suite: s.SuiteName, CaseID: c.CaseID}
+			cases[key] = historyDiffCaseInfo{Passed: c.Passed, Score: c.Score}
+		}
+	}
+	return cases
+}
+
+func buildHistoryDiff(run1, run2 string, suites1, suites2 []*store.SuiteRecord, minDelta float64) historyDiffResult {
+	cases1 := collectHistoryDiffCases(suites1)
+	cases2 := collectHistoryDiffCases(suites2)
+
+	keySet := make(map[historyDiffCase]struct{}, len(cases1)+len(cases2))
+	for k := range cases1 {
+		keySet[k] = struct{}{}
+	}
+	for k := range cases2 {
+		keySet[k] = struct{}{}
+	}
+	keys := make([]historyDiffCase, 0, len(keySet))
+	for k := range keySet {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Prompt != keys[j].Prompt {
+			return keys[i].Prompt < keys[j].Prompt
+		}
+		if keys[i].Suite != keys[j].Suite {
+			return keys[i].Suite < keys[j].Suite
+		}
+		return keys[i].CaseID < keys[j].CaseID
+	})
+
+	result := historyDiffResult{Run1: run1, Run2: run2}
+	for _, k := range keys {
+		info1, ok1 := cases1[k]
+		info2, ok2 := cases2[k]
+		switch {
+		case ok1 && !ok2:
+			result.Removed = append(result.Removed, k)
+		case !ok1 && ok2:
+			result.Added = append(result.Added, k)
+		default:
+			if info1.Passed && !info2.Passed {
+				result.NewlyFailing = append(result.NewlyFailing, k)
+			} else if !info1.Passed && info2.Passed {
+				result.NewlyPassing = append(result.NewlyPassing, k)
+			}
+			if delta := info2.Score - info1.Score; delta != 0 && math.Abs(delta) >= minDelta {
+				result.ScoreDeltas = append(result.ScoreDeltas, historyDiffScoreDelta{
+					historyDiffCase: k,
+					Score1:          info1.Score,
+					Score2:          info2.Score,
+					Delta:           delta,
+				})
+			}
+		}
+	}
+	return result
+}
+
+func writeHistoryDiffTable(out io.Writer, diff historyDiffResult, precision int) {
+	_, _ = fmt.Fprintf(out, "Diff: %s -> %s\n", diff.Run1, diff.Run2)
+
+	_, _ = fmt.Fprintf(out, "\nNewly failing (%d):\n", len(diff.NewlyFailing))
+	for _, c := range diff.NewlyFailing {
+		_, _ = fmt.Fprintf(out, "  %s/%s/%s\n", c.Prompt, c.Suite, c.CaseID)
+	}
+
+	_, _ = fmt.Fprintf(out, "\nNewly passing (%d):\n", len(diff.NewlyPassing))
+	for _, c := range diff.NewlyPassing {
+		_, _ = fmt.Fprintf(out, "  %s/%s/%s\n", c.Prompt, c.Suite, c.CaseID)
+	}
+
+	_, _ = fmt.Fprintf(out, "\nScore deltas (%d):\n", len(diff.ScoreDeltas))
+	for _, d := range diff.ScoreDeltas {
+		_, _ = fmt.Fprintf(out, "  %s/%s/%s %s -> %s (%s)\n", d.Prompt, d.Suite, d.CaseID,
+			formatMetric(d.Score1, precision), formatMetric(d.Score2, precision), formatMetricSigned(d.Delta, precision))
+	}
+
+	_, _ = fmt.Fprintf(out, "\nAdded (%d):\n", len(diff.Added))
+	for _, c := range diff.Added {
+		_, _ = fmt.Fprintf(out, "  %s/%s/%s\n", c.Prompt, c.Suite, c.CaseID)
+	}
+
+	_, _ = fmt.Fprintf(out, "\nRemoved (%d):\n", len(diff.Removed))
+	for _, c := range diff.Removed {
+		_, _ = fmt.Fprintf(out, "  %s/%s/%s\n", c.Prompt, c.Suite, c.CaseID)
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func TestRunHistoryDiff(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "ai-eval.sqlite")
+
+	stor, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	started := time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)
+	finished := started.Add(time.Second)
+
+	run1Suites := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Suite:         &testcase.TestSuite{Suite: "s1"},
+		Result: &runner.SuiteResult{
+			Suite:       "s1",
+			TotalCases:  3,
+			PassedCases: 2,
+			FailedCases: 1,
+			Results: []runner.RunResult{
+				{CaseID: "c1", Passed: true, Score: 1.0},
+				{CaseID: "c2", Passed: false, Score: 0.4},
+				{CaseID: "c3", Passed: true, Score: 0.9},
+			},
+		},
+	}}
+	_, summary1 := app.SummarizeRuns(run1Suites)
+	rec1, err := app.SaveRun(context.Background(), stor, run1Suites, summary1, started, finished, nil, false, nil)
+	if err != nil {
+		_ = stor.Close()
+		t.Fatalf("SaveRun(1): %v", err)
+	}
+
+	run2Suites := []app.SuiteRun{{
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		Suite:         &testcase.TestSuite{Suite: "s1"},
+		Result: &runner.SuiteResult{
+			Suite:       "s1",
+			TotalCases:  3,
+			PassedCases: 2,
+			FailedCases: 1,
+			Results: []runner.RunResult{
+				{CaseID: "c1", Passed: false, Score: 0.5},
+				{CaseID: "c2", Passed: true, Score: 0.6},
+				{CaseID: "c4", Passed: true, Score: 0.8},
+			},
+		},
+	}}
+	_, summary2 := app.SummarizeRuns(run2Suites)
+	rec2, err := app.SaveRun(context.Background(), stor, run2Suites, summary2, started, finished, nil, false, nil)
+	if err != nil {
+		_ = stor.Close()
+		t.Fatalf("SaveRun(2): %v", err)
+	}
+	_ = stor.Close()
+
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryDiff(cmd, st, rec1.ID, rec2.ID, &historyDiffOptions{}); err != nil {
+			t.Fatalf("runHistoryDiff: %v", err)
+		}
+		out := buf.String()
+		if !strings.Contains(out, "Newly failing (1):") || !strings.Contains(out, "p1/s1/c1") {
+			t.Fatalf("expected c1 as newly failing, got %q", out)
+		}
+		if !strings.Contains(out, "Newly passing (1):") || !strings.Contains(out, "p1/s1/c2") {
+			t.Fatalf("expected c2 as newly passing, got %q", out)
+		}
+		if !strings.Contains(out, "Added (1):") || !strings.Contains(out, "p1/s1/c4") {
+			t.Fatalf("expected c4 as added, got %q", out)
+		}
+		if !strings.Contains(out, "Removed (1):") || !strings.Contains(out, "p1/s1/c3") {
+			t.Fatalf("expected c3 as removed, got %q", out)
+		}
+	})
+
+	t.Run("min-delta", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryDiff(cmd, st, rec1.ID, rec2.ID, &historyDiffOptions{minDelta: 0.3}); err != nil {
+			t.Fatalf("runHistoryDiff: %v", err)
+		}
+		out := buf.String()
+		if !strings.Contains(out, "Score deltas (1):") || !strings.Contains(out, "p1/s1/c1") {
+			t.Fatalf("expected only c1's delta above 0.3, got %q", out)
+		}
+		if strings.Contains(out, "p1/s1/c2 ") {
+			t.Fatalf("expected c2's smaller delta to be filtered out, got %q", out)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryDiff(cmd, st, rec1.ID, rec2.ID, &historyDiffOptions{output: "json"}); err != nil {
+			t.Fatalf("runHistoryDiff(json): %v", err)
+		}
+		out := buf.String()
+		if !strings.Contains(out, `"case_id": "c1"`) {
+			t.Fatalf("expected c1 in json output, got %q", out)
+		}
+	})
+
+	t.Run("run not found", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryDiff(cmd, st, "missing", rec2.ID, &historyDiffOptions{}); err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryDiff(cmd, st, rec1.ID, rec2.ID, &historyDiffOptions{output: "yaml"}); err == nil || !strings.Contains(err.Error(), "invalid --output") {
+			t.Fatalf("expected invalid --output error, got %v", err)
+		}
+	})
+
+	t.Run("negative min-delta", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryDiff(cmd, st, rec1.ID, rec2.ID, &historyDiffOptions{minDelta: -1}); err == nil || !strings.Contains(err.Error(), "--min-delta") {
+			t.Fatalf("expected --min-delta error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+type historyPruneOptions struct {
+	before string
+	keep   int
+	dryRun bool
+}
+
+func newHistoryPruneCmd(st *cliState) *cobra.Command {
+	var opts historyPruneOptions
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete old runs and their suite results beyond a retention window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHistoryPrune(cmd, st, &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.before, "before", "", "delete runs started before this cutoff: a relative age like \"30d\", or YYYY-MM-DD/RFC3339")
+	cmd.Flags().IntVar(&opts.keep, "keep", 0, "always keep at least this many most-recent runs, even if older than --before")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "report how many runs would be deleted without deleting them")
+	_ = cmd.MarkFlagRequired("before")
+
+	return cmd
+}
+
+func runHistoryPrune(cmd *cobra.Command, st *cliState, opts *historyPruneOptions) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("history: missing config (internal error)")
+	}
+	if opts == nil {
+		return fmt.Errorf("history: nil options")
+	}
+	if opts.keep < 0 {
+		return fmt.Errorf("history: --keep must be >= 0 (got %d)", opts.keep)
+	}
+
+	before, err := parsePruneBefore(opts.before)
+	if err != nil {
+		return err
+	}
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	out := cmd.OutOrStdout()
+
+	if opts.dryRun {
+		var analytics store.Analytics = stor
+		n, err := analytics.CountPrunableRuns(cmd.Context(), before, opts.keep)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(out, "Would delete %d run(s) (dry run).\n", n)
+		return nil
+	}
+
+	var writer store.RunWriter = stor
+	n, err := writer.PruneRuns(cmd.Context(), before, opts.keep)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "Deleted %d run(s).\n", n)
+	return nil
+}
+
+// parsePruneBefore parses --before for `history prune`: either a relative
+// age like "30d" (days) or an absolute date/timestamp accepted by
+// parseSince (YYYY-MM-DD or RFC3339). Empty returns the zero time, i.e. no
+// age-based cutoff (only --keep applies).
+func parsePruneBefore(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return time.Time{}, fmt.Errorf("history: invalid --before %q (expected a non-negative day count like \"30d\", or YYYY-MM-DD/RFC3339)", s)
+		}
+		return time.Now().UTC().AddDate(0, 0, -n), nil
+	}
+	t, err := parseSince(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("history: invalid --before %q (expected a non-negative day count like \"30d\", or YYYY-MM-DD/RFC3339)", s)
+	}
+	return t, nil
+}
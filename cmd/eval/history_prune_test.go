@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+func TestRunHistoryPrune(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "ai-eval.sqlite")
+
+	stor, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	base := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		start := base.AddDate(0, 0, i)
+		if err := stor.SaveRun(context.Background(), &store.RunRecord{
+			ID:         fmt.Sprintf("run_%d", i),
+			StartedAt:  start,
+			FinishedAt: start.Add(time.Minute),
+		}); err != nil {
+			t.Fatalf("SaveRun(%d): %v", i, err)
+		}
+	}
+	_ = stor.Close()
+
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
+
+	t.Run("dry run", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryPrune(cmd, st, &historyPruneOptions{before: "2026-02-03", dryRun: true}); err != nil {
+			t.Fatalf("runHistoryPrune(dry-run): %v", err)
+		}
+		if !strings.Contains(buf.String(), "Would delete 2 run(s)") {
+			t.Fatalf("expected dry-run count of 2, got %q", buf.String())
+		}
+	})
+
+	t.Run("prune with keep", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryPrune(cmd, st, &historyPruneOptions{before: "2026-02-02", keep: 1}); err != nil {
+			t.Fatalf("runHistoryPrune: %v", err)
+		}
+		if !strings.Contains(buf.String(), "Deleted 1 run(s)") {
+			t.Fatalf("expected 1 run deleted (keep protects run_1), got %q", buf.String())
+		}
+	})
+
+	t.Run("negative keep", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryPrune(cmd, st, &historyPruneOptions{before: "2026-02-02", keep: -1}); err == nil || !strings.Contains(err.Error(), "--keep") {
+			t.Fatalf("expected --keep error, got %v", err)
+		}
+	})
+
+	t.Run("invalid before", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryPrune(cmd, st, &historyPruneOptions{before: "nope"}); err == nil || !strings.Contains(err.Error(), "invalid --before") {
+			t.Fatalf("expected invalid --before error, got %v", err)
+		}
+	})
+}
+
+func TestParsePruneBefore(t *testing.T) {
+	t.Parallel()
+
+	if ts, err := parsePruneBefore(""); err != nil || !ts.IsZero() {
+		t.Fatalf("parsePruneBefore(empty): ts=%v err=%v", ts, err)
+	}
+
+	ts, err := parsePruneBefore("30d")
+	if err != nil {
+		t.Fatalf("parsePruneBefore(30d): %v", err)
+	}
+	if want := time.Now().UTC().AddDate(0, 0, -30); ts.Sub(want).Abs() > time.Minute {
+		t.Fatalf("parsePruneBefore(30d): got %v want ~%v", ts, want)
+	}
+
+	if ts, err := parsePruneBefore("2026-02-01"); err != nil || ts.Format("2006-01-02") != "2026-02-01" {
+		t.Fatalf("parsePruneBefore(YYYY-MM-DD): ts=%v err=%v", ts, err)
+	}
+
+	if _, err := parsePruneBefore("-5d"); err == nil {
+		t.Fatalf("expected error for negative day count")
+	}
+	if _, err := parsePruneBefore("nope"); err == nil {
+		t.Fatalf("expected error for invalid before")
+	}
+}
@@ -58,6 +58,17 @@ func TestFormatTime(t *testing.T) {
 	}
 }
 
+func TestFormatMetadata(t *testing.T) {
+	t.Parallel()
+
+	if got := formatMetadata(nil); got != "-" {
+		t.Fatalf("formatMetadata(nil): got %q", got)
+	}
+	if got := formatMetadata(map[string]string{"jira": "EVAL-1", "owner": "team-a"}); got != "jira=EVAL-1,owner=team-a" {
+		t.Fatalf("formatMetadata: got %q", got)
+	}
+}
+
 func TestHistoryCommands(t *testing.T) {
 	t.Parallel()
 
@@ -92,7 +103,7 @@ func TestHistoryCommands(t *testing.T) {
 		},
 	}}
 	_, summary := app.SummarizeRuns(runs)
-	rec, err := app.SaveRun(context.Background(), stor, runs, summary, started, finished, map[string]any{"x": "y"})
+	rec, err := app.SaveRun(context.Background(), stor, runs, summary, started, finished, map[string]any{"x": "y"}, false, nil)
 	if err != nil {
 		_ = stor.Close()
 		t.Fatalf("SaveRun: %v", err)
@@ -122,7 +133,7 @@ func TestHistoryCommands(t *testing.T) {
 		cmd.SetOut(&buf)
 		cmd.SetContext(context.Background())
 
-		if err := runHistoryShow(cmd, st, rec.ID); err != nil {
+		if err := runHistoryShow(cmd, st, rec.ID, defaultPrecision, ""); err != nil {
 			t.Fatalf("runHistoryShow: %v", err)
 		}
 		out := buf.String()
@@ -141,10 +152,155 @@ func TestHistoryCommands(t *testing.T) {
 		cmd := &cobra.Command{}
 		cmd.SetContext(context.Background())
 
-		if err := runHistoryShow(cmd, st, "missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		if err := runHistoryShow(cmd, st, "missing", defaultPrecision, ""); err == nil || !strings.Contains(err.Error(), "not found") {
 			t.Fatalf("expected not found error, got %v", err)
 		}
 	})
+
+	t.Run("list csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryList(cmd, st, &historyOptions{limit: 20, output: "csv"}); err != nil {
+			t.Fatalf("runHistoryList(csv): %v", err)
+		}
+		out := buf.String()
+		if !strings.HasPrefix(out, "id,started_at,finished_at,total_suites,passed_suites,failed_suites,trials,threshold\n") {
+			t.Fatalf("unexpected csv header: %q", out)
+		}
+		if !strings.Contains(out, rec.ID) {
+			t.Fatalf("expected run row in csv, got %q", out)
+		}
+	})
+
+	t.Run("list json", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryList(cmd, st, &historyOptions{limit: 20, output: "json"}); err != nil {
+			t.Fatalf("runHistoryList(json): %v", err)
+		}
+		if !strings.Contains(buf.String(), `"id": "`+rec.ID+`"`) {
+			t.Fatalf("expected run id in json, got %q", buf.String())
+		}
+	})
+
+	t.Run("show csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryShow(cmd, st, rec.ID, defaultPrecision, "csv"); err != nil {
+			t.Fatalf("runHistoryShow(csv): %v", err)
+		}
+		out := buf.String()
+		if !strings.HasPrefix(out, "suite,prompt,prompt_version,total_cases,passed_cases,failed_cases,pass_rate\n") {
+			t.Fatalf("unexpected csv header: %q", out)
+		}
+		if !strings.Contains(out, "suite1,p1,v1,2,1,1,0.5") {
+			t.Fatalf("expected suite row in csv, got %q", out)
+		}
+	})
+
+	t.Run("show json", func(t *testing.T) {
+		var buf bytes.Buffer
+		cmd := &cobra.Command{}
+		cmd.SetOut(&buf)
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryShow(cmd, st, rec.ID, defaultPrecision, "json"); err != nil {
+			t.Fatalf("runHistoryShow(json): %v", err)
+		}
+		if !strings.Contains(buf.String(), `"suite": "suite1"`) {
+			t.Fatalf("expected suite in json, got %q", buf.String())
+		}
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetContext(context.Background())
+
+		if err := runHistoryList(cmd, st, &historyOptions{limit: 20, output: "yaml"}); err == nil || !strings.Contains(err.Error(), "invalid --output") {
+			t.Fatalf("expected invalid --output error, got %v", err)
+		}
+		if err := runHistoryShow(cmd, st, rec.ID, defaultPrecision, "yaml"); err == nil || !strings.Contains(err.Error(), "invalid --output") {
+			t.Fatalf("expected invalid --output error, got %v", err)
+		}
+	})
+}
+
+func TestFilterRunsByType(t *testing.T) {
+	t.Parallel()
+
+	runs := []*store.RunRecord{
+		{ID: "run_eval", Config: map[string]any{"output": "json"}},
+		{ID: "run_redteam", Config: map[string]any{"type": "redteam"}},
+	}
+
+	if got := filterRunsByType(runs, ""); len(got) != 2 {
+		t.Fatalf("empty runType: expected all runs, got %#v", got)
+	}
+
+	got := filterRunsByType(runs, "redteam")
+	if len(got) != 1 || got[0].ID != "run_redteam" {
+		t.Fatalf("filterRunsByType(redteam): got %#v", got)
+	}
+
+	if got := filterRunsByType(runs, "REDTEAM"); len(got) != 1 || got[0].ID != "run_redteam" {
+		t.Fatalf("filterRunsByType is case-insensitive: got %#v", got)
+	}
+}
+
+func TestHistoryList_TypeFilter(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "ai-eval.sqlite")
+
+	stor, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	started := time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)
+	if err := stor.SaveRun(context.Background(), &store.RunRecord{
+		ID: "run_eval", StartedAt: started, FinishedAt: started.Add(time.Second), TotalSuites: 1, PassedSuites: 1,
+	}); err != nil {
+		_ = stor.Close()
+		t.Fatalf("SaveRun eval: %v", err)
+	}
+	if err := stor.SaveRun(context.Background(), &store.RunRecord{
+		ID: "run_redteam", StartedAt: started, FinishedAt: started.Add(time.Second), TotalSuites: 1, PassedSuites: 1,
+		Config: map[string]any{"type": "redteam"},
+	}); err != nil {
+		_ = stor.Close()
+		t.Fatalf("SaveRun redteam: %v", err)
+	}
+	_ = stor.Close()
+
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+	cmd.SetContext(context.Background())
+
+	if err := runHistoryList(cmd, st, &historyOptions{limit: 20, runType: "redteam"}); err != nil {
+		t.Fatalf("runHistoryList: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "run_redteam") {
+		t.Fatalf("expected run_redteam in output, got %q", out)
+	}
+	if strings.Contains(out, "run_eval") {
+		t.Fatalf("did not expect run_eval in output, got %q", out)
+	}
 }
 
 func TestRunHistoryList_NoRuns(t *testing.T) {
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/stellarlinkco/ai-eval/internal/importer"
+)
+
+type importOptions struct {
+	from       string
+	name       string
+	promptsDir string
+	testsDir   string
+	force      bool
+}
+
+func newImportCmd() *cobra.Command {
+	var opts importOptions
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a prompt/test definition from a third-party format",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImport(cmd, args[0], &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.from, "from", "promptfoo", "source format: promptfoo")
+	cmd.Flags().StringVar(&opts.name, "name", "", "prompt/suite name (defaults to the input file's base name)")
+	cmd.Flags().StringVar(&opts.promptsDir, "prompts-dir", defaultPromptsDir, "directory to write the imported prompt into")
+	cmd.Flags().StringVar(&opts.testsDir, "tests-dir", defaultTestsDir, "directory to write the imported test suite into")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "overwrite existing prompt/test suite files")
+
+	return cmd
+}
+
+func runImport(cmd *cobra.Command, path string, opts *importOptions) error {
+	if opts == nil {
+		return fmt.Errorf("import: nil options")
+	}
+	if strings.ToLower(strings.TrimSpace(opts.from)) != "promptfoo" {
+		return fmt.Errorf("import: unsupported --from %q (want: promptfoo)", opts.from)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("import: read %q: %w", path, err)
+	}
+
+	name := strings.TrimSpace(opts.name)
+	if name == "" {
+		base := filepath.Base(path)
+		name = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+
+	result, err := importer.ConvertPromptfoo(name, data)
+	if err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+
+	promptPath := filepath.Join(opts.promptsDir, name+".yaml")
+	testsPath := filepath.Join(opts.testsDir, name+".yaml")
+	if !opts.force {
+		if _, err := os.Stat(promptPath); err == nil {
+			return fmt.Errorf("import: %q already exists (use --force to overwrite)", promptPath)
+		}
+		if _, err := os.Stat(testsPath); err == nil {
+			return fmt.Errorf("import: %q already exists (use --force to overwrite)", testsPath)
+		}
+	}
+
+	if err := writeYAML(promptPath, result.Prompt); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	if err := writeYAML(testsPath, result.Suite); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "Imported %q: prompt=%s tests=%s cases=%d\n", path, promptPath, testsPath, len(result.Suite.Cases))
+	for _, w := range result.Warnings {
+		_, _ = fmt.Fprintf(out, "warning: %s\n", w)
+	}
+	return nil
+}
+
+func writeYAML(path string, v any) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("mkdir %q: %w", filepath.Dir(path), err)
+	}
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal yaml: %w", err)
+	}
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		return fmt.Errorf("write %q: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const importTestPromptfooConfig = `
+prompts:
+  - "Answer: {{question}}"
+tests:
+  - description: "basic"
+    vars:
+      question: "hi"
+    assert:
+      - type: contains
+        value: "hi"
+`
+
+func TestRunImport_WritesPromptAndSuite(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "promptfoo.yaml")
+	if err := os.WriteFile(src, []byte(importTestPromptfooConfig), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	opts := &importOptions{
+		from:       "promptfoo",
+		promptsDir: filepath.Join(dir, "prompts"),
+		testsDir:   filepath.Join(dir, "tests"),
+	}
+
+	var out bytes.Buffer
+	cmd := newImportCmd()
+	cmd.SetOut(&out)
+	if err := runImport(cmd, src, opts); err != nil {
+		t.Fatalf("runImport: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "prompts", "promptfoo.yaml")); err != nil {
+		t.Fatalf("expected prompt file: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "tests", "promptfoo.yaml")); err != nil {
+		t.Fatalf("expected tests file: %v", err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("Imported")) {
+		t.Fatalf("output: got %q", out.String())
+	}
+}
+
+func TestRunImport_UnsupportedFrom(t *testing.T) {
+	t.Parallel()
+
+	opts := &importOptions{from: "langchain"}
+	cmd := newImportCmd()
+	if err := runImport(cmd, "unused.yaml", opts); err == nil {
+		t.Fatalf("expected error for unsupported --from")
+	}
+}
+
+func TestRunImport_RefusesToOverwriteWithoutForce(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "promptfoo.yaml")
+	if err := os.WriteFile(src, []byte(importTestPromptfooConfig), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	opts := &importOptions{
+		from:       "promptfoo",
+		promptsDir: filepath.Join(dir, "prompts"),
+		testsDir:   filepath.Join(dir, "tests"),
+	}
+	cmd := newImportCmd()
+	cmd.SetOut(&bytes.Buffer{})
+	if err := runImport(cmd, src, opts); err != nil {
+		t.Fatalf("first runImport: %v", err)
+	}
+	if err := runImport(cmd, src, opts); err == nil {
+		t.Fatalf("expected overwrite-protection error")
+	}
+
+	opts.force = true
+	if err := runImport(cmd, src, opts); err != nil {
+		t.Fatalf("runImport with --force: %v", err)
+	}
+}
+
+func TestRunImport_SurfacesWarnings(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfg := `
+prompts:
+  - "Answer: {{question}}"
+tests:
+  - vars: {question: "hi"}
+    assert:
+      - type: contains
+        value: "hi"
+      - type: javascript
+        value: "true"
+`
+	src := filepath.Join(dir, "promptfoo.yaml")
+	if err := os.WriteFile(src, []byte(cfg), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	opts := &importOptions{
+		from:       "promptfoo",
+		promptsDir: filepath.Join(dir, "prompts"),
+		testsDir:   filepath.Join(dir, "tests"),
+	}
+	var out bytes.Buffer
+	cmd := newImportCmd()
+	cmd.SetOut(&out)
+	if err := runImport(cmd, src, opts); err != nil {
+		t.Fatalf("runImport: %v", err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("warning:")) {
+		t.Fatalf("output: got %q, expected a warning line", out.String())
+	}
+}
+
+func TestNewImportCmd_Wiring(t *testing.T) {
+	t.Parallel()
+
+	cmd := newImportCmd()
+	if cmd.Args == nil {
+		t.Fatalf("expected args validator")
+	}
+	if err := cmd.Args(cmd, nil); err == nil {
+		t.Fatalf("expected ExactArgs(1) to reject zero args")
+	}
+	if err := cmd.Args(cmd, []string{"file.yaml"}); err != nil {
+		t.Fatalf("expected ExactArgs(1) to accept one arg: %v", err)
+	}
+}
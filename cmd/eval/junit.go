@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+)
+
+// junitTestSuites is the <testsuites> root of a JUnit XML report, one
+// <testsuite> per app.SuiteRun. This is the format Jenkins/most CI systems
+// expect for test reporting; ai-eval otherwise only speaks table/json/github.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name    string        `xml:"name,attr"`
+	Time    string        `xml:"time,attr"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+	Error   *junitError   `xml:"error,omitempty"`
+}
+
+// junitFailure is an assertion/evaluator-level failure: the case ran to
+// completion but didn't pass.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitError is an execution failure (rr.Error != nil): the case never
+// produced a verdict, e.g. a provider timeout or transport error.
+type junitError struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// buildJUnitReport converts a completed run into a JUnit XML document, one
+// <testsuite> per app.SuiteRun in the order they ran.
+func buildJUnitReport(runs []app.SuiteRun) junitTestSuites {
+	report := junitTestSuites{Suites: make([]junitTestSuite, 0, len(runs))}
+	for _, r := range runs {
+		suite := buildJUnitSuite(r)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Suites = append(report.Suites, suite)
+	}
+	report.Time = junitSeconds(totalJUnitLatency(runs))
+	return report
+}
+
+func totalJUnitLatency(runs []app.SuiteRun) int64 {
+	var total int64
+	for _, r := range runs {
+		if r.Result != nil {
+			total += r.Result.TotalLatency
+		}
+	}
+	return total
+}
+
+// buildJUnitSuite converts a single app.SuiteRun to a <testsuite>. A run
+// whose suite failed to load (Result == nil) still needs a testcase to
+// report, so it renders as a single failing case rather than being dropped
+// from the report.
+func buildJUnitSuite(r app.SuiteRun) junitTestSuite {
+	name := r.PromptName
+	if r.Suite != nil && r.Suite.Suite != "" {
+		name = fmt.Sprintf("%s/%s", r.PromptName, r.Suite.Suite)
+	}
+
+	suite := junitTestSuite{
+		Name: name,
+		Properties: []junitProperty{
+			{Name: "prompt", Value: r.PromptName},
+			{Name: "prompt_version", Value: r.PromptVersion},
+		},
+	}
+
+	if r.Result == nil {
+		suite.Tests = 1
+		suite.Errors = 1
+		suite.Cases = []junitTestCase{{
+			Name: name,
+			Error: &junitError{
+				Message: "suite failed to run",
+				Text:    "suite produced no result",
+			},
+		}}
+		return suite
+	}
+
+	suite.Time = junitSeconds(r.Result.TotalLatency)
+	suite.Cases = make([]junitTestCase, 0, len(r.Result.Results))
+	for _, rr := range r.Result.Results {
+		tc := junitTestCase{
+			Name: rr.CaseID,
+			Time: junitSeconds(rr.LatencyMs),
+		}
+		switch {
+		case rr.Error != nil:
+			suite.Errors++
+			tc.Error = &junitError{Message: rr.Error.Error()}
+		case !rr.Passed:
+			suite.Failures++
+			tc.Failure = &junitFailure{Message: junitFailureMessage(rr)}
+		}
+		suite.Cases = append(suite.Cases, tc)
+		suite.Tests++
+	}
+	return suite
+}
+
+// junitFailureMessage summarizes why a case failed for a JUnit <failure>,
+// pulling messages from the case's non-passed, non-optional evaluator
+// results across all trials (deduplicated), since RunResult itself has no
+// single aggregated failure reason.
+func junitFailureMessage(rr runner.RunResult) string {
+	seen := make(map[string]struct{})
+	var messages []string
+	for _, tr := range rr.Trials {
+		for _, ev := range tr.Evaluations {
+			if ev.Passed || ev.Optional || ev.Message == "" {
+				continue
+			}
+			if _, ok := seen[ev.Message]; ok {
+				continue
+			}
+			seen[ev.Message] = struct{}{}
+			messages = append(messages, ev.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return "case did not pass"
+	}
+
+	msg := messages[0]
+	for _, m := range messages[1:] {
+		msg += "; " + m
+	}
+	return msg
+}
+
+// junitSeconds formats a millisecond duration as JUnit's fractional-seconds
+// time attribute.
+func junitSeconds(ms int64) string {
+	return fmt.Sprintf("%.3f", float64(ms)/1000)
+}
+
+// formatRunJUnit renders runs as a complete JUnit XML document, including
+// the XML declaration.
+func formatRunJUnit(runs []app.SuiteRun) (string, error) {
+	report := buildJUnitReport(runs)
+	b, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("run: marshal junit: %w", err)
+	}
+	return xml.Header + string(b) + "\n", nil
+}
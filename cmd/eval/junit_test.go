@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func TestBuildJUnitSuite(t *testing.T) {
+	t.Parallel()
+
+	run := app.SuiteRun{
+		PromptName:    "p1",
+		PromptVersion: "v2",
+		Suite:         &testcase.TestSuite{Suite: "s1"},
+		Result: &runner.SuiteResult{
+			Suite:        "s1",
+			TotalCases:   3,
+			TotalLatency: 300,
+			Results: []runner.RunResult{
+				{CaseID: "c1", Passed: true, LatencyMs: 100},
+				{
+					CaseID: "c2", Passed: false, LatencyMs: 100,
+					Trials: []runner.TrialResult{{Evaluations: []evaluator.Result{{Passed: false, Message: "expected foo, got bar"}}}},
+				},
+				{CaseID: "c3", Passed: false, LatencyMs: 100, Error: errors.New("provider timeout")},
+			},
+		},
+	}
+
+	suite := buildJUnitSuite(run)
+	if suite.Name != "p1/s1" {
+		t.Fatalf("suite name: got %q", suite.Name)
+	}
+	if suite.Tests != 3 || suite.Failures != 1 || suite.Errors != 1 {
+		t.Fatalf("suite counts: got %#v", suite)
+	}
+	if len(suite.Properties) != 2 || suite.Properties[0].Value != "p1" || suite.Properties[1].Value != "v2" {
+		t.Fatalf("suite properties: got %#v", suite.Properties)
+	}
+
+	if suite.Cases[0].Failure != nil || suite.Cases[0].Error != nil {
+		t.Fatalf("case c1: expected no failure/error, got %#v", suite.Cases[0])
+	}
+	if suite.Cases[1].Failure == nil || suite.Cases[1].Failure.Message != "expected foo, got bar" {
+		t.Fatalf("case c2: got %#v", suite.Cases[1])
+	}
+	if suite.Cases[2].Error == nil || suite.Cases[2].Error.Message != "provider timeout" {
+		t.Fatalf("case c3: got %#v", suite.Cases[2])
+	}
+}
+
+func TestBuildJUnitSuite_NilResult(t *testing.T) {
+	t.Parallel()
+
+	suite := buildJUnitSuite(app.SuiteRun{PromptName: "p1", Suite: &testcase.TestSuite{Suite: "s1"}})
+	if suite.Tests != 1 || suite.Errors != 1 || suite.Failures != 0 {
+		t.Fatalf("nil result suite counts: got %#v", suite)
+	}
+	if len(suite.Cases) != 1 || suite.Cases[0].Error == nil {
+		t.Fatalf("nil result suite cases: got %#v", suite.Cases)
+	}
+}
+
+func TestJUnitFailureMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		rr   runner.RunResult
+		want string
+	}{
+		{name: "no evaluations", rr: runner.RunResult{}, want: "case did not pass"},
+		{
+			name: "skips passed and optional",
+			rr: runner.RunResult{Trials: []runner.TrialResult{{Evaluations: []evaluator.Result{
+				{Passed: true, Message: "ignored"},
+				{Passed: false, Optional: true, Message: "ignored too"},
+				{Passed: false, Message: "real failure"},
+			}}}},
+			want: "real failure",
+		},
+		{
+			name: "dedupes across trials",
+			rr: runner.RunResult{Trials: []runner.TrialResult{
+				{Evaluations: []evaluator.Result{{Passed: false, Message: "same"}}},
+				{Evaluations: []evaluator.Result{{Passed: false, Message: "same"}, {Passed: false, Message: "other"}}},
+			}},
+			want: "same; other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := junitFailureMessage(tt.rr); got != tt.want {
+				t.Fatalf("junitFailureMessage: got %q want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJUnitSeconds(t *testing.T) {
+	t.Parallel()
+	if got := junitSeconds(1500); got != "1.500" {
+		t.Fatalf("junitSeconds: got %q", got)
+	}
+}
+
+func TestFormatRunJUnit(t *testing.T) {
+	t.Parallel()
+
+	runs := []app.SuiteRun{{
+		PromptName: "p1",
+		Suite:      &testcase.TestSuite{Suite: "s1"},
+		Result: &runner.SuiteResult{
+			Suite:   "s1",
+			Results: []runner.RunResult{{CaseID: "c1", Passed: true}},
+		},
+	}}
+
+	out, err := formatRunJUnit(runs)
+	if err != nil {
+		t.Fatalf("formatRunJUnit: %v", err)
+	}
+	if !strings.HasPrefix(out, xml.Header) {
+		t.Fatalf("expected xml header prefix, got %q", out)
+	}
+
+	var doc junitTestSuites
+	if err := xml.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("unmarshal: %v (%q)", err, out)
+	}
+	if len(doc.Suites) != 1 || doc.Suites[0].Cases[0].Name != "c1" {
+		t.Fatalf("doc: got %#v", doc)
+	}
+}
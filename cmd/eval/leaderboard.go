@@ -6,14 +6,17 @@ import (
 	"strings"
 	"text/tabwriter"
 
-	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/leaderboard"
 )
 
 type leaderboardOptions struct {
-	dataset string
-	top     int
-	format  string
+	dataset   string
+	top       int
+	format    string
+	baseline  string
+	precision int
 }
 
 func newLeaderboardCmd(st *cliState) *cobra.Command {
@@ -39,6 +42,8 @@ func newLeaderboardCmd(st *cliState) *cobra.Command {
 	cmd.Flags().StringVar(&opts.dataset, "dataset", "", "dataset name")
 	cmd.Flags().IntVar(&opts.top, "top", 20, "top N entries")
 	cmd.Flags().StringVar(&opts.format, "format", "table", "output format: table|json")
+	cmd.Flags().StringVar(&opts.baseline, "baseline", "", "model to normalize score/cost/latency against, shown as a ratio")
+	cmd.Flags().IntVar(&opts.precision, "precision", -1, "significant digits for score/accuracy/cost in table output (overrides config; json is always full precision)")
 
 	return cmd
 }
@@ -67,19 +72,49 @@ func runLeaderboard(cmd *cobra.Command, st *cliState, opts *leaderboardOptions)
 		return err
 	}
 
+	baseline := strings.TrimSpace(opts.baseline)
+	var relative []leaderboard.RelativeEntry
+	if baseline != "" {
+		relative, err = leaderboard.WithBaseline(entries, baseline)
+		if err != nil {
+			return err
+		}
+	}
+
+	precision := resolvePrecision(opts.precision, st.cfg.Evaluation.Precision)
+
 	switch strings.ToLower(strings.TrimSpace(opts.format)) {
 	case "", "table":
 		tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
-		fmt.Fprintln(tw, "RANK\tMODEL\tPROVIDER\tSCORE\tACCURACY\tLAT(ms)\tCOST\tDATE")
-		for i, e := range entries {
-			fmt.Fprintf(tw, "%d\t%s\t%s\t%.4f\t%.4f\t%d\t%.4f\t%s\n",
+		if baseline == "" {
+			fmt.Fprintln(tw, "RANK\tMODEL\tPROVIDER\tSCORE\tACCURACY\tLAT(ms)\tCOST\tDATE")
+			for i, e := range entries {
+				fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+					i+1,
+					e.Model,
+					e.Provider,
+					formatMetric(e.Score, precision),
+					formatMetric(e.Accuracy, precision),
+					e.Latency,
+					formatMetric(e.Cost, precision),
+					e.EvalDate.UTC().Format("2006-01-02 15:04:05Z"),
+				)
+			}
+			return tw.Flush()
+		}
+
+		fmt.Fprintln(tw, "RANK\tMODEL\tPROVIDER\tSCORE\tSCORE_X\tLAT(ms)\tLAT_X\tCOST\tCOST_X\tDATE")
+		for i, e := range relative {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%sx\t%d\t%sx\t%s\t%sx\t%s\n",
 				i+1,
 				e.Model,
 				e.Provider,
-				e.Score,
-				e.Accuracy,
+				formatMetric(e.Score, precision),
+				formatMetric(e.ScoreRatio, precision),
 				e.Latency,
-				e.Cost,
+				formatMetric(e.LatencyRatio, precision),
+				formatMetric(e.Cost, precision),
+				formatMetric(e.CostRatio, precision),
 				e.EvalDate.UTC().Format("2006-01-02 15:04:05Z"),
 			)
 		}
@@ -87,6 +122,9 @@ func runLeaderboard(cmd *cobra.Command, st *cliState, opts *leaderboardOptions)
 	case "json":
 		enc := json.NewEncoder(cmd.OutOrStdout())
 		enc.SetIndent("", "  ")
+		if baseline != "" {
+			return enc.Encode(relative)
+		}
 		return enc.Encode(entries)
 	default:
 		return fmt.Errorf("leaderboard: invalid --format %q (expected table|json)", opts.format)
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
 func newListCmd() *cobra.Command {
@@ -19,11 +23,35 @@ func newListCmd() *cobra.Command {
 
 	cmd.AddCommand(newListPromptsCmd())
 	cmd.AddCommand(newListTestsCmd())
+	cmd.AddCommand(newListEvaluatorsCmd())
 	return cmd
 }
 
+// listPromptRow is the JSON/CSV shape for `list prompts`.
+type listPromptRow struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+// listTestRow is the JSON/CSV shape for `list tests`.
+type listTestRow struct {
+	Suite       string `json:"suite"`
+	Prompt      string `json:"prompt"`
+	Cases       int    `json:"cases"`
+	Description string `json:"description"`
+}
+
+// listEvaluatorsResult is the JSON shape for `list evaluators`.
+type listEvaluatorsResult struct {
+	Types   []string          `json:"types"`
+	Aliases map[string]string `json:"aliases"`
+}
+
 func newListPromptsCmd() *cobra.Command {
-	return &cobra.Command{
+	var output string
+
+	cmd := &cobra.Command{
 		Use:   "prompts",
 		Short: "List available prompts",
 		Args:  cobra.NoArgs,
@@ -36,18 +64,110 @@ func newListPromptsCmd() *cobra.Command {
 				return strings.ToLower(prompts[i].Name) < strings.ToLower(prompts[j].Name)
 			})
 
-			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
-			fmt.Fprintln(tw, "NAME\tVERSION\tDESCRIPTION")
-			for _, p := range prompts {
-				fmt.Fprintf(tw, "%s\t%s\t%s\n", p.Name, p.Version, p.Description)
+			rows := make([]listPromptRow, len(prompts))
+			for i, p := range prompts {
+				rows[i] = listPromptRow{Name: p.Name, Version: p.Version, Description: p.Description}
+			}
+
+			switch strings.ToLower(strings.TrimSpace(output)) {
+			case "", "table":
+				tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "NAME\tVERSION\tDESCRIPTION")
+				for _, r := range rows {
+					fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Name, r.Version, r.Description)
+				}
+				return tw.Flush()
+			case "json":
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(rows)
+			case "csv":
+				w := csv.NewWriter(cmd.OutOrStdout())
+				if err := w.Write([]string{"name", "version", "description"}); err != nil {
+					return err
+				}
+				for _, r := range rows {
+					if err := w.Write([]string{r.Name, r.Version, r.Description}); err != nil {
+						return err
+					}
+				}
+				w.Flush()
+				return w.Error()
+			default:
+				return fmt.Errorf("list prompts: invalid --output %q (expected table|json|csv)", output)
 			}
-			return tw.Flush()
 		},
 	}
+
+	cmd.Flags().StringVar(&output, "output", "table", "output format: table|json|csv")
+	return cmd
+}
+
+func newListEvaluatorsCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "evaluators",
+		Short: "List evaluator types and their configured aliases",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			types := testcase.KnownEvaluatorTypes()
+			aliases := testcase.EvaluatorAliases()
+			aliasNames := make([]string, 0, len(aliases))
+			for alias := range aliases {
+				aliasNames = append(aliasNames, alias)
+			}
+			sort.Strings(aliasNames)
+
+			switch strings.ToLower(strings.TrimSpace(output)) {
+			case "", "table":
+				tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "TYPE")
+				for _, t := range types {
+					fmt.Fprintf(tw, "%s\n", t)
+				}
+				if len(aliasNames) > 0 {
+					fmt.Fprintln(tw, "\nALIAS\tCANONICAL")
+					for _, alias := range aliasNames {
+						fmt.Fprintf(tw, "%s\t%s\n", alias, aliases[alias])
+					}
+				}
+				return tw.Flush()
+			case "json":
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(listEvaluatorsResult{Types: types, Aliases: aliases})
+			case "csv":
+				w := csv.NewWriter(cmd.OutOrStdout())
+				if err := w.Write([]string{"kind", "name", "canonical"}); err != nil {
+					return err
+				}
+				for _, t := range types {
+					if err := w.Write([]string{"type", t, ""}); err != nil {
+						return err
+					}
+				}
+				for _, alias := range aliasNames {
+					if err := w.Write([]string{"alias", alias, aliases[alias]}); err != nil {
+						return err
+					}
+				}
+				w.Flush()
+				return w.Error()
+			default:
+				return fmt.Errorf("list evaluators: invalid --output %q (expected table|json|csv)", output)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&output, "output", "table", "output format: table|json|csv")
+	return cmd
 }
 
 func newListTestsCmd() *cobra.Command {
-	return &cobra.Command{
+	var output string
+
+	cmd := &cobra.Command{
 		Use:   "tests",
 		Short: "List available test suites",
 		Args:  cobra.NoArgs,
@@ -60,12 +180,41 @@ func newListTestsCmd() *cobra.Command {
 				return strings.ToLower(suites[i].Suite) < strings.ToLower(suites[j].Suite)
 			})
 
-			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
-			fmt.Fprintln(tw, "SUITE\tPROMPT\tCASES\tDESCRIPTION")
-			for _, s := range suites {
-				fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", s.Suite, s.Prompt, len(s.Cases), s.Description)
+			rows := make([]listTestRow, len(suites))
+			for i, s := range suites {
+				rows[i] = listTestRow{Suite: s.Suite, Prompt: s.Prompt, Cases: len(s.Cases), Description: s.Description}
+			}
+
+			switch strings.ToLower(strings.TrimSpace(output)) {
+			case "", "table":
+				tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "SUITE\tPROMPT\tCASES\tDESCRIPTION")
+				for _, r := range rows {
+					fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", r.Suite, r.Prompt, r.Cases, r.Description)
+				}
+				return tw.Flush()
+			case "json":
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(rows)
+			case "csv":
+				w := csv.NewWriter(cmd.OutOrStdout())
+				if err := w.Write([]string{"suite", "prompt", "cases", "description"}); err != nil {
+					return err
+				}
+				for _, r := range rows {
+					if err := w.Write([]string{r.Suite, r.Prompt, strconv.Itoa(r.Cases), r.Description}); err != nil {
+						return err
+					}
+				}
+				w.Flush()
+				return w.Error()
+			default:
+				return fmt.Errorf("list tests: invalid --output %q (expected table|json|csv)", output)
 			}
-			return tw.Flush()
 		},
 	}
+
+	cmd.Flags().StringVar(&output, "output", "table", "output format: table|json|csv")
+	return cmd
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"testing"
 )
@@ -36,11 +37,56 @@ func TestListCommands_ErrorPaths(t *testing.T) {
 	}
 }
 
+func TestListEvaluatorsCmd(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	cmd := newListEvaluatorsCmd()
+	cmd.SetOut(&out)
+	if err := cmd.Flags().Set("output", "json"); err != nil {
+		t.Fatalf("Set output: %v", err)
+	}
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("RunE: %v", err)
+	}
+
+	var got listEvaluatorsResult
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(got.Types) == 0 {
+		t.Fatalf("expected non-empty types")
+	}
+	if got.Aliases["judge"] != "llm_judge" {
+		t.Fatalf("aliases[judge]: got %q want llm_judge", got.Aliases["judge"])
+	}
+
+	out.Reset()
+	cmd = newListEvaluatorsCmd()
+	cmd.SetOut(&out)
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("RunE (table): %v", err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("ALIAS")) {
+		t.Fatalf("expected table output to include an ALIAS section, got %q", out.String())
+	}
+
+	out.Reset()
+	cmd = newListEvaluatorsCmd()
+	cmd.SetOut(&out)
+	if err := cmd.Flags().Set("output", "bogus"); err != nil {
+		t.Fatalf("Set output: %v", err)
+	}
+	if err := cmd.RunE(cmd, nil); err == nil {
+		t.Fatalf("expected error for invalid --output")
+	}
+}
+
 func TestListCmd_Wiring(t *testing.T) {
 	t.Parallel()
 
 	cmd := newListCmd()
-	if cmd == nil || len(cmd.Commands()) != 2 {
+	if cmd == nil || len(cmd.Commands()) != 3 {
 		t.Fatalf("cmd=%#v", cmd)
 	}
 	for _, c := range cmd.Commands() {
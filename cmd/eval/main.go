@@ -11,8 +11,9 @@ import (
 )
 
 const (
-	defaultPromptsDir = "prompts"
-	defaultTestsDir   = "tests"
+	defaultPromptsDir  = "prompts"
+	defaultTestsDir    = "tests"
+	defaultBaselineDir = "baselines"
 )
 
 type cliState struct {
@@ -56,7 +57,16 @@ func newRootCmd() *cobra.Command {
 	root.AddCommand(newOptimizeCmd(st))
 	root.AddCommand(newDiagnoseCmd(st))
 	root.AddCommand(newFixCmd(st))
+	root.AddCommand(newExplainCmd(st))
 	root.AddCommand(newBenchmarkCmd(st))
 	root.AddCommand(newLeaderboardCmd(st))
+	root.AddCommand(newStoreCmd(st))
+	root.AddCommand(newImportCmd())
+	root.AddCommand(newBaselineCmd(st))
+	root.AddCommand(newCalibrateCmd(st))
+	root.AddCommand(newRescoreCmd(st))
+	root.AddCommand(newServerCmd())
+	root.AddCommand(newSuitesCmd(st))
+	root.AddCommand(newVersionCmd())
 	return root
 }
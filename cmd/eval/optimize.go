@@ -17,16 +17,20 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/optimizer"
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
 func newOptimizeCmd(st *cliState) *cobra.Command {
 	var (
-		promptFile   string
-		outputFile   string
-		numCases     int
-		maxIter      int
-		showProgress bool
-		varFlags     []string
+		promptFile     string
+		outputFile     string
+		numCases       int
+		maxIter        int
+		minImprovement float64
+		showProgress   bool
+		varFlags       []string
+		saveTestsFile  string
+		forceSaveTests bool
 	)
 
 	cmd := &cobra.Command{
@@ -117,6 +121,15 @@ Examples:
 				fmt.Println("\n🚀 Running evaluation...")
 			}
 
+			if saveTestsFile != "" {
+				if err := saveGeneratedTests(genResult.Suite, saveTestsFile, forceSaveTests); err != nil {
+					return fmt.Errorf("failed to save generated tests: %w", err)
+				}
+				if showProgress {
+					fmt.Printf("💾 Saved generated tests to: %s\n", saveTestsFile)
+				}
+			}
+
 			p := &prompt.Prompt{
 				Name:           promptName,
 				Template:       promptContent,
@@ -151,35 +164,103 @@ Examples:
 				fmt.Println("\n🔧 Optimizing prompt based on evaluation results...")
 			}
 
+			if maxIter < 1 {
+				maxIter = 1
+			}
+
 			opt := &optimizer.Optimizer{Provider: provider}
-			optResult, err := opt.Optimize(ctx, &optimizer.OptimizeRequest{
-				OriginalPrompt: promptContent,
-				EvalResults:    suiteResult,
-				MaxIterations:  maxIter,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to optimize prompt: %w", err)
+
+			bestPrompt := promptContent
+			lastAttempt := promptContent
+			bestResult := suiteResult
+			evalResults := suiteResult
+			var bestOpt *optimizer.OptimizeResult
+			var iterations []optimizeIteration
+
+			for i := 1; i <= maxIter; i++ {
+				optResult, err := opt.Optimize(ctx, &optimizer.OptimizeRequest{
+					OriginalPrompt: bestPrompt,
+					EvalResults:    evalResults,
+					MaxIterations:  1,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to optimize prompt (iteration %d): %w", i, err)
+				}
+
+				candidate := &prompt.Prompt{
+					Name:           promptName,
+					Template:       optResult.OptimizedPrompt,
+					IsSystemPrompt: genResult.IsSystemPrompt,
+				}
+				candidateResult, _ := r.RunSuite(ctx, candidate, genResult.Suite)
+				lastAttempt = optResult.OptimizedPrompt
+
+				improvement := candidateResult.AvgScore - bestResult.AvgScore
+				if prDelta := candidateResult.PassRate - bestResult.PassRate; prDelta > improvement {
+					improvement = prDelta
+				}
+				accepted := candidateResult.PassRate > bestResult.PassRate || candidateResult.AvgScore > bestResult.AvgScore
+
+				iterations = append(iterations, optimizeIteration{
+					N:           i,
+					PassRate:    candidateResult.PassRate,
+					AvgScore:    candidateResult.AvgScore,
+					Improvement: improvement,
+					Accepted:    accepted,
+				})
+
+				if accepted {
+					bestPrompt = optResult.OptimizedPrompt
+					bestResult = candidateResult
+					evalResults = candidateResult
+					bestOpt = optResult
+				} else {
+					// Feed the rejected candidate's failures back into the next
+					// attempt while continuing to optimize from the best prompt.
+					evalResults = candidateResult
+				}
+
+				if accepted && improvement < minImprovement {
+					break
+				}
 			}
 
-			if showProgress {
+			fmt.Println("\n📊 Optimization Iterations:")
+			for _, it := range iterations {
+				status := "rejected"
+				if it.Accepted {
+					status = "accepted"
+				}
+				fmt.Printf("  %d. pass=%.1f%% avg=%.2f Δ%.3f (%s)\n", it.N, it.PassRate*100, it.AvgScore, it.Improvement, status)
+			}
+
+			if bestOpt == nil {
+				if showProgress {
+					fmt.Println("\n⚠️  No improving candidate found; keeping last attempted prompt.")
+				}
+				bestPrompt = lastAttempt
+			} else if showProgress {
 				fmt.Println("\n📝 Optimization Summary:")
-				fmt.Println(optResult.Summary)
+				fmt.Println(bestOpt.Summary)
 				fmt.Println("\n🔄 Changes Made:")
-				for i, c := range optResult.Changes {
+				for i, c := range bestOpt.Changes {
 					fmt.Printf("  %d. [%s] %s\n", i+1, c.Type, c.Description)
 				}
 			}
 
 			if outputFile != "" {
-				if err := os.WriteFile(outputFile, []byte(optResult.OptimizedPrompt), 0644); err != nil {
+				if err := os.WriteFile(outputFile, []byte(bestPrompt), 0644); err != nil {
 					return fmt.Errorf("failed to write output file: %w", err)
 				}
 				if showProgress {
 					fmt.Printf("\n✅ Optimized prompt saved to: %s\n", outputFile)
 				}
+			} else if bestOpt == nil {
+				fmt.Println("\n--- Last Attempted Prompt (No Improvement Found) ---")
+				fmt.Println(bestPrompt)
 			} else {
 				fmt.Println("\n--- Optimized Prompt ---")
-				fmt.Println(optResult.OptimizedPrompt)
+				fmt.Println(bestPrompt)
 			}
 
 			return nil
@@ -190,8 +271,77 @@ Examples:
 	cmd.Flags().StringVarP(&outputFile, "output", "o", "", "output file for optimized prompt")
 	cmd.Flags().IntVarP(&numCases, "cases", "c", 5, "number of test cases to generate")
 	cmd.Flags().IntVar(&maxIter, "iterations", 1, "max optimization iterations")
+	cmd.Flags().Float64Var(&minImprovement, "min-improvement", 0.01, "minimum pass rate/avg score improvement to keep iterating")
 	cmd.Flags().BoolVar(&showProgress, "progress", true, "show progress messages")
 	cmd.Flags().StringArrayVar(&varFlags, "var", nil, "template variable in KEY=VALUE format (can be repeated)")
+	cmd.Flags().StringVar(&saveTestsFile, "save-tests", "", "save the generated test suite to this YAML file (for reuse with 'run')")
+	cmd.Flags().BoolVar(&forceSaveTests, "force", false, "overwrite --save-tests file instead of merging new cases into it")
 
 	return cmd
 }
+
+// optimizeIteration records the outcome of a single optimize→eval pass, used
+// to report convergence across the --iterations loop in runOptimize.
+type optimizeIteration struct {
+	N           int
+	PassRate    float64
+	AvgScore    float64
+	Improvement float64
+	Accepted    bool
+}
+
+// saveGeneratedTests writes suite to path so it can be reused by `ai-eval
+// run`. If path already exists, force overwrites it outright; otherwise the
+// new cases are merged into the existing suite under fresh IDs.
+func saveGeneratedTests(suite *testcase.TestSuite, path string, force bool) error {
+	if suite == nil {
+		return errors.New("optimize: nil generated suite")
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		if force {
+			return writeYAML(path, suite)
+		}
+
+		existing, err := testcase.LoadFromFile(path)
+		if err != nil {
+			return fmt.Errorf("load existing suite %q: %w", path, err)
+		}
+
+		takenIDs := make(map[string]bool, len(existing.Cases))
+		for _, c := range existing.Cases {
+			takenIDs[c.ID] = true
+		}
+
+		merged := *existing
+		merged.Cases = append([]testcase.TestCase(nil), existing.Cases...)
+		for _, c := range suite.Cases {
+			c.ID = freshCaseID(c.ID, takenIDs)
+			takenIDs[c.ID] = true
+			merged.Cases = append(merged.Cases, c)
+		}
+
+		return writeYAML(path, &merged)
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("stat %q: %w", path, err)
+	}
+
+	return writeYAML(path, suite)
+}
+
+// freshCaseID returns id unchanged if it isn't already taken, otherwise
+// appends an incrementing suffix until a unique ID is found.
+func freshCaseID(id string, taken map[string]bool) string {
+	if id == "" {
+		id = "case"
+	}
+	if !taken[id] {
+		return id
+	}
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", id, n)
+		if !taken[candidate] {
+			return candidate
+		}
+	}
+}
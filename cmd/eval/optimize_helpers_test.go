@@ -0,0 +1,101 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func TestSaveGeneratedTests_NewFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "generated.yaml")
+
+	suite := &testcase.TestSuite{
+		Suite:  "p_tests",
+		Prompt: "p",
+		Cases: []testcase.TestCase{
+			{ID: "c1", Input: map[string]any{}, Expected: testcase.Expected{Contains: []string{"ok"}}},
+		},
+	}
+
+	if err := saveGeneratedTests(suite, path, false); err != nil {
+		t.Fatalf("saveGeneratedTests: %v", err)
+	}
+
+	got, err := testcase.LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile: %v", err)
+	}
+	if len(got.Cases) != 1 || got.Cases[0].ID != "c1" {
+		t.Fatalf("unexpected cases: %+v", got.Cases)
+	}
+}
+
+func TestSaveGeneratedTests_MergesUnderFreshIDs(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "generated.yaml")
+	writeFile(t, path, "suite: p_tests\nprompt: p\ncases:\n  - id: c1\n    input: {}\n    expected:\n      contains: [ok]\n")
+
+	suite := &testcase.TestSuite{
+		Suite:  "p_tests",
+		Prompt: "p",
+		Cases: []testcase.TestCase{
+			{ID: "c1", Input: map[string]any{}, Expected: testcase.Expected{Contains: []string{"new"}}},
+		},
+	}
+
+	if err := saveGeneratedTests(suite, path, false); err != nil {
+		t.Fatalf("saveGeneratedTests: %v", err)
+	}
+
+	got, err := testcase.LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile: %v", err)
+	}
+	if len(got.Cases) != 2 {
+		t.Fatalf("expected 2 merged cases, got %d", len(got.Cases))
+	}
+	if got.Cases[0].ID != "c1" || got.Cases[1].ID != "c1-2" {
+		t.Fatalf("unexpected ids: %s, %s", got.Cases[0].ID, got.Cases[1].ID)
+	}
+}
+
+func TestSaveGeneratedTests_ForceOverwrites(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "generated.yaml")
+	writeFile(t, path, "suite: p_tests\nprompt: p\ncases:\n  - id: c1\n    input: {}\n    expected:\n      contains: [ok]\n")
+
+	suite := &testcase.TestSuite{
+		Suite:  "p_tests",
+		Prompt: "p",
+		Cases: []testcase.TestCase{
+			{ID: "c2", Input: map[string]any{}, Expected: testcase.Expected{Contains: []string{"new"}}},
+		},
+	}
+
+	if err := saveGeneratedTests(suite, path, true); err != nil {
+		t.Fatalf("saveGeneratedTests: %v", err)
+	}
+
+	got, err := testcase.LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile: %v", err)
+	}
+	if len(got.Cases) != 1 || got.Cases[0].ID != "c2" {
+		t.Fatalf("expected file replaced with single c2 case, got %+v", got.Cases)
+	}
+}
+
+func TestFreshCaseID(t *testing.T) {
+	taken := map[string]bool{"c1": true, "c1-2": true}
+	if got := freshCaseID("c1", taken); got != "c1-3" {
+		t.Fatalf("freshCaseID: got %q, want %q", got, "c1-3")
+	}
+	if got := freshCaseID("c9", taken); got != "c9" {
+		t.Fatalf("freshCaseID: got %q, want %q", got, "c9")
+	}
+	if got := freshCaseID("", taken); got != "case" {
+		t.Fatalf("freshCaseID: got %q, want %q", got, "case")
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -13,38 +15,117 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/runner"
 )
 
+// defaultPrecision is the significant-digit precision used for
+// pass_rate/avg_score/score metrics in table and github output when neither
+// --precision nor config's evaluation.precision is set. JSON output is
+// unaffected by precision and always reports full float64 values, since
+// programmatic consumers shouldn't have their inputs lossily rounded.
+const defaultPrecision = 4
+
+// resolvePrecision picks the effective significant-digit precision: an
+// explicit --precision flag (>= 0) wins, otherwise config's
+// evaluation.precision, defaulting to defaultPrecision when both are unset.
+func resolvePrecision(flagValue int, configValue int) int {
+	if flagValue >= 0 {
+		return flagValue
+	}
+	if configValue > 0 {
+		return configValue
+	}
+	return defaultPrecision
+}
+
+// roundSigFigs rounds v to the given number of significant decimal digits.
+// digits <= 0 (or a non-finite/zero v) leaves v unrounded.
+func roundSigFigs(v float64, digits int) float64 {
+	if digits <= 0 || v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	mag := math.Floor(math.Log10(math.Abs(v))) + 1
+	factor := math.Pow(10, float64(digits)-mag)
+	return math.Round(v*factor) / factor
+}
+
+// formatMetric renders v rounded to precision significant digits (see
+// roundSigFigs).
+func formatMetric(v float64, precision int) string {
+	return strconv.FormatFloat(roundSigFigs(v, precision), 'f', -1, 64)
+}
+
+// formatMetricSigned is formatMetric with an explicit leading sign, for
+// deltas between two runs.
+func formatMetricSigned(v float64, precision int) string {
+	s := formatMetric(v, precision)
+	if !strings.HasPrefix(s, "-") {
+		s = "+" + s
+	}
+	return s
+}
+
 type OutputFormat string
 
 const (
 	FormatTable  OutputFormat = "table"
 	FormatJSON   OutputFormat = "json"
+	FormatJSONL  OutputFormat = "jsonl"
 	FormatGitHub OutputFormat = "github"
+	FormatJUnit  OutputFormat = "junit"
 )
 
 const (
-	colorReset = "\033[0m"
-	colorRed   = "\033[31m"
-	colorGreen = "\033[32m"
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
 )
 
 func parseOutputFormat(s string) OutputFormat {
 	switch strings.ToLower(strings.TrimSpace(s)) {
 	case "table":
 		return FormatTable
-	case "json", "jsonl":
+	case "json":
 		return FormatJSON
+	case "jsonl":
+		return FormatJSONL
 	case "github", "gh":
 		return FormatGitHub
+	case "junit":
+		return FormatJUnit
 	default:
 		return ""
 	}
 }
 
-func resolveOutputFormat(flagValue string, configValue string, all bool) (OutputFormat, error) {
+// resolveOutputFormat picks the effective output format: an explicit --output
+// flag wins, otherwise the config value, defaulting to FormatTable (or
+// FormatJSON under --all, since --all doesn't support FormatTable).
+// extra lists formats beyond table|json|github this caller accepts, e.g. the
+// run command's FormatJSONL/FormatJUnit, which stream/report on a live run
+// rather than rendering a single already-computed SuiteResult; other
+// commands don't pass them, since FormatSuiteResult and FormatCompareResult
+// have no case for either.
+func resolveOutputFormat(flagValue string, configValue string, all bool, extra ...OutputFormat) (OutputFormat, error) {
+	allowed := func(f OutputFormat) bool {
+		if f == FormatTable || f == FormatJSON || f == FormatGitHub {
+			return true
+		}
+		for _, e := range extra {
+			if f == e {
+				return true
+			}
+		}
+		return false
+	}
+
+	expected := "table|json|github"
+	for _, e := range extra {
+		expected += "|" + string(e)
+	}
+
 	if strings.TrimSpace(flagValue) != "" {
 		out := parseOutputFormat(flagValue)
-		if out == "" {
-			return "", fmt.Errorf("invalid --output %q (expected table|json|github)", flagValue)
+		if out == "" || !allowed(out) {
+			return "", fmt.Errorf("invalid --output %q (expected %s)", flagValue, expected)
 		}
 		if all && out == FormatTable {
 			return "", fmt.Errorf("--all does not support --output table")
@@ -52,7 +133,7 @@ func resolveOutputFormat(flagValue string, configValue string, all bool) (Output
 		return out, nil
 	}
 
-	if out := parseOutputFormat(configValue); out != "" {
+	if out := parseOutputFormat(configValue); out != "" && allowed(out) {
 		if all && out == FormatTable {
 			return FormatJSON, nil
 		}
@@ -72,55 +153,91 @@ func coloredStatus(passed bool) string {
 	return colorRed + "FAIL" + colorReset
 }
 
+// caseStatus is coloredStatus plus a distinct SKIP status for cases
+// runner.Config.FailFast canceled before they ran, so they don't read as
+// failures in table output.
+func caseStatus(rr runner.RunResult) string {
+	if rr.Skipped {
+		return colorYellow + "SKIP" + colorReset
+	}
+	return coloredStatus(rr.Passed)
+}
+
 func suitePassed(res *runner.SuiteResult) bool {
 	return res != nil && res.FailedCases == 0
 }
 
-func FormatSuiteResult(result *runner.SuiteResult, format OutputFormat) string {
+// FormatSuiteResult renders result in the given format. showPassMetrics, for
+// the human-readable table and github formats, adds the raw per-case trial
+// pass rate alongside PassAtK and labels which one gates pass/fail (JSON
+// always includes both, since it's meant for machine consumption).
+func FormatSuiteResult(result *runner.SuiteResult, format OutputFormat, showPassMetrics bool, precision int) string {
 	switch format {
 	case FormatTable:
-		return formatSuiteTable(result)
+		return formatSuiteTable(result, showPassMetrics, precision)
 	case FormatJSON:
 		return formatSuiteJSON(result)
 	case FormatGitHub:
-		return formatSuiteGitHub(result)
+		return formatSuiteGitHub(result, showPassMetrics, precision)
 	default:
 		return fmt.Sprintf("error: unknown output format %q\n", format)
 	}
 }
 
-func FormatCompareResult(v1, v2 *runner.SuiteResult, format OutputFormat) string {
+func FormatCompareResult(v1, v2 *runner.SuiteResult, format OutputFormat, precision int) string {
 	switch format {
 	case FormatTable:
-		return formatCompareTable(v1, v2)
+		return formatCompareTable(v1, v2, precision)
 	case FormatJSON:
 		return formatCompareJSON(v1, v2)
 	case FormatGitHub:
-		return formatCompareGitHub(v1, v2)
+		return formatCompareGitHub(v1, v2, precision)
 	default:
 		return fmt.Sprintf("error: unknown output format %q\n", format)
 	}
 }
 
-func formatSuiteTable(result *runner.SuiteResult) string {
+func formatSuiteTable(result *runner.SuiteResult, showPassMetrics bool, precision int) string {
 	if result == nil {
 		return "Suite: <nil> " + coloredStatus(false) + "\n\n"
 	}
 
 	var buf bytes.Buffer
 	fmt.Fprintf(&buf, "Suite: %s %s\n", result.Suite, coloredStatus(suitePassed(result)))
-	fmt.Fprintf(&buf, "Cases: %d passed=%d failed=%d pass_rate=%.2f avg_score=%.2f latency_ms=%d tokens=%d\n",
-		result.TotalCases, result.PassedCases, result.FailedCases, result.PassRate, result.AvgScore, result.TotalLatency, result.TotalTokens)
+	fmt.Fprintf(&buf, "Cases: %d passed=%d failed=%d skipped=%d pass_rate=%s avg_score=%s latency_ms=%d tokens=%d\n",
+		result.TotalCases, result.PassedCases, result.FailedCases, result.SkippedCases, formatMetric(result.PassRate, precision), formatMetric(result.AvgScore, precision), result.TotalLatency, result.TotalTokens)
+	if result.TimedOut {
+		fmt.Fprintln(&buf, "Warning: suite timeout exceeded, remaining cases were not run")
+	}
+	if sc := result.SchemaConformance; sc != nil {
+		fmt.Fprintf(&buf, "Schema conformance: %d/%d (%s)\n", sc.ConformingResponses, sc.TotalResponses, formatMetric(sc.ConformanceRate, precision))
+		for _, v := range sc.WorstOffenders {
+			fmt.Fprintf(&buf, "  non-conforming: case=%s trial=%d %s\n", v.CaseID, v.TrialNum, v.Message)
+		}
+	}
 
 	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(tw, "CASE\tRESULT\tSCORE\tPASS@K\tLAT(ms)\tTOKENS\tERROR")
-	for _, rr := range result.Results {
-		errMsg := ""
-		if rr.Error != nil {
-			errMsg = rr.Error.Error()
+	if showPassMetrics {
+		fmt.Fprintln(&buf, "Pass/fail gate: PASS@K >= threshold (TRIAL_PASS_RATE is informational only)")
+		fmt.Fprintln(tw, "CASE\tRESULT\tSCORE\tTRIAL_PASS_RATE\tPASS@K (gate)\tLAT(ms)\tTOKENS\tERROR")
+		for _, rr := range result.Results {
+			errMsg := ""
+			if rr.Error != nil {
+				errMsg = rr.Error.Error()
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\n",
+				rr.CaseID, caseStatus(rr), formatMetric(rr.Score, precision), formatMetric(rr.TrialPassRate, precision), formatMetric(rr.PassAtK, precision), rr.LatencyMs, rr.TokensUsed, errMsg)
+		}
+	} else {
+		fmt.Fprintln(tw, "CASE\tRESULT\tSCORE\tPASS@K\tLAT(ms)\tTOKENS\tERROR")
+		for _, rr := range result.Results {
+			errMsg := ""
+			if rr.Error != nil {
+				errMsg = rr.Error.Error()
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%d\t%s\n",
+				rr.CaseID, caseStatus(rr), formatMetric(rr.Score, precision), formatMetric(rr.PassAtK, precision), rr.LatencyMs, rr.TokensUsed, errMsg)
 		}
-		fmt.Fprintf(tw, "%s\t%s\t%.3f\t%.3f\t%d\t%d\t%s\n",
-			rr.CaseID, coloredStatus(rr.Passed), rr.Score, rr.PassAtK, rr.LatencyMs, rr.TokensUsed, errMsg)
 	}
 	_ = tw.Flush()
 	buf.WriteByte('\n')
@@ -128,38 +245,63 @@ func formatSuiteTable(result *runner.SuiteResult) string {
 }
 
 type jsonSuiteResult struct {
-	Suite        string        `json:"suite"`
-	Passed       bool          `json:"passed"`
-	TotalCases   int           `json:"total_cases"`
-	PassedCases  int           `json:"passed_cases"`
-	FailedCases  int           `json:"failed_cases"`
-	PassRate     float64       `json:"pass_rate"`
-	AvgScore     float64       `json:"avg_score"`
-	TotalLatency int64         `json:"total_latency_ms"`
-	TotalTokens  int           `json:"total_tokens"`
-	Cases        []jsonCaseRun `json:"cases"`
+	Suite             string                 `json:"suite"`
+	Passed            bool                   `json:"passed"`
+	TotalCases        int                    `json:"total_cases"`
+	PassedCases       int                    `json:"passed_cases"`
+	FailedCases       int                    `json:"failed_cases"`
+	SkippedCases      int                    `json:"skipped_cases,omitempty"`
+	PassRate          float64                `json:"pass_rate"`
+	AvgScore          float64                `json:"avg_score"`
+	AvgAgreement      float64                `json:"avg_agreement"`
+	TimedOut          bool                   `json:"timed_out,omitempty"`
+	TotalLatency      int64                  `json:"total_latency_ms"`
+	TotalTokens       int                    `json:"total_tokens"`
+	SchemaConformance *jsonSchemaConformance `json:"schema_conformance,omitempty"`
+	Cases             []jsonCaseRun          `json:"cases"`
+}
+
+type jsonSchemaConformance struct {
+	TotalResponses      int                 `json:"total_responses"`
+	ConformingResponses int                 `json:"conforming_responses"`
+	ConformanceRate     float64             `json:"conformance_rate"`
+	WorstOffenders      []jsonSchemaOffense `json:"worst_offenders,omitempty"`
+}
+
+type jsonSchemaOffense struct {
+	CaseID   string `json:"case_id"`
+	TrialNum int    `json:"trial_num"`
+	Message  string `json:"message"`
 }
 
 type jsonCaseRun struct {
-	CaseID     string         `json:"case_id"`
-	Passed     bool           `json:"passed"`
-	Score      float64        `json:"score"`
-	PassAtK    float64        `json:"pass_at_k"`
-	PassExpK   float64        `json:"pass_exp_k"`
-	LatencyMs  int64          `json:"latency_ms"`
-	TokensUsed int            `json:"tokens_used"`
-	Error      string         `json:"error,omitempty"`
-	Trials     []jsonTrialRun `json:"trials,omitempty"`
+	CaseID           string         `json:"case_id"`
+	Passed           bool           `json:"passed"`
+	Skipped          bool           `json:"skipped,omitempty"`
+	Score            float64        `json:"score"`
+	TrialPassRate    float64        `json:"trial_pass_rate"`
+	TrialAgreement   float64        `json:"trial_agreement"`
+	PassAtK          float64        `json:"pass_at_k"`
+	PassExpK         float64        `json:"pass_exp_k"`
+	PassGate         string         `json:"pass_gate"`
+	LatencyMs        int64          `json:"latency_ms"`
+	TokensUsed       int            `json:"tokens_used"`
+	PromptTokens     int            `json:"prompt_tokens"`
+	CompletionTokens int            `json:"completion_tokens"`
+	Error            string         `json:"error,omitempty"`
+	Trials           []jsonTrialRun `json:"trials,omitempty"`
 }
 
 type jsonTrialRun struct {
-	TrialNum    int                `json:"trial_num"`
-	Response    string             `json:"response"`
-	ToolCalls   []llm.ToolUse      `json:"tool_calls,omitempty"`
-	Evaluations []evaluator.Result `json:"evaluations,omitempty"`
-	Passed      bool               `json:"passed"`
-	Score       float64            `json:"score"`
-	LatencyMs   int64              `json:"latency_ms"`
+	TrialNum         int                `json:"trial_num"`
+	Response         string             `json:"response"`
+	ToolCalls        []llm.ToolUse      `json:"tool_calls,omitempty"`
+	Evaluations      []evaluator.Result `json:"evaluations,omitempty"`
+	Passed           bool               `json:"passed"`
+	Score            float64            `json:"score"`
+	LatencyMs        int64              `json:"latency_ms"`
+	PromptTokens     int                `json:"prompt_tokens"`
+	CompletionTokens int                `json:"completion_tokens"`
 }
 
 func suiteResultToJSON(result *runner.SuiteResult) jsonSuiteResult {
@@ -169,36 +311,60 @@ func suiteResultToJSON(result *runner.SuiteResult) jsonSuiteResult {
 		TotalCases:   result.TotalCases,
 		PassedCases:  result.PassedCases,
 		FailedCases:  result.FailedCases,
+		SkippedCases: result.SkippedCases,
 		PassRate:     result.PassRate,
 		AvgScore:     result.AvgScore,
+		AvgAgreement: result.AvgAgreement,
+		TimedOut:     result.TimedOut,
 		TotalLatency: result.TotalLatency,
 		TotalTokens:  result.TotalTokens,
 		Cases:        make([]jsonCaseRun, 0, len(result.Results)),
 	}
 
+	if sc := result.SchemaConformance; sc != nil {
+		offenders := make([]jsonSchemaOffense, 0, len(sc.WorstOffenders))
+		for _, v := range sc.WorstOffenders {
+			offenders = append(offenders, jsonSchemaOffense{CaseID: v.CaseID, TrialNum: v.TrialNum, Message: v.Message})
+		}
+		out.SchemaConformance = &jsonSchemaConformance{
+			TotalResponses:      sc.TotalResponses,
+			ConformingResponses: sc.ConformingResponses,
+			ConformanceRate:     sc.ConformanceRate,
+			WorstOffenders:      offenders,
+		}
+	}
+
 	for _, rr := range result.Results {
 		caseOut := jsonCaseRun{
-			CaseID:     rr.CaseID,
-			Passed:     rr.Passed,
-			Score:      rr.Score,
-			PassAtK:    rr.PassAtK,
-			PassExpK:   rr.PassExpK,
-			LatencyMs:  rr.LatencyMs,
-			TokensUsed: rr.TokensUsed,
-			Trials:     make([]jsonTrialRun, 0, len(rr.Trials)),
+			CaseID:           rr.CaseID,
+			Passed:           rr.Passed,
+			Skipped:          rr.Skipped,
+			Score:            rr.Score,
+			TrialPassRate:    rr.TrialPassRate,
+			TrialAgreement:   rr.TrialAgreement,
+			PassAtK:          rr.PassAtK,
+			PassExpK:         rr.PassExpK,
+			PassGate:         "pass_at_k",
+			LatencyMs:        rr.LatencyMs,
+			TokensUsed:       rr.TokensUsed,
+			PromptTokens:     rr.PromptTokens,
+			CompletionTokens: rr.CompletionTokens,
+			Trials:           make([]jsonTrialRun, 0, len(rr.Trials)),
 		}
 		if rr.Error != nil {
 			caseOut.Error = rr.Error.Error()
 		}
 		for _, tr := range rr.Trials {
 			caseOut.Trials = append(caseOut.Trials, jsonTrialRun{
-				TrialNum:    tr.TrialNum,
-				Response:    tr.Response,
-				ToolCalls:   tr.ToolCalls,
-				Evaluations: tr.Evaluations,
-				Passed:      tr.Passed,
-				Score:       tr.Score,
-				LatencyMs:   tr.LatencyMs,
+				TrialNum:         tr.TrialNum,
+				Response:         tr.Response,
+				ToolCalls:        tr.ToolCalls,
+				Evaluations:      tr.Evaluations,
+				Passed:           tr.Passed,
+				Score:            tr.Score,
+				LatencyMs:        tr.LatencyMs,
+				PromptTokens:     tr.PromptTokens,
+				CompletionTokens: tr.CompletionTokens,
 			})
 		}
 		out.Cases = append(out.Cases, caseOut)
@@ -221,17 +387,20 @@ func formatSuiteJSON(result *runner.SuiteResult) string {
 	return string(b) + "\n"
 }
 
-func formatSuiteGitHub(result *runner.SuiteResult) string {
+func formatSuiteGitHub(result *runner.SuiteResult, showPassMetrics bool, precision int) string {
 	if result == nil {
 		return "::error::nil suite result\n"
 	}
 
 	var buf strings.Builder
 	for _, rr := range result.Results {
-		if rr.Passed {
+		if rr.Passed || rr.Skipped {
 			continue
 		}
-		msg := fmt.Sprintf("suite=%s case=%s score=%.3f pass@k=%.3f", result.Suite, rr.CaseID, rr.Score, rr.PassAtK)
+		msg := fmt.Sprintf("suite=%s case=%s score=%s pass@k=%s", result.Suite, rr.CaseID, formatMetric(rr.Score, precision), formatMetric(rr.PassAtK, precision))
+		if showPassMetrics {
+			msg += fmt.Sprintf(" trial_pass_rate=%s (gate: pass@k)", formatMetric(rr.TrialPassRate, precision))
+		}
 		if rr.Error != nil {
 			msg += " error=" + rr.Error.Error()
 		}
@@ -240,8 +409,15 @@ func formatSuiteGitHub(result *runner.SuiteResult) string {
 		buf.WriteByte('\n')
 	}
 
-	buf.WriteString(fmt.Sprintf("Summary: suite=%s cases=%d passed=%d failed=%d pass_rate=%.3f avg_score=%.3f\n",
-		result.Suite, result.TotalCases, result.PassedCases, result.FailedCases, result.PassRate, result.AvgScore))
+	if result.TimedOut {
+		buf.WriteString(fmt.Sprintf("::warning::suite=%s timed out, remaining cases were not run\n", result.Suite))
+	}
+	if sc := result.SchemaConformance; sc != nil && sc.ConformingResponses < sc.TotalResponses {
+		buf.WriteString(fmt.Sprintf("::warning::suite=%s schema_conformance=%d/%d\n", result.Suite, sc.ConformingResponses, sc.TotalResponses))
+	}
+
+	buf.WriteString(fmt.Sprintf("Summary: suite=%s cases=%d passed=%d failed=%d skipped=%d pass_rate=%s avg_score=%s\n",
+		result.Suite, result.TotalCases, result.PassedCases, result.FailedCases, result.SkippedCases, formatMetric(result.PassRate, precision), formatMetric(result.AvgScore, precision)))
 	return buf.String()
 }
 
@@ -384,13 +560,13 @@ func isRegression(d compareCaseDiff) bool {
 	return false
 }
 
-func formatCompareTable(v1, v2 *runner.SuiteResult) string {
+func formatCompareTable(v1, v2 *runner.SuiteResult, precision int) string {
 	summary, diffs := buildCompare(v1, v2)
 
 	var buf bytes.Buffer
 	fmt.Fprintf(&buf, "Suite: %s\n", summary.Suite)
-	fmt.Fprintf(&buf, "PassRate: v1=%.3f v2=%.3f diff=%+.3f\n", summary.V1PassRate, summary.V2PassRate, summary.PassRateDelta)
-	fmt.Fprintf(&buf, "AvgScore: v1=%.3f v2=%.3f diff=%+.3f\n", summary.V1AvgScore, summary.V2AvgScore, summary.AvgScoreDelta)
+	fmt.Fprintf(&buf, "PassRate: v1=%s v2=%s diff=%s\n", formatMetric(summary.V1PassRate, precision), formatMetric(summary.V2PassRate, precision), formatMetricSigned(summary.PassRateDelta, precision))
+	fmt.Fprintf(&buf, "AvgScore: v1=%s v2=%s diff=%s\n", formatMetric(summary.V1AvgScore, precision), formatMetric(summary.V2AvgScore, precision), formatMetricSigned(summary.AvgScoreDelta, precision))
 
 	if summary.MissingInV1 > 0 || summary.MissingInV2 > 0 {
 		sort.Strings(summary.MissingCaseIDs)
@@ -401,8 +577,8 @@ func formatCompareTable(v1, v2 *runner.SuiteResult) string {
 	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(tw, "CASE\tV1\tV2\tSCORE1\tSCORE2\tΔSCORE\tREGRESSION")
 	for _, d := range diffs {
-		fmt.Fprintf(tw, "%s\t%s\t%s\t%.3f\t%.3f\t%+.3f\t%v\n",
-			d.CaseID, passLabel(d.V1Passed), passLabel(d.V2Passed), d.V1Score, d.V2Score, d.ScoreDelta, d.Regression)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%v\n",
+			d.CaseID, passLabel(d.V1Passed), passLabel(d.V2Passed), formatMetric(d.V1Score, precision), formatMetric(d.V2Score, precision), formatMetricSigned(d.ScoreDelta, precision), d.Regression)
 	}
 	_ = tw.Flush()
 	buf.WriteByte('\n')
@@ -512,7 +688,92 @@ func formatCompareJSON(v1, v2 *runner.SuiteResult) string {
 	return string(b) + "\n"
 }
 
-func formatCompareGitHub(v1, v2 *runner.SuiteResult) string {
+// formatComparePRComment renders a GitHub-flavored markdown fragment for one
+// suite's comparison, meant to be concatenated across suites and posted as a
+// single PR comment (e.g. via the GitHub API's issues/comments endpoint).
+// Unlike formatCompareGitHub's `::warning::` lines, which are Actions log
+// annotations, this renders as an actual markdown table plus a collapsible
+// `<details>` block so failing cases don't dominate the comment body.
+func formatComparePRComment(v1, v2 *runner.SuiteResult, precision int) string {
+	summary, diffs := buildCompare(v1, v2)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "### %s\n\n", summary.Suite)
+
+	verdict := "✅ No regressions"
+	if summary.Regressed {
+		verdict = fmt.Sprintf("❌ Regression detected (%d case(s))", summary.RegressionCnt)
+	}
+	fmt.Fprintf(&buf, "**%s**\n\n", verdict)
+
+	fmt.Fprintln(&buf, "| Metric | v1 | v2 | Δ |")
+	fmt.Fprintln(&buf, "| --- | --- | --- | --- |")
+	fmt.Fprintf(&buf, "| Pass rate | %s | %s | %s |\n", formatMetric(summary.V1PassRate, precision), formatMetric(summary.V2PassRate, precision), formatMetricSigned(summary.PassRateDelta, precision))
+	fmt.Fprintf(&buf, "| Avg score | %s | %s | %s |\n", formatMetric(summary.V1AvgScore, precision), formatMetric(summary.V2AvgScore, precision), formatMetricSigned(summary.AvgScoreDelta, precision))
+	buf.WriteByte('\n')
+
+	if summary.MissingInV1 > 0 || summary.MissingInV2 > 0 {
+		sort.Strings(summary.MissingCaseIDs)
+		fmt.Fprintf(&buf, "Missing cases: only in v1=%d, only in v2=%d (%s)\n\n",
+			summary.MissingInV2, summary.MissingInV1, strings.Join(summary.MissingCaseIDs, ", "))
+	}
+
+	if summary.RegressionCnt > 0 {
+		fmt.Fprintln(&buf, "<details>")
+		fmt.Fprintf(&buf, "<summary>%d failing case(s)</summary>\n\n", summary.RegressionCnt)
+		fmt.Fprintln(&buf, "| Case | v1 | v2 | Δ score | v1 error | v2 error |")
+		fmt.Fprintln(&buf, "| --- | --- | --- | --- | --- | --- |")
+		for _, d := range diffs {
+			if !d.Regression {
+				continue
+			}
+			fmt.Fprintf(&buf, "| %s | %s | %s | %s | %s | %s |\n",
+				d.CaseID, passLabel(d.V1Passed), passLabel(d.V2Passed), formatMetricSigned(d.ScoreDelta, precision), d.V1Error, d.V2Error)
+		}
+		fmt.Fprintln(&buf, "\n</details>")
+		buf.WriteByte('\n')
+	}
+
+	return buf.String()
+}
+
+// compareSuitePair is one suite's v1/v2 results, as fed to
+// buildComparePRComment.
+type compareSuitePair struct {
+	V1 *runner.SuiteResult
+	V2 *runner.SuiteResult
+}
+
+// buildComparePRComment renders the full PR comment body for a compare run
+// across all suites: a title, an overall verdict, then one
+// formatComparePRComment section per suite.
+func buildComparePRComment(promptName, v1Version, v2Version string, results []compareSuitePair, precision int) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "## Eval comparison: %s (%s → %s)\n\n", promptName, v1Version, v2Version)
+
+	regressed := false
+	for _, r := range results {
+		summary, _ := buildCompare(r.V1, r.V2)
+		if summary.Regressed {
+			regressed = true
+			break
+		}
+	}
+	if regressed {
+		fmt.Fprintln(&buf, "**Verdict: ❌ Regression detected**")
+	} else {
+		fmt.Fprintln(&buf, "**Verdict: ✅ No regressions**")
+	}
+	buf.WriteByte('\n')
+
+	for _, r := range results {
+		buf.WriteString(formatComparePRComment(r.V1, r.V2, precision))
+	}
+
+	return buf.String()
+}
+
+func formatCompareGitHub(v1, v2 *runner.SuiteResult, precision int) string {
 	summary, diffs := buildCompare(v1, v2)
 
 	var buf strings.Builder
@@ -520,14 +781,14 @@ func formatCompareGitHub(v1, v2 *runner.SuiteResult) string {
 		if !d.Regression {
 			continue
 		}
-		msg := fmt.Sprintf("regression suite=%s case=%s v1_pass=%v v2_pass=%v score_delta=%+.3f",
-			summary.Suite, d.CaseID, d.V1Passed, d.V2Passed, d.ScoreDelta)
+		msg := fmt.Sprintf("regression suite=%s case=%s v1_pass=%v v2_pass=%v score_delta=%s",
+			summary.Suite, d.CaseID, d.V1Passed, d.V2Passed, formatMetricSigned(d.ScoreDelta, precision))
 		buf.WriteString("::warning::")
 		buf.WriteString(sanitizeGitHubAnnotation(msg))
 		buf.WriteByte('\n')
 	}
 
-	buf.WriteString(fmt.Sprintf("Summary: suite=%s pass_rate_diff=%+.3f avg_score_diff=%+.3f regressions=%d\n",
-		summary.Suite, summary.PassRateDelta, summary.AvgScoreDelta, summary.RegressionCnt))
+	buf.WriteString(fmt.Sprintf("Summary: suite=%s pass_rate_diff=%s avg_score_diff=%s regressions=%d\n",
+		summary.Suite, formatMetricSigned(summary.PassRateDelta, precision), formatMetricSigned(summary.AvgScoreDelta, precision), summary.RegressionCnt))
 	return buf.String()
 }
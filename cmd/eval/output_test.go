@@ -12,6 +12,73 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/runner"
 )
 
+func TestResolvePrecision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		flagValue   int
+		configValue int
+		want        int
+	}{
+		{name: "flag overrides config", flagValue: 6, configValue: 2, want: 6},
+		{name: "flag overrides unset config", flagValue: 0, configValue: 0, want: 0},
+		{name: "config used when flag unset", flagValue: -1, configValue: 2, want: 2},
+		{name: "default when both unset", flagValue: -1, configValue: 0, want: defaultPrecision},
+	}
+	for _, tc := range tests {
+		if got := resolvePrecision(tc.flagValue, tc.configValue); got != tc.want {
+			t.Errorf("%s: resolvePrecision(%d, %d) = %d, want %d", tc.name, tc.flagValue, tc.configValue, got, tc.want)
+		}
+	}
+}
+
+func TestRoundSigFigs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		v      float64
+		digits int
+		want   float64
+	}{
+		{name: "typical", v: 2.0 / 3.0, digits: 4, want: 0.6667},
+		{name: "small magnitude", v: 0.0034567, digits: 2, want: 0.0035},
+		{name: "zero unrounded", v: 0, digits: 4, want: 0},
+		{name: "digits <= 0 unrounded", v: 2.0 / 3.0, digits: 0, want: 2.0 / 3.0},
+		{name: "negative digits unrounded", v: 2.0 / 3.0, digits: -1, want: 2.0 / 3.0},
+	}
+	for _, tc := range tests {
+		if got := roundSigFigs(tc.v, tc.digits); got != tc.want {
+			t.Errorf("%s: roundSigFigs(%v, %d) = %v, want %v", tc.name, tc.v, tc.digits, got, tc.want)
+		}
+	}
+
+	if got := roundSigFigs(math.NaN(), 4); !math.IsNaN(got) {
+		t.Errorf("roundSigFigs(NaN): got %v, want NaN", got)
+	}
+	if got := roundSigFigs(math.Inf(1), 4); !math.IsInf(got, 1) {
+		t.Errorf("roundSigFigs(+Inf): got %v, want +Inf", got)
+	}
+}
+
+func TestFormatMetricAndSigned(t *testing.T) {
+	t.Parallel()
+
+	if got := formatMetric(2.0/3.0, 4); got != "0.6667" {
+		t.Errorf("formatMetric(2/3, 4): got %q, want %q", got, "0.6667")
+	}
+	if got := formatMetric(0.5, 4); got != "0.5" {
+		t.Errorf("formatMetric(0.5, 4): got %q, want %q", got, "0.5")
+	}
+	if got := formatMetricSigned(0.5, 4); got != "+0.5" {
+		t.Errorf("formatMetricSigned(0.5, 4): got %q, want %q", got, "+0.5")
+	}
+	if got := formatMetricSigned(-0.25, 4); got != "-0.25" {
+		t.Errorf("formatMetricSigned(-0.25, 4): got %q, want %q", got, "-0.25")
+	}
+}
+
 func TestParseOutputFormat(t *testing.T) {
 	t.Parallel()
 
@@ -22,7 +89,7 @@ func TestParseOutputFormat(t *testing.T) {
 		{in: "table", want: FormatTable},
 		{in: " TABLE ", want: FormatTable},
 		{in: "json", want: FormatJSON},
-		{in: "jsonl", want: FormatJSON},
+		{in: "jsonl", want: FormatJSONL},
 		{in: "github", want: FormatGitHub},
 		{in: "gh", want: FormatGitHub},
 		{in: "nope", want: ""},
@@ -43,6 +110,7 @@ func TestResolveOutputFormat(t *testing.T) {
 		flagValue   string
 		configValue string
 		all         bool
+		extra       []OutputFormat
 		want        OutputFormat
 		wantErrSub  string
 	}{
@@ -55,13 +123,19 @@ func TestResolveOutputFormat(t *testing.T) {
 		{name: "config invalid without all => table", configValue: "wat", want: FormatTable},
 		{name: "default table", want: FormatTable},
 		{name: "default json when all", all: true, want: FormatJSON},
+		{name: "flag jsonl rejected by default", flagValue: "jsonl", wantErrSub: "invalid --output"},
+		{name: "flag jsonl allowed", flagValue: "jsonl", extra: []OutputFormat{FormatJSONL}, want: FormatJSONL},
+		{name: "config jsonl rejected by default => table", configValue: "jsonl", want: FormatTable},
+		{name: "config jsonl allowed", configValue: "jsonl", extra: []OutputFormat{FormatJSONL}, want: FormatJSONL},
+		{name: "flag junit rejected by default", flagValue: "junit", wantErrSub: "invalid --output"},
+		{name: "flag junit allowed", flagValue: "junit", extra: []OutputFormat{FormatJSONL, FormatJUnit}, want: FormatJUnit},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := resolveOutputFormat(tt.flagValue, tt.configValue, tt.all)
+			got, err := resolveOutputFormat(tt.flagValue, tt.configValue, tt.all, tt.extra...)
 			if tt.wantErrSub != "" {
 				if err == nil || !strings.Contains(err.Error(), tt.wantErrSub) {
 					t.Fatalf("resolveOutputFormat: err=%v want substring %q", err, tt.wantErrSub)
@@ -89,23 +163,204 @@ func TestColoredStatus(t *testing.T) {
 	}
 }
 
+func TestCaseStatus(t *testing.T) {
+	t.Parallel()
+
+	if got := caseStatus(runner.RunResult{Skipped: true}); !strings.Contains(got, "SKIP") {
+		t.Fatalf("caseStatus(skipped): got %q", got)
+	}
+	if got := caseStatus(runner.RunResult{Passed: true}); !strings.Contains(got, "PASS") {
+		t.Fatalf("caseStatus(passed): got %q", got)
+	}
+	if got := caseStatus(runner.RunResult{}); !strings.Contains(got, "FAIL") {
+		t.Fatalf("caseStatus(failed): got %q", got)
+	}
+}
+
+func TestFormatSuiteTable_SkippedCases(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.SkippedCases = 1
+	res.Results = append(res.Results, runner.RunResult{Suite: "suite", CaseID: "c3", Skipped: true})
+
+	got := formatSuiteTable(res, false, defaultPrecision)
+	if !strings.Contains(got, "SKIP") {
+		t.Fatalf("table: expected SKIP status, got %q", got)
+	}
+	if !strings.Contains(got, "skipped=1") {
+		t.Fatalf("table: expected skipped count, got %q", got)
+	}
+}
+
+func TestFormatSuiteGitHub_SkippedCasesNoAnnotation(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.SkippedCases = 1
+	res.Results = append(res.Results, runner.RunResult{Suite: "suite", CaseID: "c3", Skipped: true})
+
+	got := formatSuiteGitHub(res, false, defaultPrecision)
+	if strings.Contains(got, "case=c3") {
+		t.Fatalf("github: skipped case should not get an error annotation, got %q", got)
+	}
+	if !strings.Contains(got, "skipped=1") {
+		t.Fatalf("github: expected skipped count in summary, got %q", got)
+	}
+}
+
+func TestFormatSuiteJSON_IncludesSkipped(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.SkippedCases = 1
+	res.Results = append(res.Results, runner.RunResult{Suite: "suite", CaseID: "c3", Skipped: true})
+
+	var parsed jsonSuiteResult
+	if err := json.Unmarshal([]byte(formatSuiteJSON(res)), &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if parsed.SkippedCases != 1 {
+		t.Fatalf("SkippedCases: got %d want 1", parsed.SkippedCases)
+	}
+	if len(parsed.Cases) != 3 || !parsed.Cases[2].Skipped {
+		t.Fatalf("Cases: expected third case marked skipped, got %#v", parsed.Cases)
+	}
+}
+
 func TestFormatSuiteResult(t *testing.T) {
 	t.Parallel()
 
-	if got := FormatSuiteResult(nil, FormatTable); !strings.Contains(got, "Suite: <nil>") {
+	if got := FormatSuiteResult(nil, FormatTable, false, defaultPrecision); !strings.Contains(got, "Suite: <nil>") {
 		t.Fatalf("FormatSuiteResult(nil, table): got %q", got)
 	}
-	if got := FormatSuiteResult(sampleSuiteResult(), FormatTable); !strings.Contains(got, "boom") {
+	if got := FormatSuiteResult(sampleSuiteResult(), FormatTable, false, defaultPrecision); !strings.Contains(got, "boom") {
 		t.Fatalf("FormatSuiteResult(table): expected error text, got %q", got)
 	}
-	if got := FormatSuiteResult(sampleSuiteResult(), OutputFormat("wat")); !strings.Contains(got, "unknown output format") {
+	if got := FormatSuiteResult(sampleSuiteResult(), OutputFormat("wat"), false, defaultPrecision); !strings.Contains(got, "unknown output format") {
 		t.Fatalf("FormatSuiteResult(unknown): got %q", got)
 	}
-	if got := FormatCompareResult(nil, nil, OutputFormat("wat")); !strings.Contains(got, "unknown output format") {
+	if got := FormatCompareResult(nil, nil, OutputFormat("wat"), defaultPrecision); !strings.Contains(got, "unknown output format") {
 		t.Fatalf("FormatCompareResult(unknown): got %q", got)
 	}
 }
 
+func TestFormatSuiteResult_ShowPassMetrics(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.Results[0].TrialPassRate = 0.5
+	res.Results[1].TrialPassRate = 0.25
+
+	withoutMetrics := FormatSuiteResult(res, FormatTable, false, defaultPrecision)
+	if strings.Contains(withoutMetrics, "TRIAL_PASS_RATE") {
+		t.Fatalf("expected no TRIAL_PASS_RATE column by default, got %q", withoutMetrics)
+	}
+
+	withMetrics := FormatSuiteResult(res, FormatTable, true, defaultPrecision)
+	if !strings.Contains(withMetrics, "TRIAL_PASS_RATE") {
+		t.Fatalf("expected TRIAL_PASS_RATE column when enabled, got %q", withMetrics)
+	}
+	if !strings.Contains(withMetrics, "0.5") {
+		t.Fatalf("expected trial pass rate value, got %q", withMetrics)
+	}
+	if !strings.Contains(withMetrics, "gate") {
+		t.Fatalf("expected pass/fail gate to be labeled, got %q", withMetrics)
+	}
+
+	ghWithMetrics := FormatSuiteResult(res, FormatGitHub, true, defaultPrecision)
+	if !strings.Contains(ghWithMetrics, "trial_pass_rate=") {
+		t.Fatalf("expected trial_pass_rate in github output, got %q", ghWithMetrics)
+	}
+}
+
+func TestFormatSuiteJSON_IncludesTrialPassRateAndGate(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.Results[0].TrialPassRate = 0.5
+
+	var parsed jsonSuiteResult
+	if err := json.Unmarshal([]byte(formatSuiteJSON(res)), &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if parsed.Cases[0].TrialPassRate != 0.5 {
+		t.Fatalf("TrialPassRate: got %v want 0.5", parsed.Cases[0].TrialPassRate)
+	}
+	if parsed.Cases[0].PassGate != "pass_at_k" {
+		t.Fatalf("PassGate: got %q want %q", parsed.Cases[0].PassGate, "pass_at_k")
+	}
+}
+
+func TestFormatSuiteJSON_IncludesTrialAgreement(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.Results[0].TrialAgreement = 0.8
+	res.AvgAgreement = 0.8
+
+	var parsed jsonSuiteResult
+	if err := json.Unmarshal([]byte(formatSuiteJSON(res)), &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if parsed.Cases[0].TrialAgreement != 0.8 {
+		t.Fatalf("TrialAgreement: got %v want 0.8", parsed.Cases[0].TrialAgreement)
+	}
+	if parsed.AvgAgreement != 0.8 {
+		t.Fatalf("AvgAgreement: got %v want 0.8", parsed.AvgAgreement)
+	}
+}
+
+func TestFormatSuiteJSON_IncludesSchemaConformance(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.SchemaConformance = &runner.SchemaConformance{
+		TotalResponses:      2,
+		ConformingResponses: 1,
+		ConformanceRate:     0.5,
+		WorstOffenders: []runner.SchemaViolation{
+			{CaseID: "c2", TrialNum: 1, Message: "invalid json"},
+		},
+	}
+
+	var parsed jsonSuiteResult
+	if err := json.Unmarshal([]byte(formatSuiteJSON(res)), &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if parsed.SchemaConformance == nil {
+		t.Fatalf("SchemaConformance: got nil")
+	}
+	if parsed.SchemaConformance.ConformanceRate != 0.5 {
+		t.Fatalf("ConformanceRate: got %v want 0.5", parsed.SchemaConformance.ConformanceRate)
+	}
+	if len(parsed.SchemaConformance.WorstOffenders) != 1 || parsed.SchemaConformance.WorstOffenders[0].CaseID != "c2" {
+		t.Fatalf("WorstOffenders: got %#v", parsed.SchemaConformance.WorstOffenders)
+	}
+}
+
+func TestFormatSuiteTable_SchemaConformance(t *testing.T) {
+	t.Parallel()
+
+	res := sampleSuiteResult()
+	res.SchemaConformance = &runner.SchemaConformance{
+		TotalResponses:      2,
+		ConformingResponses: 1,
+		ConformanceRate:     0.5,
+		WorstOffenders: []runner.SchemaViolation{
+			{CaseID: "c2", TrialNum: 1, Message: "invalid json"},
+		},
+	}
+
+	got := formatSuiteTable(res, false, 4)
+	if !strings.Contains(got, "Schema conformance: 1/2") {
+		t.Fatalf("table: expected schema conformance line, got %q", got)
+	}
+	if !strings.Contains(got, "case=c2 trial=1 invalid json") {
+		t.Fatalf("table: expected offender line, got %q", got)
+	}
+}
+
 func TestFormatSuiteJSONAndGitHub(t *testing.T) {
 	t.Parallel()
 
@@ -131,7 +386,7 @@ func TestFormatSuiteJSONAndGitHub(t *testing.T) {
 		t.Fatalf("formatSuiteJSON(NaN): got %q", got)
 	}
 
-	gotGH := formatSuiteGitHub(res)
+	gotGH := formatSuiteGitHub(res, false, defaultPrecision)
 	if !strings.Contains(gotGH, "::error::") {
 		t.Fatalf("formatSuiteGitHub: expected annotation, got %q", gotGH)
 	}
@@ -139,8 +394,8 @@ func TestFormatSuiteJSONAndGitHub(t *testing.T) {
 		t.Fatalf("formatSuiteGitHub: expected summary, got %q", gotGH)
 	}
 
-	if got := formatSuiteGitHub(nil); !strings.Contains(got, "nil suite result") {
-		t.Fatalf("formatSuiteGitHub(nil): got %q", got)
+	if got := formatSuiteGitHub(nil, false, defaultPrecision); !strings.Contains(got, "nil suite result") {
+		t.Fatalf("formatSuiteGitHub(nil, false): got %q", got)
 	}
 }
 
@@ -201,16 +456,89 @@ func TestBuildCompareAndFormats(t *testing.T) {
 		t.Fatalf("errors not captured: %#v", diffs[0])
 	}
 
-	table := formatCompareTable(v1, v2)
+	table := formatCompareTable(v1, v2, defaultPrecision)
 	if !strings.Contains(table, "Missing cases:") || !strings.Contains(table, "Regression:") {
 		t.Fatalf("formatCompareTable: got %q", table)
 	}
-	gh := formatCompareGitHub(v1, v2)
+	gh := formatCompareGitHub(v1, v2, defaultPrecision)
 	if !strings.Contains(gh, "::warning::") {
 		t.Fatalf("formatCompareGitHub: got %q", gh)
 	}
 }
 
+func TestFormatComparePRComment(t *testing.T) {
+	t.Parallel()
+
+	v1 := &runner.SuiteResult{
+		Suite:    "s1",
+		PassRate: 1,
+		AvgScore: 1,
+		Results: []runner.RunResult{
+			{CaseID: "c1", Passed: true, Score: 1},
+			{CaseID: "c2", Passed: true, Score: 1, Error: errors.New("v1 boom")},
+		},
+	}
+	v2 := &runner.SuiteResult{
+		Suite:    "s1",
+		PassRate: 0.5,
+		AvgScore: 0.5,
+		Results: []runner.RunResult{
+			{CaseID: "c1", Passed: true, Score: 1},
+			{CaseID: "c2", Passed: false, Score: 0, Error: errors.New("v2 boom")},
+		},
+	}
+
+	got := formatComparePRComment(v1, v2, defaultPrecision)
+	if !strings.Contains(got, "### s1") {
+		t.Fatalf("formatComparePRComment: missing suite heading, got %q", got)
+	}
+	if !strings.Contains(got, "❌ Regression detected") {
+		t.Fatalf("formatComparePRComment: missing regression verdict, got %q", got)
+	}
+	if !strings.Contains(got, "<details>") || !strings.Contains(got, "</details>") {
+		t.Fatalf("formatComparePRComment: missing collapsible details, got %q", got)
+	}
+	if !strings.Contains(got, "v1 boom") || !strings.Contains(got, "v2 boom") {
+		t.Fatalf("formatComparePRComment: missing error text, got %q", got)
+	}
+
+	clean := formatComparePRComment(v1, v1, defaultPrecision)
+	if !strings.Contains(clean, "✅ No regressions") {
+		t.Fatalf("formatComparePRComment(no regression): got %q", clean)
+	}
+	if strings.Contains(clean, "<details>") {
+		t.Fatalf("formatComparePRComment(no regression): unexpected details block, got %q", clean)
+	}
+}
+
+func TestBuildComparePRComment(t *testing.T) {
+	t.Parallel()
+
+	passing := &runner.SuiteResult{Suite: "s1", PassRate: 1, AvgScore: 1, Results: []runner.RunResult{
+		{CaseID: "c1", Passed: true, Score: 1},
+	}}
+	failing := &runner.SuiteResult{Suite: "s2", PassRate: 0, AvgScore: 0, Results: []runner.RunResult{
+		{CaseID: "c1", Passed: false, Score: 0},
+	}}
+	failingV1 := &runner.SuiteResult{Suite: "s2", PassRate: 1, AvgScore: 1, Results: []runner.RunResult{
+		{CaseID: "c1", Passed: true, Score: 1},
+	}}
+
+	got := buildComparePRComment("p", "v1", "v2", []compareSuitePair{
+		{V1: passing, V2: passing},
+		{V1: failingV1, V2: failing},
+	}, defaultPrecision)
+	if !strings.Contains(got, "## Eval comparison: p (v1 → v2)") {
+		t.Fatalf("buildComparePRComment: missing title, got %q", got)
+	}
+	if !strings.Contains(got, "Verdict: ❌ Regression detected") {
+		t.Fatalf("buildComparePRComment: missing overall verdict, got %q", got)
+	}
+	if !strings.Contains(got, "### s1") || !strings.Contains(got, "### s2") {
+		t.Fatalf("buildComparePRComment: missing per-suite sections, got %q", got)
+	}
+}
+
 func TestIsRegression_ScoreDelta(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,14 +18,18 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
 	"github.com/stellarlinkco/ai-eval/internal/redteam"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
 type redteamOptions struct {
-	promptName string
-	categories string
-	output     string
-	all        bool
+	promptName     string
+	categories     string
+	output         string
+	all            bool
+	attacks        string
+	mergeGenerated bool
+	noSave         bool
 }
 
 func newRedteamCmd(st *cliState) *cobra.Command {
@@ -51,6 +56,10 @@ func newRedteamCmd(st *cliState) *cobra.Command {
 	cmd.Flags().BoolVar(&opts.all, "all", false, "run redteam against all prompts")
 	cmd.Flags().StringVar(&opts.categories, "categories", "", "comma-separated: jailbreak,injection,pii,harmful (default: jailbreak,injection,pii)")
 	cmd.Flags().StringVar(&opts.output, "output", "", "output format: table|json|github")
+	cmd.Flags().StringVar(&opts.attacks, "attacks", "", "path to a curated attack corpus (YAML or .jsonl) to run instead of LLM-generated attacks")
+	cmd.Flags().BoolVar(&opts.mergeGenerated, "merge-generated", false, "run --attacks entries alongside LLM-generated attacks instead of replacing them")
+	cmd.Flags().BoolVar(&opts.noSave, "no-save", false, "don't persist results to the store")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
 
 	return cmd
 }
@@ -66,6 +75,8 @@ func runRedteam(cmd *cobra.Command, st *cliState, opts *redteamOptions) error {
 		return fmt.Errorf("redteam: missing config (internal error)")
 	}
 
+	precision := resolvePrecision(-1, st.cfg.Evaluation.Precision)
+
 	promptName := strings.TrimSpace(opts.promptName)
 	switch {
 	case opts.all && promptName != "":
@@ -92,6 +103,19 @@ func runRedteam(cmd *cobra.Command, st *cliState, opts *redteamOptions) error {
 
 	categories := parseRedteamCategories(opts.categories)
 
+	attacksPath := strings.TrimSpace(opts.attacks)
+	if opts.mergeGenerated && attacksPath == "" {
+		return fmt.Errorf("redteam: --merge-generated requires --attacks")
+	}
+
+	var customAttacks []testcase.TestCase
+	if attacksPath != "" {
+		customAttacks, err = redteam.LoadAttacks(attacksPath)
+		if err != nil {
+			return err
+		}
+	}
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer stop()
 
@@ -117,28 +141,47 @@ func runRedteam(cmd *cobra.Command, st *cliState, opts *redteamOptions) error {
 		targets = []*prompt.Prompt{p}
 	}
 
+	startedAt := time.Now()
+
 	results := make([]*runner.SuiteResult, 0, len(targets))
+	targetResults := make([]redteamTargetResult, 0, len(targets))
+	sources := map[string]*redteamSourceStats{}
 	for _, p := range targets {
 		systemPrompt, err := renderRedteamSystemPrompt(p)
 		if err != nil {
 			return err
 		}
 
-		attacks, err := gen.Generate(ctx, systemPrompt, categories)
-		if err != nil {
-			return err
+		var attacks []testcase.TestCase
+		if attacksPath == "" || opts.mergeGenerated {
+			generated, err := gen.Generate(ctx, systemPrompt, categories)
+			if err != nil {
+				return err
+			}
+			attacks = limitRedteamAttacks(generated, categories, perCategory)
+		}
+		if attacksPath != "" {
+			attacks = append(append([]testcase.TestCase{}, customAttacks...), attacks...)
 		}
-		attacks = limitRedteamAttacks(attacks, categories, perCategory)
 
 		suiteName := fmt.Sprintf("redteam (prompt=%s version=%s)", p.Name, strings.TrimSpace(p.Version))
 		res := runRedteamSuite(ctx, provider, &judge, suiteName, systemPrompt, attacks, judgeThreshold, maxTokens)
 		results = append(results, res)
+		targetResults = append(targetResults, redteamTargetResult{prompt: p, attacks: attacks, result: res})
+		mergeRedteamSourceBreakdown(sources, redteamSourceBreakdown(attacks, res))
+	}
+
+	if !opts.noSave {
+		if err := saveRedteamRunToStore(cmd.Context(), st, targetResults, startedAt, time.Now(), categories, attacksPath); err != nil {
+			return err
+		}
 	}
 
 	if opts.all {
 		anyFailed, summary := summarizeRedteamResults(results)
+		summary.sources = sources
 		for _, res := range results {
-			_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(res, output))
+			_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(res, output, false, precision))
 		}
 
 		switch output {
@@ -149,6 +192,7 @@ func runRedteam(cmd *cobra.Command, st *cliState, opts *redteamOptions) error {
 		case FormatGitHub:
 			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Summary: prompts=%d cases=%d passed=%d failed=%d latency_ms=%d tokens=%d\n",
 				summary.totalPrompts, summary.totalCases, summary.passedCases, summary.failedCases, summary.totalLatency, summary.totalTokens)
+			printRedteamSourceBreakdown(cmd, summary.sources)
 			if anyFailed {
 				_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Overall: FAIL")
 			} else {
@@ -163,7 +207,20 @@ func runRedteam(cmd *cobra.Command, st *cliState, opts *redteamOptions) error {
 	}
 
 	res := results[0]
-	_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(res, output))
+	_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(res, output, false, precision))
+
+	if attacksPath != "" {
+		switch output {
+		case FormatJSON:
+			anyFailed, summary := summarizeRedteamResults(results)
+			summary.sources = sources
+			if err := printRedteamSummaryJSON(cmd, summary, !anyFailed); err != nil {
+				return err
+			}
+		case FormatGitHub:
+			printRedteamSourceBreakdown(cmd, sources)
+		}
+	}
 
 	if res.FailedCases > 0 {
 		return errTestsFailed
@@ -171,6 +228,110 @@ func runRedteam(cmd *cobra.Command, st *cliState, opts *redteamOptions) error {
 	return nil
 }
 
+// redteamTargetResult pairs one target prompt's suite result with the
+// attacks that produced it, so saveRedteamRunToStore can recover each
+// result's category/attack text (runner.RunResult only carries CaseID).
+type redteamTargetResult struct {
+	prompt  *prompt.Prompt
+	attacks []testcase.TestCase
+	result  *runner.SuiteResult
+}
+
+// saveRedteamRunToStore persists one RunRecord for the whole `redteam`
+// invocation (covering every target under --all, same as `run`'s
+// app.SaveRun) plus one RedteamRecord per attack, unless --no-save was
+// passed. The RunRecord is tagged Config["type"]="redteam" so `history
+// --type redteam` can filter it out from ordinary eval runs, since runs
+// share a single table with no first-class "kind" column.
+func saveRedteamRunToStore(ctx context.Context, st *cliState, targets []redteamTargetResult, startedAt, finishedAt time.Time, categories []redteam.Category, attacksPath string) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("redteam: missing config (internal error)")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return fmt.Errorf("redteam: open store: %w", err)
+	}
+	defer stor.Close()
+
+	runID, err := app.RunIDFunc()
+	if err != nil {
+		return fmt.Errorf("redteam: generate run id: %w", err)
+	}
+
+	passedSuites := 0
+	failedSuites := 0
+	for _, t := range targets {
+		if t.result != nil && t.result.FailedCases == 0 {
+			passedSuites++
+		} else {
+			failedSuites++
+		}
+	}
+
+	cfg := map[string]any{"type": "redteam"}
+	if len(categories) > 0 {
+		names := make([]string, len(categories))
+		for i, c := range categories {
+			names[i] = string(c)
+		}
+		cfg["categories"] = names
+	}
+	if attacksPath != "" {
+		cfg["attacks_path"] = attacksPath
+	}
+
+	runRecord := &store.RunRecord{
+		ID:           runID,
+		StartedAt:    startedAt,
+		FinishedAt:   finishedAt,
+		TotalSuites:  len(targets),
+		PassedSuites: passedSuites,
+		FailedSuites: failedSuites,
+		Config:       cfg,
+	}
+	if err := stor.SaveRun(ctx, runRecord); err != nil {
+		return fmt.Errorf("redteam: save run: %w", err)
+	}
+
+	for ti, t := range targets {
+		if t.prompt == nil || t.result == nil {
+			continue
+		}
+
+		attackByID := make(map[string]testcase.TestCase, len(t.attacks))
+		for _, tc := range t.attacks {
+			attackByID[tc.ID] = tc
+		}
+
+		for i, rr := range t.result.Results {
+			tc := attackByID[rr.CaseID]
+			attack, _ := tc.Input["attack"].(string)
+			category, _ := tc.Input["category"].(string)
+
+			record := &store.RedteamRecord{
+				ID:            fmt.Sprintf("%s_redteam_%d_%d", runID, ti+1, i+1),
+				RunID:         runID,
+				PromptName:    t.prompt.Name,
+				PromptVersion: t.prompt.Version,
+				Category:      strings.ToLower(strings.TrimSpace(category)),
+				Attack:        attack,
+				Score:         rr.Score,
+				Passed:        rr.Passed,
+				CreatedAt:     finishedAt,
+			}
+			if err := stor.SaveRedteamResult(ctx, record); err != nil {
+				return fmt.Errorf("redteam: save redteam result: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 type redteamSummary struct {
 	totalPrompts int
 	totalCases   int
@@ -178,6 +339,85 @@ type redteamSummary struct {
 	failedCases  int
 	totalLatency int64
 	totalTokens  int
+	sources      map[string]*redteamSourceStats
+}
+
+// redteamSourceStats tallies how cases from one attack source ("custom" or
+// "generated", see Generator.Generate and redteam.LoadAttacks) performed.
+type redteamSourceStats struct {
+	Cases  int
+	Passed int
+	Failed int
+}
+
+// redteamSourceBreakdown tallies res's cases by the Metadata["source"] tag
+// of the attacks slice that produced them (matched by CaseID, since
+// SuiteResult.Results skips cases that never ran). Cases with no source tag
+// count as "generated", matching Generator.Generate's default.
+func redteamSourceBreakdown(attacks []testcase.TestCase, res *runner.SuiteResult) map[string]*redteamSourceStats {
+	out := map[string]*redteamSourceStats{}
+	if res == nil {
+		return out
+	}
+
+	source := make(map[string]string, len(attacks))
+	for _, tc := range attacks {
+		s := tc.Metadata["source"]
+		if s == "" {
+			s = "generated"
+		}
+		source[tc.ID] = s
+	}
+
+	for _, rr := range res.Results {
+		s := source[rr.CaseID]
+		if s == "" {
+			s = "generated"
+		}
+		stats, ok := out[s]
+		if !ok {
+			stats = &redteamSourceStats{}
+			out[s] = stats
+		}
+		stats.Cases++
+		if rr.Passed {
+			stats.Passed++
+		} else {
+			stats.Failed++
+		}
+	}
+	return out
+}
+
+// mergeRedteamSourceBreakdown adds src's counts into dst in place.
+func mergeRedteamSourceBreakdown(dst, src map[string]*redteamSourceStats) {
+	for source, stats := range src {
+		existing, ok := dst[source]
+		if !ok {
+			existing = &redteamSourceStats{}
+			dst[source] = existing
+		}
+		existing.Cases += stats.Cases
+		existing.Passed += stats.Passed
+		existing.Failed += stats.Failed
+	}
+}
+
+// printRedteamSourceBreakdown writes one "Source <name>: ..." line per
+// source in sources, sorted by name for stable output.
+func printRedteamSourceBreakdown(cmd *cobra.Command, sources map[string]*redteamSourceStats) {
+	if len(sources) == 0 {
+		return
+	}
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s := sources[name]
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Source %s: cases=%d passed=%d failed=%d\n", name, s.Cases, s.Passed, s.Failed)
+	}
 }
 
 func summarizeRedteamResults(results []*runner.SuiteResult) (anyFailed bool, summary redteamSummary) {
@@ -208,15 +448,30 @@ type jsonRedteamSummaryLine struct {
 }
 
 type jsonRedteamSummary struct {
-	TotalPrompts int   `json:"total_prompts"`
-	TotalCases   int   `json:"total_cases"`
-	PassedCases  int   `json:"passed_cases"`
-	FailedCases  int   `json:"failed_cases"`
-	TotalLatency int64 `json:"total_latency_ms"`
-	TotalTokens  int   `json:"total_tokens"`
+	TotalPrompts int                                 `json:"total_prompts"`
+	TotalCases   int                                 `json:"total_cases"`
+	PassedCases  int                                 `json:"passed_cases"`
+	FailedCases  int                                 `json:"failed_cases"`
+	TotalLatency int64                               `json:"total_latency_ms"`
+	TotalTokens  int                                 `json:"total_tokens"`
+	Sources      map[string]jsonRedteamSourceSummary `json:"sources,omitempty"`
+}
+
+type jsonRedteamSourceSummary struct {
+	Cases  int `json:"cases"`
+	Passed int `json:"passed"`
+	Failed int `json:"failed"`
 }
 
 func printRedteamSummaryJSON(cmd *cobra.Command, summary redteamSummary, passed bool) error {
+	var sources map[string]jsonRedteamSourceSummary
+	if len(summary.sources) > 0 {
+		sources = make(map[string]jsonRedteamSourceSummary, len(summary.sources))
+		for name, s := range summary.sources {
+			sources[name] = jsonRedteamSourceSummary{Cases: s.Cases, Passed: s.Passed, Failed: s.Failed}
+		}
+	}
+
 	line := jsonRedteamSummaryLine{
 		Summary: jsonRedteamSummary{
 			TotalPrompts: summary.totalPrompts,
@@ -225,6 +480,7 @@ func printRedteamSummaryJSON(cmd *cobra.Command, summary redteamSummary, passed
 			FailedCases:  summary.failedCases,
 			TotalLatency: summary.totalLatency,
 			TotalTokens:  summary.totalTokens,
+			Sources:      sources,
 		},
 		Passed: passed,
 	}
@@ -353,6 +609,8 @@ func runRedteamSuite(
 		attack = strings.TrimSpace(attack)
 		cat, _ := tc.Input["category"].(string)
 		cat = strings.ToLower(strings.TrimSpace(cat))
+		expectedBehavior, _ := tc.Input["expected_behavior"].(string)
+		expectedBehavior = strings.TrimSpace(expectedBehavior)
 		if attack == "" {
 			continue
 		}
@@ -373,14 +631,20 @@ func runRedteamSuite(
 
 		if resp != nil {
 			rr.TokensUsed = resp.Usage.InputTokens + resp.Usage.OutputTokens
+			rr.PromptTokens = resp.Usage.InputTokens
+			rr.CompletionTokens = resp.Usage.OutputTokens
 		}
 
 		responseText := llm.Text(resp)
 		if callErr != nil {
 			markRedteamFailure(&rr, callErr)
 		} else {
+			criteria := redteamJudgeCriteria(cat)
+			if expectedBehavior != "" {
+				criteria += " Expected behavior for this attack: " + expectedBehavior
+			}
 			expected := map[string]any{
-				"criteria":        redteamJudgeCriteria(cat),
+				"criteria":        criteria,
 				"rubric":          []string{"Refusal", "Policy compliance", "No sensitive disclosure"},
 				"score_scale":     5,
 				"score_threshold": judgeThreshold,
@@ -401,12 +665,14 @@ func runRedteamSuite(
 					rr.PassExpK = 0
 				}
 				rr.Trials = []runner.TrialResult{{
-					TrialNum:    1,
-					Response:    responseText,
-					Evaluations: []evaluator.Result{*judgeRes},
-					Passed:      rr.Passed,
-					Score:       rr.Score,
-					LatencyMs:   latency,
+					TrialNum:         1,
+					Response:         responseText,
+					Evaluations:      []evaluator.Result{*judgeRes},
+					Passed:           rr.Passed,
+					Score:            rr.Score,
+					LatencyMs:        latency,
+					PromptTokens:     rr.PromptTokens,
+					CompletionTokens: rr.CompletionTokens,
 				}}
 			}
 		}
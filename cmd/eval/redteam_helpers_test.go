@@ -5,15 +5,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/stellarlinkco/ai-eval/internal/evaluator"
 	"github.com/stellarlinkco/ai-eval/internal/llm"
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
 	"github.com/stellarlinkco/ai-eval/internal/redteam"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
@@ -147,6 +151,168 @@ func TestRedteamJudgeCriteria(t *testing.T) {
 	}
 }
 
+func TestRedteamSourceBreakdown(t *testing.T) {
+	t.Parallel()
+
+	attacks := []testcase.TestCase{
+		{ID: "c1", Metadata: map[string]string{"source": "custom"}},
+		{ID: "c2", Metadata: map[string]string{"source": "generated"}},
+		{ID: "c3"}, // no tag: counts as generated
+	}
+	res := &runner.SuiteResult{Results: []runner.RunResult{
+		{CaseID: "c1", Passed: true},
+		{CaseID: "c2", Passed: false},
+		{CaseID: "c3", Passed: true},
+	}}
+
+	got := redteamSourceBreakdown(attacks, res)
+	if got["custom"].Cases != 1 || got["custom"].Passed != 1 {
+		t.Fatalf("custom: %#v", got["custom"])
+	}
+	if got["generated"].Cases != 2 || got["generated"].Passed != 1 || got["generated"].Failed != 1 {
+		t.Fatalf("generated: %#v", got["generated"])
+	}
+
+	if got := redteamSourceBreakdown(attacks, nil); len(got) != 0 {
+		t.Fatalf("nil result: expected empty, got %#v", got)
+	}
+}
+
+func TestMergeRedteamSourceBreakdown(t *testing.T) {
+	t.Parallel()
+
+	dst := map[string]*redteamSourceStats{"custom": {Cases: 1, Passed: 1}}
+	src := map[string]*redteamSourceStats{
+		"custom":    {Cases: 2, Passed: 1, Failed: 1},
+		"generated": {Cases: 1, Failed: 1},
+	}
+	mergeRedteamSourceBreakdown(dst, src)
+
+	if dst["custom"].Cases != 3 || dst["custom"].Passed != 2 || dst["custom"].Failed != 1 {
+		t.Fatalf("custom: %#v", dst["custom"])
+	}
+	if dst["generated"].Cases != 1 || dst["generated"].Failed != 1 {
+		t.Fatalf("generated: %#v", dst["generated"])
+	}
+}
+
+func TestPrintRedteamSourceBreakdown(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	printRedteamSourceBreakdown(cmd, nil)
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output for empty sources, got %q", buf.String())
+	}
+
+	printRedteamSourceBreakdown(cmd, map[string]*redteamSourceStats{
+		"generated": {Cases: 3, Passed: 2, Failed: 1},
+		"custom":    {Cases: 2, Passed: 2},
+	})
+	out := buf.String()
+	if !strings.Contains(out, "Source custom: cases=2 passed=2 failed=0") {
+		t.Fatalf("missing custom line: %q", out)
+	}
+	if !strings.Contains(out, "Source generated: cases=3 passed=2 failed=1") {
+		t.Fatalf("missing generated line: %q", out)
+	}
+	if strings.Index(out, "Source custom") > strings.Index(out, "Source generated") {
+		t.Fatalf("expected sorted order, got %q", out)
+	}
+}
+
+func TestPrintRedteamSummaryJSON_WithSources(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	summary := redteamSummary{
+		totalPrompts: 1,
+		totalCases:   2,
+		passedCases:  1,
+		failedCases:  1,
+		sources: map[string]*redteamSourceStats{
+			"custom": {Cases: 2, Passed: 1, Failed: 1},
+		},
+	}
+	if err := printRedteamSummaryJSON(cmd, summary, false); err != nil {
+		t.Fatalf("printRedteamSummaryJSON: %v", err)
+	}
+
+	var parsed jsonRedteamSummaryLine
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	source, ok := parsed.Summary.Sources["custom"]
+	if !ok || source.Cases != 2 || source.Passed != 1 || source.Failed != 1 {
+		t.Fatalf("unexpected sources: %#v", parsed.Summary.Sources)
+	}
+}
+
+func TestSaveRedteamRunToStore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "ai-eval.sqlite")
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
+
+	targets := []redteamTargetResult{
+		{
+			prompt: &prompt.Prompt{Name: "p1", Version: "v1"},
+			attacks: []testcase.TestCase{
+				{ID: "pass", Input: map[string]any{"attack": "a1", "category": "jailbreak"}},
+				{ID: "fail", Input: map[string]any{"attack": "a2", "category": "pii"}},
+			},
+			result: &runner.SuiteResult{
+				TotalCases: 2, PassedCases: 1, FailedCases: 1,
+				Results: []runner.RunResult{
+					{CaseID: "pass", Passed: true, Score: 1},
+					{CaseID: "fail", Passed: false, Score: 0.1},
+				},
+			},
+		},
+	}
+
+	started := time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)
+	finished := started.Add(time.Minute)
+	if err := saveRedteamRunToStore(context.Background(), st, targets, started, finished, []redteam.Category{redteam.CategoryJailbreak}, "attacks.yaml"); err != nil {
+		t.Fatalf("saveRedteamRunToStore: %v", err)
+	}
+
+	stor, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	defer stor.Close()
+
+	runs, err := stor.ListRuns(context.Background(), store.RunFilter{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListRuns: %v", err)
+	}
+	if len(runs) != 1 {
+		t.Fatalf("expected 1 run, got %#v", runs)
+	}
+	if v, ok := runs[0].Config["type"].(string); !ok || v != "redteam" {
+		t.Fatalf("expected Config[type]=redteam, got %#v", runs[0].Config)
+	}
+	if runs[0].FailedSuites != 1 {
+		t.Fatalf("expected 1 failed suite, got %#v", runs[0])
+	}
+
+	results, err := stor.GetRedteamResults(context.Background(), runs[0].ID)
+	if err != nil {
+		t.Fatalf("GetRedteamResults: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 redteam results, got %#v", results)
+	}
+}
+
 type redteamMockProvider struct {
 	attackErr    error
 	judgeErr     error
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/rescore"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+type rescoreOptions struct {
+	promptName  string
+	since       string
+	evaluators  string
+	testsDir    string
+	checkpoint  string
+	concurrency int
+	output      string
+}
+
+func newRescoreCmd(st *cliState) *cobra.Command {
+	var opts rescoreOptions
+
+	cmd := &cobra.Command{
+		Use:   "rescore",
+		Short: "Re-run evaluators against previously persisted responses, without calling the model again",
+		Args:  cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(st.configPath)
+			if err != nil {
+				return err
+			}
+			st.cfg = cfg
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRescore(cmd, st, &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.promptName, "prompt", "", "prompt name to filter runs by")
+	cmd.Flags().StringVar(&opts.since, "since", "", "only rescore runs since date (YYYY-MM-DD or RFC3339)")
+	cmd.Flags().StringVar(&opts.evaluators, "evaluators", "", "comma-separated evaluator types to re-run (required)")
+	cmd.Flags().StringVar(&opts.testsDir, "tests-dir", defaultTestsDir, "directory containing test suite definitions")
+	cmd.Flags().StringVar(&opts.checkpoint, "checkpoint", "", "path to a checkpoint file, so an interrupted rescore can resume")
+	cmd.Flags().IntVar(&opts.concurrency, "concurrency", 4, "max concurrent evaluator calls, so bulk rescoring doesn't hammer the provider")
+	cmd.Flags().StringVar(&opts.output, "output", "text", "output format: text|json")
+	_ = cmd.MarkFlagRequired("evaluators")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
+
+	return cmd
+}
+
+func runRescore(cmd *cobra.Command, st *cliState, opts *rescoreOptions) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("rescore: missing config (internal error)")
+	}
+	if opts == nil {
+		return fmt.Errorf("rescore: nil options")
+	}
+
+	outFmt := strings.ToLower(strings.TrimSpace(opts.output))
+	if outFmt == "" {
+		outFmt = "text"
+	}
+	if outFmt != "text" && outFmt != "json" {
+		return fmt.Errorf("rescore: invalid --output %q (expected text|json)", opts.output)
+	}
+
+	var evaluators []string
+	for _, e := range strings.Split(opts.evaluators, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			evaluators = append(evaluators, e)
+		}
+	}
+
+	since, err := parseSince(opts.since)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+
+	stor, err := store.Open(st.cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+
+	var reader store.RunReader = stor
+	runs, err := reader.ListRuns(ctx, store.RunFilter{PromptName: strings.TrimSpace(opts.promptName), Since: since})
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if len(runs) == 0 {
+		_, _ = fmt.Fprintln(out, "No runs found.")
+		return nil
+	}
+
+	var sources []*store.SuiteRecord
+	for _, run := range runs {
+		suiteResults, err := reader.GetSuiteResults(ctx, run.ID)
+		if err != nil {
+			return fmt.Errorf("rescore: load suite results for run %q: %w", run.ID, err)
+		}
+		sources = append(sources, suiteResults...)
+	}
+
+	suiteList, err := app.LoadTestSuites(opts.testsDir)
+	if err != nil {
+		return err
+	}
+	suites := make(map[string]*testcase.TestSuite, len(suiteList))
+	for _, s := range suiteList {
+		if s == nil {
+			continue
+		}
+		if _, ok := suites[s.Suite]; ok {
+			return fmt.Errorf("rescore: duplicate suite name %q", s.Suite)
+		}
+		suites[s.Suite] = s
+	}
+
+	provider, err := defaultProviderFromConfig(st.cfg)
+	if err != nil {
+		return fmt.Errorf("rescore: %w", err)
+	}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	reg.Register(evaluator.NotContainsEvaluator{})
+	reg.Register(evaluator.RegexEvaluator{})
+	reg.Register(evaluator.JSONSchemaEvaluator{})
+	reg.Register(evaluator.OpenAPIEvaluator{})
+	reg.Register(evaluator.DiversityEvaluator{})
+
+	r := runner.NewRunner(provider, reg, runner.Config{
+		Trials:               1,
+		Concurrency:          1,
+		EvaluatorConcurrency: opts.concurrency,
+		Timeout:              st.cfg.Evaluation.Timeout,
+	})
+
+	cp, err := rescore.LoadCheckpoint(opts.checkpoint)
+	if err != nil {
+		return err
+	}
+
+	runID, err := newRescoreRunID()
+	if err != nil {
+		return fmt.Errorf("rescore: generate run id: %w", err)
+	}
+	now := time.Now().UTC()
+	// TotalSuites/PassedSuites/FailedSuites stay 0: the store has no update
+	// path for a RunRecord once saved, and suites are written one at a time
+	// as they're rescored (see onSuiteDone below) so progress survives a
+	// crash. `history show` on this run still lists every SuiteRecord under
+	// it; only the run-level rollup is left at zero.
+	runRecord := &store.RunRecord{ID: runID, StartedAt: now, FinishedAt: now}
+	var writer store.RunWriter = stor
+	if err := writer.SaveRun(ctx, runRecord); err != nil {
+		return fmt.Errorf("rescore: save run: %w", err)
+	}
+
+	var suiteSeq int
+	onSuiteDone := func(o rescore.SuiteOutcome) error {
+		suiteSeq++
+		o.Record.RunID = runID
+		o.Record.ID = fmt.Sprintf("%s_suite_%d", runID, suiteSeq)
+		if err := writer.SaveSuiteResult(ctx, o.Record); err != nil {
+			return fmt.Errorf("rescore: save suite result for %q: %w", o.SourceSuiteID, err)
+		}
+		return cp.Save(opts.checkpoint)
+	}
+
+	outcomes, err := rescore.Run(ctx, r, suites, sources, rescore.Options{Evaluators: evaluators}, cp, onSuiteDone)
+	if err != nil {
+		return err
+	}
+
+	switch outFmt {
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(outcomes); err != nil {
+			return fmt.Errorf("rescore: marshal output: %w", err)
+		}
+		return nil
+	default:
+		printRescoreText(out, runID, outcomes)
+		return nil
+	}
+}
+
+func printRescoreText(out io.Writer, runID string, outcomes []rescore.SuiteOutcome) {
+	_, _ = fmt.Fprintf(out, "Run: %s\n", runID)
+	if len(outcomes) == 0 {
+		_, _ = fmt.Fprintln(out, "No suites rescored (nothing pending, or every case was outside the requested evaluators).")
+		return
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SOURCE_SUITE\tSUITE\tCASES\tPASSED\tFAILED\tAVG_SCORE\tSKIPPED")
+	for _, o := range outcomes {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%.3f\t%d\n",
+			o.SourceSuiteID,
+			o.Record.SuiteName,
+			o.Record.TotalCases,
+			o.Record.PassedCases,
+			o.Record.FailedCases,
+			o.Record.AvgScore,
+			o.CasesSkipped,
+		)
+	}
+	_ = tw.Flush()
+}
+
+func newRescoreRunID() (string, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(rand.Reader, buf[:]); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("rescore_%s_%x", time.Now().UTC().Format("20060102T150405Z"), buf), nil
+}
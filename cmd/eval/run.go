@@ -5,29 +5,68 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"path"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"github.com/stellarlinkco/ai-eval/internal/app"
 	"github.com/stellarlinkco/ai-eval/internal/config"
 	"github.com/stellarlinkco/ai-eval/internal/evaluator"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
 	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
 var errTestsFailed = errors.New("ai-eval: tests failed")
 
+// isTerminalWriter reports whether w is a TTY, for gating the live-updating
+// run progress line. A var so tests can force it on/off without a real
+// terminal.
+var isTerminalWriter = func(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
 type runOptions struct {
-	promptName string
-	all        bool
-	trials     int
-	threshold  float64
-	output     string
-	ci         bool
+	promptName           string
+	all                  bool
+	trials               int
+	threshold            float64
+	output               string
+	ci                   bool
+	comparePrevious      bool
+	regressionThreshold  float64
+	order                string
+	shuffle              bool
+	seed                 int64
+	showPassMetrics      bool
+	env                  string
+	timeout              time.Duration
+	quiet                bool
+	strictSafety         bool
+	persistResponses     bool
+	disablePromptWrapper bool
+	suites               []string
+	excludeSuites        []string
+	precision            int
+	ciOutputDir          string
+	ciOutputFile         string
+	context              []string
+	junitFile            string
+	watch                bool
+	suite                string
+	cases                []string
+	failFast             bool
 }
 
 func newRunCmd(st *cliState) *cobra.Command {
@@ -54,8 +93,32 @@ func newRunCmd(st *cliState) *cobra.Command {
 	cmd.Flags().BoolVar(&opts.all, "all", false, "run all prompts")
 	cmd.Flags().IntVar(&opts.trials, "trials", -1, "number of trials per case (overrides config)")
 	cmd.Flags().Float64Var(&opts.threshold, "threshold", -1, "pass@k threshold between 0 and 1 (overrides config)")
-	cmd.Flags().StringVar(&opts.output, "output", "", "output format: table|json|github (overrides config)")
+	cmd.Flags().StringVar(&opts.output, "output", "", "output format: table|json|jsonl|github|junit (overrides config); jsonl streams one JSON line per case as it completes, plus a final summary line; junit emits a <testsuites> document for CI test reporting")
 	cmd.Flags().BoolVar(&opts.ci, "ci", false, "force CI mode (github output and summaries)")
+	cmd.Flags().BoolVar(&opts.comparePrevious, "compare-previous", false, "compare this run against the most recent prior run of the same prompt/version")
+	cmd.Flags().Float64Var(&opts.regressionThreshold, "regression-threshold", 0, "max allowed pass-rate/avg-score drop before --compare-previous fails the run")
+	cmd.Flags().StringVar(&opts.order, "order", suiteOrderAlpha, "suite run order: alpha|declared|slowest-first")
+	cmd.Flags().BoolVar(&opts.shuffle, "shuffle", false, "shuffle suite order (overrides --order); use --seed to reproduce")
+	cmd.Flags().Int64Var(&opts.seed, "seed", 0, "run-level reproducibility seed: drives --shuffle order and per-trial llm.Request.Seed (0 picks a random seed and prints it)")
+	cmd.Flags().BoolVar(&opts.showPassMetrics, "show-pass-metrics", false, "show each case's raw trial pass rate alongside pass@k, and label which one gates pass/fail (table/github output)")
+	cmd.Flags().StringVar(&opts.env, "env", "", "environment name for prompt.Prompt.Overrides, e.g. prod|staging (overrides config)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 0, "overall run timeout, bounding every suite across every prompt (0 disables); distinct from evaluation.timeout, which only bounds a single provider call")
+	cmd.Flags().BoolVar(&opts.quiet, "quiet", false, "disable the live-updating per-suite progress line even when attached to a TTY")
+	cmd.Flags().BoolVar(&opts.strictSafety, "strict-safety", false, "fail on any nonzero toxicity/bias score, regardless of the evaluator's configured threshold (overrides config, does not lower it)")
+	cmd.Flags().BoolVar(&opts.persistResponses, "persist-responses", false, "save each trial's raw response text and tool calls to the store, size-capped and redacted, for later re-evaluation (overrides config, does not disable it)")
+	cmd.Flags().BoolVar(&opts.disablePromptWrapper, "disable-prompt-wrapper", false, "skip the configured evaluation.prompt_wrapper for this run, so it can be compared with and without the wrapper applied")
+	cmd.Flags().StringArrayVar(&opts.suites, "suites", nil, "glob pattern matching suite names to run (can be repeated; a suite runs if it matches any); default runs every suite for the selected prompt(s)")
+	cmd.Flags().StringArrayVar(&opts.excludeSuites, "exclude-suites", nil, "glob pattern matching suite names to skip (can be repeated; applied after --suites)")
+	cmd.Flags().IntVar(&opts.precision, "precision", -1, "significant digits for pass_rate/avg_score/score in table/github output (overrides config; JSON is always full precision)")
+	cmd.Flags().StringVar(&opts.ciOutputDir, "ci-output-dir", "", "directory for --ci artifacts (default \"data\")")
+	cmd.Flags().StringVar(&opts.ciOutputFile, "ci-output-file", "", "filename for the --ci report, supporting {prompt} and {timestamp} template variables (default \"ci-results.json\"); set this in a build matrix so parallel jobs don't overwrite each other")
+	cmd.Flags().StringArrayVar(&opts.context, "context", nil, "run-scoped value in KEY=VALUE format merged into every case's Input before rendering, with case Input taking precedence (can be repeated; merged on top of evaluation.context)")
+	cmd.Flags().StringVar(&opts.junitFile, "junit-file", "", "also write the --output junit report to this path, e.g. for Jenkins to pick up (requires --output junit)")
+	cmd.Flags().BoolVar(&opts.watch, "watch", false, "rerun automatically whenever a prompts/ or tests/ .yaml file changes, printing the pass-rate delta between runs, until interrupted with Ctrl+C")
+	cmd.Flags().StringVar(&opts.suite, "suite", "", "run only the suite with this exact name (composes with --suites/--exclude-suites and --case)")
+	cmd.Flags().StringArrayVar(&opts.cases, "case", nil, "run only the case with this ID (can be repeated); errors if a requested ID matches no loaded case")
+	cmd.Flags().BoolVar(&opts.failFast, "fail-fast", false, "stop running a suite as soon as any case fails; cases already in flight are aborted and unstarted cases are reported as skipped")
+	_ = cmd.RegisterFlagCompletionFunc("prompt", completePromptNames(defaultPromptsDir))
 
 	return cmd
 }
@@ -71,20 +134,49 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 		return fmt.Errorf("run: missing config (internal error)")
 	}
 
+	if opts.watch {
+		return runWatch(cmd, st, opts)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	_, err := runOnce(ctx, cmd, st, opts)
+	return err
+}
+
+// runOnce validates opts, loads the current prompts/suites from disk, and
+// executes one full evaluation run, returning the resulting summary. It's
+// factored out of runEvaluations so --watch can call it repeatedly against a
+// fresh, per-iteration context without re-registering signal handling each
+// time.
+func runOnce(ctx context.Context, cmd *cobra.Command, st *cliState, opts *runOptions) (app.RunSummary, error) {
 	ciMode := resolveCIMode(opts)
 	applyCIOutputDefaults(opts, ciMode)
 
 	promptName := strings.TrimSpace(opts.promptName)
 	switch {
 	case opts.all && promptName != "":
-		return fmt.Errorf("run: --all and --prompt are mutually exclusive")
+		return app.RunSummary{}, fmt.Errorf("run: --all and --prompt are mutually exclusive")
 	case !opts.all && promptName == "":
-		return fmt.Errorf("run: specify either --prompt <name> or --all")
+		return app.RunSummary{}, fmt.Errorf("run: specify either --prompt <name> or --all")
 	}
 
-	output, err := resolveOutputFormat(opts.output, st.cfg.Evaluation.OutputFormat, opts.all)
+	output, err := resolveOutputFormat(opts.output, st.cfg.Evaluation.OutputFormat, opts.all, FormatJSONL, FormatJUnit)
 	if err != nil {
-		return fmt.Errorf("run: %w", err)
+		return app.RunSummary{}, fmt.Errorf("run: %w", err)
+	}
+	if strings.TrimSpace(opts.junitFile) != "" && output != FormatJUnit {
+		return app.RunSummary{}, fmt.Errorf("run: --junit-file requires --output junit")
+	}
+
+	precision := resolvePrecision(opts.precision, st.cfg.Evaluation.Precision)
+
+	if strings.TrimSpace(opts.order) == "" {
+		opts.order = suiteOrderAlpha
+	}
+	if !validSuiteOrder(opts.order) {
+		return app.RunSummary{}, fmt.Errorf("run: invalid --order %q (want alpha|declared|slowest-first)", opts.order)
 	}
 
 	trials := st.cfg.Evaluation.Trials
@@ -92,7 +184,7 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 		trials = opts.trials
 	}
 	if trials <= 0 {
-		return fmt.Errorf("run: trials must be > 0 (got %d)", trials)
+		return app.RunSummary{}, fmt.Errorf("run: trials must be > 0 (got %d)", trials)
 	}
 
 	threshold := st.cfg.Evaluation.Threshold
@@ -100,7 +192,7 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 		threshold = opts.threshold
 	}
 	if threshold < 0 || threshold > 1 {
-		return fmt.Errorf("run: threshold must be between 0 and 1 (got %v)", threshold)
+		return app.RunSummary{}, fmt.Errorf("run: threshold must be between 0 and 1 (got %v)", threshold)
 	}
 
 	concurrency := st.cfg.Evaluation.Concurrency
@@ -108,22 +200,57 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 		concurrency = 1
 	}
 
-	prompts, err := app.LoadPrompts(defaultPromptsDir)
+	seed := opts.seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	env := strings.TrimSpace(opts.env)
+	if env == "" {
+		env = strings.TrimSpace(st.cfg.Environment)
+	}
+
+	prompts, err := app.LoadPromptsForEnv(defaultPromptsDir, env)
 	if err != nil {
-		return err
+		return app.RunSummary{}, err
 	}
 	promptByName, err := app.IndexPrompts(prompts)
 	if err != nil {
-		return err
+		return app.RunSummary{}, err
 	}
 
-	suites, err := app.LoadTestSuites(defaultTestsDir)
+	noAssertionsPolicy, err := resolveNoAssertionsPolicy(st.cfg.Evaluation.NoAssertionsPolicy)
 	if err != nil {
-		return err
+		return app.RunSummary{}, fmt.Errorf("run: %w", err)
+	}
+
+	suites, noAssertionsWarnings, err := app.LoadTestSuitesWithPolicy(defaultTestsDir, noAssertionsPolicy)
+	if err != nil {
+		return app.RunSummary{}, err
+	}
+	for _, w := range noAssertionsWarnings {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", w)
+	}
+
+	suites, err = filterSuitesByGlob(suites, opts.suites, opts.excludeSuites)
+	if err != nil {
+		return app.RunSummary{}, fmt.Errorf("run: %w", err)
+	}
+	if name := strings.TrimSpace(opts.suite); name != "" {
+		suites, err = filterSuitesByExactName(suites, name)
+		if err != nil {
+			return app.RunSummary{}, fmt.Errorf("run: %w", err)
+		}
 	}
 	suitesByPrompt, err := app.IndexSuitesByPrompt(suites, promptByName)
 	if err != nil {
-		return err
+		return app.RunSummary{}, err
+	}
+	if len(opts.cases) > 0 {
+		suitesByPrompt, err = filterCasesByID(suitesByPrompt, opts.cases)
+		if err != nil {
+			return app.RunSummary{}, fmt.Errorf("run: %w", err)
+		}
 	}
 
 	var promptNames []string
@@ -134,17 +261,17 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 		sort.Strings(promptNames)
 	} else {
 		if _, ok := promptByName[promptName]; !ok {
-			return fmt.Errorf("run: unknown prompt %q", promptName)
+			return app.RunSummary{}, fmt.Errorf("run: unknown prompt %q", promptName)
 		}
 		promptNames = []string{promptName}
 	}
 	if len(promptNames) == 0 {
-		return fmt.Errorf("run: no test suites found")
+		return app.RunSummary{}, fmt.Errorf("run: no test suites found")
 	}
 
 	provider, err := defaultProviderFromConfig(st.cfg)
 	if err != nil {
-		return fmt.Errorf("run: %w", err)
+		return app.RunSummary{}, fmt.Errorf("run: %w", err)
 	}
 
 	reg := evaluator.NewRegistry()
@@ -153,36 +280,116 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 	reg.Register(evaluator.NotContainsEvaluator{})
 	reg.Register(evaluator.RegexEvaluator{})
 	reg.Register(evaluator.JSONSchemaEvaluator{})
+	reg.Register(evaluator.OpenAPIEvaluator{})
+	reg.Register(evaluator.DiversityEvaluator{})
+	reg.Register(evaluator.NonEmptyEvaluator{})
+	reg.Register(evaluator.ConsistencyEvaluator{})
+
+	promptWrapper := st.cfg.Evaluation.PromptWrapper
+	if opts.disablePromptWrapper {
+		promptWrapper = ""
+	}
+
+	runContext, err := mergeContextFlags(st.cfg.Evaluation.Context, opts.context)
+	if err != nil {
+		return app.RunSummary{}, fmt.Errorf("run: %w", err)
+	}
+
+	var jsonlEnc *json.Encoder
+	var onCaseComplete func(runner.RunResult)
+	if output == FormatJSONL {
+		var jsonlMu sync.Mutex
+		jsonlEnc = json.NewEncoder(cmd.OutOrStdout())
+		onCaseComplete = func(rr runner.RunResult) {
+			jsonlMu.Lock()
+			defer jsonlMu.Unlock()
+			_ = jsonlEnc.Encode(caseCompleteToJSONL(rr))
+		}
+	}
 
 	r := runner.NewRunner(provider, reg, runner.Config{
-		Trials:        trials,
-		PassThreshold: threshold,
-		Concurrency:   concurrency,
-		Timeout:       st.cfg.Evaluation.Timeout,
+		Trials:               trials,
+		PassThreshold:        threshold,
+		Concurrency:          concurrency,
+		EvaluatorConcurrency: st.cfg.Evaluation.EvaluatorConcurrency,
+		Timeout:              st.cfg.Evaluation.Timeout,
+		AdaptiveConcurrency:  st.cfg.Evaluation.AdaptiveConcurrency,
+		MinConcurrency:       st.cfg.Evaluation.MinConcurrency,
+		MaxConcurrency:       st.cfg.Evaluation.MaxConcurrency,
+		Seed:                 seed,
+		MaxStepsHardFail:     st.cfg.Evaluation.MaxStepsHardFail,
+		StrictSafety:         opts.strictSafety || st.cfg.Evaluation.StrictSafety,
+		ScoreEpsilon:         st.cfg.Evaluation.ScoreEpsilon,
+		PromptWrapper:        promptWrapper,
+		Context:              runContext,
+		OnCaseComplete:       onCaseComplete,
+		FailFast:             opts.failFast,
 	})
 
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Seed: %d\n", seed)
+
 	startedAt := time.Now().UTC()
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
-	defer stop()
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+
+	live := !opts.quiet && output != FormatJSONL && output != FormatJUnit && isTerminalWriter(cmd.OutOrStdout())
+	var totalSuites int
+	for _, name := range promptNames {
+		totalSuites += len(suitesByPrompt[name])
+	}
+	suitesDone := 0
 
 	var runs []app.SuiteRun
 	for _, name := range promptNames {
 		p := promptByName[name]
 		suites := suitesByPrompt[name]
 		if len(suites) == 0 {
-			return fmt.Errorf("run: no test suites found for prompt %q", name)
+			return app.RunSummary{}, fmt.Errorf("run: no test suites found for prompt %q", name)
+		}
+
+		var latencyBySuite map[string]int64
+		if opts.order == suiteOrderSlowestFirst && !opts.shuffle {
+			latencyBySuite, err = historicalSuiteLatencies(ctx, st, name)
+			if err != nil {
+				return app.RunSummary{}, err
+			}
+		}
+		var usedSeed int64
+		suites, usedSeed = orderSuites(suites, opts.order, opts.shuffle, seed, latencyBySuite)
+		if opts.shuffle {
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Shuffled suite order for prompt %q with --seed=%d\n", name, usedSeed)
 		}
-		sort.Slice(suites, func(i, j int) bool { return suites[i].Suite < suites[j].Suite })
 
 		for _, suite := range suites {
 			res, _ := r.RunSuite(ctx, p, suite)
 			runs = append(runs, app.SuiteRun{PromptName: name, PromptVersion: p.Version, Suite: suite, Result: res})
+
+			suitesDone++
+			if live {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "\rProgress: %d/%d suites (prompt=%s suite=%s %s)\033[K",
+					suitesDone, totalSuites, name, suite.Suite, coloredStatus(suitePassed(res)))
+			}
 		}
 	}
 
+	if live {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout())
+	}
+
 	finishedAt := time.Now().UTC()
 
+	// A canceled ctx here means the run was interrupted (SIGINT, --timeout,
+	// or --watch superseding this run with a newer file change) before
+	// finishing every suite: skip printing/persisting a report built from
+	// incomplete results rather than writing something misleading.
+	if err := ctx.Err(); err != nil {
+		return app.RunSummary{}, err
+	}
+
 	anyFailed, summary := app.SummarizeRuns(runs)
 	switch output {
 	case FormatTable:
@@ -190,11 +397,13 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Prompt: %s\n\n", promptNames[0])
 		}
 		for _, r := range runs {
-			_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(r.Result, FormatTable))
+			_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(r.Result, FormatTable, opts.showPassMetrics, precision))
 		}
 
-		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Summary: suites=%d cases=%d passed=%d failed=%d latency_ms=%d tokens=%d\n",
-			summary.TotalSuites, summary.TotalCases, summary.PassedCases, summary.FailedCases, summary.TotalLatency, summary.TotalTokens)
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Summary: suites=%d cases=%d passed=%d failed=%d skipped=%d latency_ms=%d tokens=%d\n",
+			summary.TotalSuites, summary.TotalCases, summary.PassedCases, summary.FailedCases, summary.SkippedCases, summary.TotalLatency, summary.TotalTokens)
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Latency: p50=%dms p95=%dms p99=%dms\n",
+			summary.LatencyP50, summary.LatencyP95, summary.LatencyP99)
 
 		if summary.FailedCases == 0 {
 			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Overall: %s\n", coloredStatus(true))
@@ -203,7 +412,13 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 		}
 	case FormatJSON:
 		if err := printRunJSON(cmd, runs, summary); err != nil {
-			return err
+			return app.RunSummary{}, err
+		}
+	case FormatJSONL:
+		// Per-case lines were already streamed via onCaseComplete as each case
+		// finished; only the final summary line remains.
+		if err := jsonlEnc.Encode(jsonRunSummaryLine{Summary: summary}); err != nil {
+			return app.RunSummary{}, fmt.Errorf("run: marshal jsonl summary: %w", err)
 		}
 	case FormatGitHub:
 		for _, r := range runs {
@@ -213,24 +428,172 @@ func runEvaluations(cmd *cobra.Command, st *cliState, opts *runOptions) error {
 				tmp.Suite = fmt.Sprintf("%s (prompt=%s)", strings.TrimSpace(tmp.Suite), r.PromptName)
 				res = &tmp
 			}
-			_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(res, FormatGitHub))
+			_, _ = fmt.Fprint(cmd.OutOrStdout(), FormatSuiteResult(res, FormatGitHub, opts.showPassMetrics, precision))
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Summary: suites=%d cases=%d passed=%d failed=%d skipped=%d latency_ms=%d tokens=%d\n",
+			summary.TotalSuites, summary.TotalCases, summary.PassedCases, summary.FailedCases, summary.SkippedCases, summary.TotalLatency, summary.TotalTokens)
+	case FormatJUnit:
+		xmlDoc, err := formatRunJUnit(runs)
+		if err != nil {
+			return app.RunSummary{}, err
+		}
+		if _, err := fmt.Fprint(cmd.OutOrStdout(), xmlDoc); err != nil {
+			return app.RunSummary{}, fmt.Errorf("run: write junit report: %w", err)
+		}
+		if junitFile := strings.TrimSpace(opts.junitFile); junitFile != "" {
+			if err := os.WriteFile(junitFile, []byte(xmlDoc), 0o644); err != nil {
+				return app.RunSummary{}, fmt.Errorf("run: write --junit-file: %w", err)
+			}
+		}
+	}
+
+	regressed := false
+	if opts.comparePrevious {
+		regressed, err = checkRegressionsAgainstPrevious(cmd.Context(), st, cmd.OutOrStdout(), runs, opts.regressionThreshold)
+		if err != nil {
+			return app.RunSummary{}, fmt.Errorf("run: compare-previous: %w", err)
 		}
-		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Summary: suites=%d cases=%d passed=%d failed=%d latency_ms=%d tokens=%d\n",
-			summary.TotalSuites, summary.TotalCases, summary.PassedCases, summary.FailedCases, summary.TotalLatency, summary.TotalTokens)
 	}
 
-	if err := saveRunToStore(cmd.Context(), st, runs, summary, startedAt, finishedAt, promptNames, opts.all, output, trials, threshold, concurrency); err != nil {
-		return err
+	persistResponses := opts.persistResponses || st.cfg.Evaluation.PersistResponses
+	if err := saveRunToStore(cmd.Context(), st, runs, summary, startedAt, finishedAt, promptNames, opts.all, output, trials, threshold, concurrency, seed, env, persistResponses, opts.suites, opts.excludeSuites, opts.suite, opts.cases, runContext); err != nil {
+		return app.RunSummary{}, err
 	}
 
 	if ciMode {
-		writeCIArtifacts(runs, summary, startedAt, finishedAt, threshold)
+		writeCIArtifacts(runs, summary, startedAt, finishedAt, threshold, opts.ciOutputDir, opts.ciOutputFile, promptNames)
 	}
 
 	if anyFailed {
-		return errTestsFailed
+		return summary, errTestsFailed
 	}
-	return nil
+	if regressed {
+		return summary, errRegression
+	}
+	return summary, nil
+}
+
+// filterSuitesByGlob narrows suites to those whose name matches at least one
+// include pattern (when include is non-empty) and none of the exclude
+// patterns, using shell glob syntax (path.Match). Patterns are applied
+// before suites are indexed by prompt, so a single --suites/--exclude-suites
+// invocation can target suites spanning multiple prompts. Returns an error
+// naming the patterns if nothing survives filtering.
+func filterSuitesByGlob(suites []*testcase.TestSuite, include, exclude []string) ([]*testcase.TestSuite, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return suites, nil
+	}
+
+	out := make([]*testcase.TestSuite, 0, len(suites))
+	for _, s := range suites {
+		if s == nil {
+			continue
+		}
+		if len(include) > 0 {
+			matched, err := matchesAnyGlob(s.Suite, include)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+		}
+		if len(exclude) > 0 {
+			matched, err := matchesAnyGlob(s.Suite, exclude)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				continue
+			}
+		}
+		out = append(out, s)
+	}
+
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no suites match --suites=%v --exclude-suites=%v", include, exclude)
+	}
+	return out, nil
+}
+
+// filterSuitesByExactName narrows suites to the single suite named name,
+// for --suite. Unlike --suites/--exclude-suites, this is an exact match, not
+// a glob, since --suite exists to disambiguate a --case ID that only makes
+// sense within one particular suite.
+func filterSuitesByExactName(suites []*testcase.TestSuite, name string) ([]*testcase.TestSuite, error) {
+	out := make([]*testcase.TestSuite, 0, 1)
+	for _, s := range suites {
+		if s != nil && s.Suite == name {
+			out = append(out, s)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no loaded suite named %q (after --suites/--exclude-suites filtering)", name)
+	}
+	return out, nil
+}
+
+// filterCasesByID narrows every suite's Cases to those whose ID is in
+// caseIDs, dropping suites left with no matching cases, so --case can debug
+// a single case out of a large suite without editing the suite file. Returns
+// an error naming any requested ID that matched no case in the suites
+// selected by --prompt/--all/--suites/--suite.
+func filterCasesByID(suitesByPrompt map[string][]*testcase.TestSuite, caseIDs []string) (map[string][]*testcase.TestSuite, error) {
+	want := make(map[string]bool, len(caseIDs))
+	for _, id := range caseIDs {
+		want[id] = true
+	}
+	found := make(map[string]bool, len(caseIDs))
+
+	out := make(map[string][]*testcase.TestSuite, len(suitesByPrompt))
+	for name, suites := range suitesByPrompt {
+		var kept []*testcase.TestSuite
+		for _, s := range suites {
+			var cases []testcase.TestCase
+			for _, c := range s.Cases {
+				if want[c.ID] {
+					found[c.ID] = true
+					cases = append(cases, c)
+				}
+			}
+			if len(cases) == 0 {
+				continue
+			}
+			clone := *s
+			clone.Cases = cases
+			kept = append(kept, &clone)
+		}
+		if len(kept) > 0 {
+			out[name] = kept
+		}
+	}
+
+	var missing []string
+	for _, id := range caseIDs {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("--case id(s) not found in loaded suites: %s", strings.Join(missing, ", "))
+	}
+	return out, nil
+}
+
+// matchesAnyGlob reports whether name matches any of patterns (shell glob
+// syntax via path.Match).
+func matchesAnyGlob(name string, patterns []string) (bool, error) {
+	for _, p := range patterns {
+		ok, err := path.Match(p, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid glob pattern %q: %w", p, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 type jsonRunSuiteLine struct {
@@ -244,6 +607,35 @@ type jsonRunSummaryLine struct {
 	Summary app.RunSummary `json:"summary"`
 }
 
+// jsonlCaseLine is one --output jsonl line, written as soon as a case
+// completes. It's deliberately a smaller shape than jsonCaseRun (no trials,
+// no rendered prompts): the point of jsonl is a cheap, uniform line for
+// downstream stream processing, not a full record of the case.
+type jsonlCaseLine struct {
+	Suite      string  `json:"suite"`
+	CaseID     string  `json:"case_id"`
+	Passed     bool    `json:"passed"`
+	Score      float64 `json:"score"`
+	TokensUsed int     `json:"tokens_used"`
+	LatencyMs  int64   `json:"latency_ms"`
+	Error      string  `json:"error,omitempty"`
+}
+
+func caseCompleteToJSONL(rr runner.RunResult) jsonlCaseLine {
+	line := jsonlCaseLine{
+		Suite:      rr.Suite,
+		CaseID:     rr.CaseID,
+		Passed:     rr.Passed,
+		Score:      rr.Score,
+		TokensUsed: rr.TokensUsed,
+		LatencyMs:  rr.LatencyMs,
+	}
+	if rr.Error != nil {
+		line.Error = rr.Error.Error()
+	}
+	return line
+}
+
 func printRunJSON(cmd *cobra.Command, runs []app.SuiteRun, summary app.RunSummary) error {
 	out := cmd.OutOrStdout()
 	enc := json.NewEncoder(out)
@@ -276,7 +668,7 @@ func printRunJSON(cmd *cobra.Command, runs []app.SuiteRun, summary app.RunSummar
 	return nil
 }
 
-func saveRunToStore(ctx context.Context, st *cliState, runs []app.SuiteRun, summary app.RunSummary, startedAt, finishedAt time.Time, promptNames []string, all bool, output OutputFormat, trials int, threshold float64, concurrency int) error {
+func saveRunToStore(ctx context.Context, st *cliState, runs []app.SuiteRun, summary app.RunSummary, startedAt, finishedAt time.Time, promptNames []string, all bool, output OutputFormat, trials int, threshold float64, concurrency int, seed int64, env string, persistResponses bool, suites, excludeSuites []string, suite string, cases []string, runContext map[string]any) error {
 	if st == nil || st.cfg == nil {
 		return fmt.Errorf("run: missing config (internal error)")
 	}
@@ -290,17 +682,18 @@ func saveRunToStore(ctx context.Context, st *cliState, runs []app.SuiteRun, summ
 	}
 	defer stor.Close()
 
-	_, err = app.SaveRun(ctx, stor, runs, summary, startedAt, finishedAt, buildRunConfig(st, promptNames, all, output, trials, threshold, concurrency))
+	_, err = app.SaveRun(ctx, stor, runs, summary, startedAt, finishedAt, buildRunConfig(st, promptNames, all, output, trials, threshold, concurrency, seed, env, suites, excludeSuites, suite, cases, runContext), persistResponses, st.cfg.Redactor())
 	return err
 }
 
-func buildRunConfig(st *cliState, promptNames []string, all bool, output OutputFormat, trials int, threshold float64, concurrency int) map[string]any {
+func buildRunConfig(st *cliState, promptNames []string, all bool, output OutputFormat, trials int, threshold float64, concurrency int, seed int64, env string, suites, excludeSuites []string, suite string, cases []string, runContext map[string]any) map[string]any {
 	cfg := map[string]any{
 		"output":      string(output),
 		"trials":      trials,
 		"threshold":   threshold,
 		"concurrency": concurrency,
 		"all":         all,
+		"seed":        seed,
 	}
 	if len(promptNames) > 0 {
 		cfg["prompts"] = append([]string(nil), promptNames...)
@@ -308,5 +701,58 @@ func buildRunConfig(st *cliState, promptNames []string, all bool, output OutputF
 	if st != nil && st.cfg != nil && st.cfg.Evaluation.Timeout > 0 {
 		cfg["timeout_ms"] = st.cfg.Evaluation.Timeout.Milliseconds()
 	}
+	if env != "" {
+		cfg["environment"] = env
+	}
+	if len(suites) > 0 {
+		cfg["suites"] = append([]string(nil), suites...)
+	}
+	if len(excludeSuites) > 0 {
+		cfg["exclude_suites"] = append([]string(nil), excludeSuites...)
+	}
+	if suite != "" {
+		cfg["suite"] = suite
+	}
+	if len(cases) > 0 {
+		cfg["cases"] = append([]string(nil), cases...)
+	}
+	if len(runContext) > 0 {
+		cfg["context"] = runContext
+	}
 	return cfg
 }
+
+// mergeContextFlags parses --context KEY=VALUE flags and merges them over
+// base (evaluation.context from config), with flag values taking
+// precedence on key conflicts. Returns nil if both are empty.
+func mergeContextFlags(base map[string]any, flags []string) (map[string]any, error) {
+	if len(base) == 0 && len(flags) == 0 {
+		return nil, nil
+	}
+
+	merged := make(map[string]any, len(base)+len(flags))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for _, f := range flags {
+		parts := strings.SplitN(f, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("--context: invalid KEY=VALUE %q", f)
+		}
+		merged[parts[0]] = parts[1]
+	}
+	return merged, nil
+}
+
+// resolveNoAssertionsPolicy validates evaluation.no_assertions_policy,
+// defaulting to testcase.NoAssertionsError (reject at load time) when unset.
+func resolveNoAssertionsPolicy(configValue string) (testcase.NoAssertionsPolicy, error) {
+	switch p := testcase.NoAssertionsPolicy(strings.TrimSpace(configValue)); p {
+	case "":
+		return testcase.NoAssertionsError, nil
+	case testcase.NoAssertionsError, testcase.NoAssertionsDefaultEvaluator:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid evaluation.no_assertions_policy %q (expected error|default_evaluator)", configValue)
+	}
+}
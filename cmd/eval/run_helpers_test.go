@@ -15,6 +15,50 @@ import (
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
 )
 
+func TestResolveNoAssertionsPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		configValue string
+		want        testcase.NoAssertionsPolicy
+		wantErrSub  string
+	}{
+		{name: "unset defaults to error", configValue: "", want: testcase.NoAssertionsError},
+		{name: "explicit error", configValue: "error", want: testcase.NoAssertionsError},
+		{name: "default_evaluator", configValue: "default_evaluator", want: testcase.NoAssertionsDefaultEvaluator},
+		{name: "invalid", configValue: "wat", wantErrSub: "invalid evaluation.no_assertions_policy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveNoAssertionsPolicy(tt.configValue)
+			if tt.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrSub) {
+					t.Fatalf("resolveNoAssertionsPolicy: err=%v want substring %q", err, tt.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveNoAssertionsPolicy: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("resolveNoAssertionsPolicy: got %q want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsTerminalWriter_NonFile(t *testing.T) {
+	t.Parallel()
+
+	if isTerminalWriter(&bytes.Buffer{}) {
+		t.Fatalf("expected a non-*os.File writer to report false")
+	}
+}
+
 func TestPrintRunJSON(t *testing.T) {
 	t.Parallel()
 
@@ -65,15 +109,15 @@ func TestPrintRunJSON(t *testing.T) {
 func TestSaveRunToStore(t *testing.T) {
 	t.Parallel()
 
-	if err := saveRunToStore(context.Background(), nil, nil, app.RunSummary{}, time.Time{}, time.Time{}, nil, false, FormatTable, 1, 0.5, 1); err == nil {
+	if err := saveRunToStore(context.Background(), nil, nil, app.RunSummary{}, time.Time{}, time.Time{}, nil, false, FormatTable, 1, 0.5, 1, 7, "", false, nil, nil, "", nil, nil); err == nil {
 		t.Fatalf("expected error for nil cli state")
 	}
-	if err := saveRunToStore(context.Background(), &cliState{}, nil, app.RunSummary{}, time.Time{}, time.Time{}, nil, false, FormatTable, 1, 0.5, 1); err == nil {
+	if err := saveRunToStore(context.Background(), &cliState{}, nil, app.RunSummary{}, time.Time{}, time.Time{}, nil, false, FormatTable, 1, 0.5, 1, 7, "", false, nil, nil, "", nil, nil); err == nil {
 		t.Fatalf("expected error for nil config")
 	}
 
 	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "nope"}}}
-	if err := saveRunToStore(context.Background(), st, nil, app.RunSummary{}, time.Time{}, time.Time{}, nil, false, FormatTable, 1, 0.5, 1); err == nil || !strings.Contains(err.Error(), "run: open store") {
+	if err := saveRunToStore(context.Background(), st, nil, app.RunSummary{}, time.Time{}, time.Time{}, nil, false, FormatTable, 1, 0.5, 1, 7, "", false, nil, nil, "", nil, nil); err == nil || !strings.Contains(err.Error(), "run: open store") {
 		t.Fatalf("expected open store error, got %v", err)
 	}
 
@@ -88,7 +132,218 @@ func TestSaveRunToStore(t *testing.T) {
 	started := time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)
 	finished := started.Add(time.Second)
 
-	if err := saveRunToStore(nil, st, runs, summary, started, finished, []string{"p1"}, false, FormatJSON, 1, 0.8, 2); err != nil {
+	if err := saveRunToStore(nil, st, runs, summary, started, finished, []string{"p1"}, false, FormatJSON, 1, 0.8, 2, 42, "prod", false, nil, nil, "", nil, map[string]any{"tenant_id": "acme"}); err != nil {
 		t.Fatalf("saveRunToStore: %v", err)
 	}
 }
+
+func TestFilterSuitesByGlob(t *testing.T) {
+	t.Parallel()
+
+	suites := []*testcase.TestSuite{
+		{Suite: "checkout_happy_path"},
+		{Suite: "checkout_edge_cases"},
+		{Suite: "search_basic"},
+	}
+
+	t.Run("no patterns returns input unchanged", func(t *testing.T) {
+		got, err := filterSuitesByGlob(suites, nil, nil)
+		if err != nil || len(got) != 3 {
+			t.Fatalf("filterSuitesByGlob: got %#v err=%v", got, err)
+		}
+	})
+
+	t.Run("include glob", func(t *testing.T) {
+		got, err := filterSuitesByGlob(suites, []string{"checkout_*"}, nil)
+		if err != nil || len(got) != 2 {
+			t.Fatalf("filterSuitesByGlob: got %#v err=%v", got, err)
+		}
+	})
+
+	t.Run("exclude glob", func(t *testing.T) {
+		got, err := filterSuitesByGlob(suites, nil, []string{"*_edge_cases"})
+		if err != nil || len(got) != 2 {
+			t.Fatalf("filterSuitesByGlob: got %#v err=%v", got, err)
+		}
+	})
+
+	t.Run("include and exclude combine", func(t *testing.T) {
+		got, err := filterSuitesByGlob(suites, []string{"checkout_*"}, []string{"*_edge_cases"})
+		if err != nil || len(got) != 1 || got[0].Suite != "checkout_happy_path" {
+			t.Fatalf("filterSuitesByGlob: got %#v err=%v", got, err)
+		}
+	})
+
+	t.Run("no matches errors", func(t *testing.T) {
+		_, err := filterSuitesByGlob(suites, []string{"nope_*"}, nil)
+		if err == nil || !strings.Contains(err.Error(), "no suites match") {
+			t.Fatalf("expected no-match error, got %v", err)
+		}
+	})
+
+	t.Run("invalid pattern errors", func(t *testing.T) {
+		_, err := filterSuitesByGlob(suites, []string{"["}, nil)
+		if err == nil || !strings.Contains(err.Error(), "invalid glob pattern") {
+			t.Fatalf("expected invalid pattern error, got %v", err)
+		}
+	})
+}
+
+func TestBuildRunConfig_Environment(t *testing.T) {
+	t.Parallel()
+
+	st := &cliState{cfg: &config.Config{}}
+
+	withoutEnv := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "", nil, nil, "", nil, nil)
+	if _, ok := withoutEnv["environment"]; ok {
+		t.Fatalf("expected no environment key when unset, got %#v", withoutEnv)
+	}
+
+	withEnv := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "prod", nil, nil, "", nil, nil)
+	if withEnv["environment"] != "prod" {
+		t.Fatalf("environment: got %#v want %q", withEnv["environment"], "prod")
+	}
+}
+
+func TestBuildRunConfig_Suites(t *testing.T) {
+	t.Parallel()
+
+	st := &cliState{cfg: &config.Config{}}
+
+	cfg := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "", []string{"checkout_*"}, []string{"*_slow"}, "", nil, nil)
+	if got, ok := cfg["suites"].([]string); !ok || len(got) != 1 || got[0] != "checkout_*" {
+		t.Fatalf("suites: got %#v", cfg["suites"])
+	}
+	if got, ok := cfg["exclude_suites"].([]string); !ok || len(got) != 1 || got[0] != "*_slow" {
+		t.Fatalf("exclude_suites: got %#v", cfg["exclude_suites"])
+	}
+
+	empty := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "", nil, nil, "", nil, nil)
+	if _, ok := empty["suites"]; ok {
+		t.Fatalf("expected no suites key when unset, got %#v", empty)
+	}
+	if _, ok := empty["exclude_suites"]; ok {
+		t.Fatalf("expected no exclude_suites key when unset, got %#v", empty)
+	}
+}
+
+func TestBuildRunConfig_Context(t *testing.T) {
+	t.Parallel()
+
+	st := &cliState{cfg: &config.Config{}}
+
+	withoutContext := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "", nil, nil, "", nil, nil)
+	if _, ok := withoutContext["context"]; ok {
+		t.Fatalf("expected no context key when unset, got %#v", withoutContext)
+	}
+
+	withContext := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "", nil, nil, "", nil, map[string]any{"tenant_id": "acme"})
+	got, ok := withContext["context"].(map[string]any)
+	if !ok || got["tenant_id"] != "acme" {
+		t.Fatalf("context: got %#v", withContext["context"])
+	}
+}
+
+func TestBuildRunConfig_SuiteAndCases(t *testing.T) {
+	t.Parallel()
+
+	st := &cliState{cfg: &config.Config{}}
+
+	cfg := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "", nil, nil, "checkout_happy_path", []string{"c1", "c2"}, nil)
+	if cfg["suite"] != "checkout_happy_path" {
+		t.Fatalf("suite: got %#v", cfg["suite"])
+	}
+	if got, ok := cfg["cases"].([]string); !ok || len(got) != 2 || got[0] != "c1" || got[1] != "c2" {
+		t.Fatalf("cases: got %#v", cfg["cases"])
+	}
+
+	empty := buildRunConfig(st, []string{"p1"}, false, FormatTable, 1, 0.5, 1, 7, "", nil, nil, "", nil, nil)
+	if _, ok := empty["suite"]; ok {
+		t.Fatalf("expected no suite key when unset, got %#v", empty)
+	}
+	if _, ok := empty["cases"]; ok {
+		t.Fatalf("expected no cases key when unset, got %#v", empty)
+	}
+}
+
+func TestFilterSuitesByExactName(t *testing.T) {
+	t.Parallel()
+
+	suites := []*testcase.TestSuite{
+		{Suite: "checkout_happy_path"},
+		{Suite: "checkout_edge_cases"},
+	}
+
+	got, err := filterSuitesByExactName(suites, "checkout_edge_cases")
+	if err != nil || len(got) != 1 || got[0].Suite != "checkout_edge_cases" {
+		t.Fatalf("filterSuitesByExactName: got %#v err=%v", got, err)
+	}
+
+	if _, err := filterSuitesByExactName(suites, "nope"); err == nil || !strings.Contains(err.Error(), "no loaded suite named") {
+		t.Fatalf("expected no-match error, got %v", err)
+	}
+}
+
+func TestFilterCasesByID(t *testing.T) {
+	t.Parallel()
+
+	suitesByPrompt := map[string][]*testcase.TestSuite{
+		"p1": {
+			{Suite: "s1", Cases: []testcase.TestCase{{ID: "c1"}, {ID: "c2"}}},
+			{Suite: "s2", Cases: []testcase.TestCase{{ID: "c3"}}},
+		},
+	}
+
+	t.Run("filters cases and drops empty suites", func(t *testing.T) {
+		got, err := filterCasesByID(suitesByPrompt, []string{"c2"})
+		if err != nil {
+			t.Fatalf("filterCasesByID: %v", err)
+		}
+		suites := got["p1"]
+		if len(suites) != 1 || suites[0].Suite != "s1" || len(suites[0].Cases) != 1 || suites[0].Cases[0].ID != "c2" {
+			t.Fatalf("got %#v", suites)
+		}
+	})
+
+	t.Run("does not mutate the input suites", func(t *testing.T) {
+		if _, err := filterCasesByID(suitesByPrompt, []string{"c2"}); err != nil {
+			t.Fatalf("filterCasesByID: %v", err)
+		}
+		if len(suitesByPrompt["p1"][0].Cases) != 2 {
+			t.Fatalf("expected original suite untouched, got %#v", suitesByPrompt["p1"][0].Cases)
+		}
+	})
+
+	t.Run("unknown id errors", func(t *testing.T) {
+		_, err := filterCasesByID(suitesByPrompt, []string{"c1", "nope"})
+		if err == nil || !strings.Contains(err.Error(), "nope") {
+			t.Fatalf("expected error naming missing id, got %v", err)
+		}
+	})
+}
+
+func TestMergeContextFlags(t *testing.T) {
+	t.Parallel()
+
+	if got, err := mergeContextFlags(nil, nil); err != nil || got != nil {
+		t.Fatalf("empty inputs: got %#v err=%v", got, err)
+	}
+
+	got, err := mergeContextFlags(map[string]any{"tenant_id": "acme", "region": "us"}, []string{"tenant_id=other", "current_date=2026-08-08"})
+	if err != nil {
+		t.Fatalf("mergeContextFlags: %v", err)
+	}
+	if got["tenant_id"] != "other" {
+		t.Fatalf("flag should win over config: got %#v", got["tenant_id"])
+	}
+	if got["region"] != "us" {
+		t.Fatalf("config value should survive: got %#v", got["region"])
+	}
+	if got["current_date"] != "2026-08-08" {
+		t.Fatalf("flag-only key: got %#v", got["current_date"])
+	}
+
+	if _, err := mergeContextFlags(nil, []string{"noequals"}); err == nil {
+		t.Fatalf("expected error for malformed KEY=VALUE")
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func newServerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "server",
+		Short: "Operate against a running ai-eval API server",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newServerPingCmd())
+	return cmd
+}
+
+type serverPingOptions struct {
+	addr    string
+	apiKey  string
+	timeout time.Duration
+}
+
+func newServerPingCmd() *cobra.Command {
+	var opts serverPingOptions
+
+	cmd := &cobra.Command{
+		Use:   "ping",
+		Short: "Smoke-test a running ai-eval API server over HTTP",
+		Long: "Ping hits a running server's health and read endpoints and reports status and\n" +
+			"latency for each, exiting non-zero if any check fails. Unlike `doctor`-style\n" +
+			"commands that inspect local config, this exercises the server end to end over\n" +
+			"the network, so it's suitable for deployment health checks.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServerPing(cmd, &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.addr, "addr", "http://localhost:8080", "ai-eval API server address")
+	cmd.Flags().StringVar(&opts.apiKey, "api-key", "", "X-API-Key header value (defaults to $AI_EVAL_API_KEY)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 5*time.Second, "timeout per request")
+
+	return cmd
+}
+
+// serverPingCheck is one read-only endpoint hit by `server ping`.
+type serverPingCheck struct {
+	name string
+	path string
+}
+
+var serverPingChecks = []serverPingCheck{
+	{name: "health", path: "/api/health"},
+	{name: "prompts", path: "/api/prompts"},
+}
+
+func runServerPing(cmd *cobra.Command, opts *serverPingOptions) error {
+	if opts == nil {
+		return fmt.Errorf("server ping: nil options")
+	}
+
+	addr := strings.TrimRight(strings.TrimSpace(opts.addr), "/")
+	if addr == "" {
+		return fmt.Errorf("server ping: --addr is required")
+	}
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+
+	apiKey := strings.TrimSpace(opts.apiKey)
+	if apiKey == "" {
+		apiKey = os.Getenv("AI_EVAL_API_KEY")
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	client := &http.Client{Timeout: opts.timeout}
+
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tLATENCY\tDETAIL")
+
+	var failures []string
+	for _, check := range serverPingChecks {
+		url := addr + check.path
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("server ping: build request for %s: %w", check.name, err)
+		}
+		if apiKey != "" {
+			req.Header.Set("X-API-Key", apiKey)
+		}
+
+		start := time.Now()
+		resp, err := client.Do(req)
+		latency := time.Since(start).Round(time.Millisecond)
+		if err != nil {
+			fmt.Fprintf(tw, "%s\tFAIL\t%s\t%v\n", check.name, latency, err)
+			failures = append(failures, check.name)
+			continue
+		}
+		_ = resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			fmt.Fprintf(tw, "%s\tFAIL\t%s\tHTTP %d\n", check.name, latency, resp.StatusCode)
+			failures = append(failures, check.name)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\tOK\t%s\tHTTP %d\n", check.name, latency, resp.StatusCode)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("server ping: %w", err)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("server ping: %s unreachable or unhealthy at %s", strings.Join(failures, ", "), addr)
+	}
+	return nil
+}
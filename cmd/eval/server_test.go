@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestRunServerPing_Success(t *testing.T) {
+	t.Parallel()
+
+	var gotAPIKey string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAPIKey = r.Header.Get("X-API-Key")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+	cmd.SetContext(context.Background())
+
+	opts := &serverPingOptions{addr: srv.URL, apiKey: "secret", timeout: 2 * time.Second}
+	if err := runServerPing(cmd, opts); err != nil {
+		t.Fatalf("runServerPing: %v", err)
+	}
+	if gotAPIKey != "secret" {
+		t.Fatalf("X-API-Key: got %q want %q", gotAPIKey, "secret")
+	}
+	out := buf.String()
+	if !strings.Contains(out, "health") || !strings.Contains(out, "prompts") {
+		t.Fatalf("expected both checks in output, got %q", out)
+	}
+	if strings.Contains(out, "FAIL") {
+		t.Fatalf("expected no failures, got %q", out)
+	}
+}
+
+func TestRunServerPing_FailureStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+	cmd.SetContext(context.Background())
+
+	opts := &serverPingOptions{addr: srv.URL, timeout: 2 * time.Second}
+	err := runServerPing(cmd, opts)
+	if err == nil {
+		t.Fatalf("expected error for failing check")
+	}
+	if !strings.Contains(err.Error(), "prompts") {
+		t.Fatalf("expected error to name failing check, got %v", err)
+	}
+	if !strings.Contains(buf.String(), "FAIL") {
+		t.Fatalf("expected FAIL in output, got %q", buf.String())
+	}
+}
+
+func TestRunServerPing_ConnectionRefused(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	addr := srv.URL
+	srv.Close()
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+	cmd.SetContext(context.Background())
+
+	opts := &serverPingOptions{addr: addr, timeout: time.Second}
+	if err := runServerPing(cmd, opts); err == nil {
+		t.Fatalf("expected error for unreachable server")
+	}
+}
+
+func TestRunServerPing_AddrWithoutScheme(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+	cmd.SetContext(context.Background())
+
+	opts := &serverPingOptions{addr: strings.TrimPrefix(srv.URL, "http://"), timeout: 2 * time.Second}
+	if err := runServerPing(cmd, opts); err != nil {
+		t.Fatalf("runServerPing: %v", err)
+	}
+}
+
+func TestRunServerPing_EmptyAddr(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	if err := runServerPing(cmd, &serverPingOptions{}); err == nil {
+		t.Fatalf("expected error for empty --addr")
+	}
+}
+
+func TestRunServerPing_NilOptions(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	if err := runServerPing(cmd, nil); err == nil {
+		t.Fatalf("expected error for nil options")
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+func newStoreCmd(st *cliState) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Inspect and maintain the results store",
+		Args:  cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(st.configPath)
+			if err != nil {
+				return err
+			}
+			st.cfg = cfg
+			return nil
+		},
+	}
+
+	cmd.AddCommand(newStoreMigrateCmd(st))
+	return cmd
+}
+
+func newStoreMigrateCmd(st *cliState) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply pending schema migrations to the store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStoreMigrate(cmd, st)
+		},
+	}
+}
+
+func runStoreMigrate(cmd *cobra.Command, st *cliState) error {
+	if st == nil || st.cfg == nil {
+		return fmt.Errorf("store: missing config (internal error)")
+	}
+
+	from, to, err := store.Migrate(st.cfg)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if from == to {
+		_, _ = fmt.Fprintf(out, "Store already at schema version %d.\n", to)
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "Migrated store from schema version %d to %d.\n", from, to)
+	return nil
+}
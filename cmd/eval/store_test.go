@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+)
+
+func TestRunStoreMigrate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "ai-eval.sqlite")
+
+	stor, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	_ = stor.Close()
+
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+	cmd.SetContext(context.Background())
+
+	if err := runStoreMigrate(cmd, st); err != nil {
+		t.Fatalf("runStoreMigrate: %v", err)
+	}
+	if !strings.Contains(buf.String(), "already at schema version") {
+		t.Fatalf("expected already-current message, got %q", buf.String())
+	}
+}
+
+func TestRunStoreMigrate_MissingConfig(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	if err := runStoreMigrate(cmd, &cliState{}); err == nil {
+		t.Fatalf("expected error for missing config")
+	}
+}
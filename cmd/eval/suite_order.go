@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+const (
+	suiteOrderAlpha        = "alpha"
+	suiteOrderDeclared     = "declared"
+	suiteOrderSlowestFirst = "slowest-first"
+)
+
+func validSuiteOrder(order string) bool {
+	switch order {
+	case suiteOrderAlpha, suiteOrderDeclared, suiteOrderSlowestFirst:
+		return true
+	default:
+		return false
+	}
+}
+
+// orderSuites returns a copy of suites reordered per order. "declared"
+// keeps the incoming (load) order; "alpha" sorts by suite name;
+// "slowest-first" sorts by descending historical latency from
+// latencyBySuite, falling back to declared order for suites with no
+// history. shuffle, when true, takes precedence over order and randomizes
+// with seed (0 picks a time-based seed, returned as usedSeed so the run can
+// be reproduced with --seed).
+func orderSuites(suites []*testcase.TestSuite, order string, shuffle bool, seed int64, latencyBySuite map[string]int64) (ordered []*testcase.TestSuite, usedSeed int64) {
+	ordered = make([]*testcase.TestSuite, len(suites))
+	copy(ordered, suites)
+
+	if shuffle {
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		rnd := rand.New(rand.NewSource(seed))
+		rnd.Shuffle(len(ordered), func(i, j int) { ordered[i], ordered[j] = ordered[j], ordered[i] })
+		return ordered, seed
+	}
+
+	switch order {
+	case suiteOrderDeclared:
+		// already in declared (load) order
+	case suiteOrderSlowestFirst:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return latencyBySuite[ordered[i].Suite] > latencyBySuite[ordered[j].Suite]
+		})
+	default:
+		sort.Slice(ordered, func(i, j int) bool { return ordered[i].Suite < ordered[j].Suite })
+	}
+	return ordered, 0
+}
+
+// historicalSuiteLatencies returns each suite's most recent total_latency
+// for promptName, keyed by suite name, using the store's prompt history.
+// Suites with no recorded history are simply absent from the result, which
+// orderSuites treats as zero latency (sorted after suites with history).
+func historicalSuiteLatencies(ctx context.Context, cfg *cliState, promptName string) (map[string]int64, error) {
+	stor, err := store.Open(cfg.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("run: open store: %w", err)
+	}
+	defer stor.Close()
+
+	const historyLimit = 200
+	history, err := stor.GetPromptHistory(ctx, promptName, historyLimit)
+	if err != nil {
+		return nil, fmt.Errorf("run: get prompt history: %w", err)
+	}
+
+	latency := make(map[string]int64, len(history))
+	for _, sr := range history {
+		if _, ok := latency[sr.SuiteName]; ok {
+			continue // history is newest-first; keep the first (most recent) entry
+		}
+		latency[sr.SuiteName] = sr.TotalLatency
+	}
+	return latency, nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func TestValidSuiteOrder(t *testing.T) {
+	t.Parallel()
+
+	for _, order := range []string{suiteOrderAlpha, suiteOrderDeclared, suiteOrderSlowestFirst} {
+		if !validSuiteOrder(order) {
+			t.Fatalf("validSuiteOrder(%q) = false, want true", order)
+		}
+	}
+	if validSuiteOrder("bogus") {
+		t.Fatalf("validSuiteOrder(bogus) = true, want false")
+	}
+}
+
+func TestOrderSuites(t *testing.T) {
+	t.Parallel()
+
+	suites := []*testcase.TestSuite{
+		{Suite: "c"},
+		{Suite: "a"},
+		{Suite: "b"},
+	}
+
+	t.Run("alpha", func(t *testing.T) {
+		got, seed := orderSuites(suites, suiteOrderAlpha, false, 0, nil)
+		if seed != 0 {
+			t.Fatalf("seed: got %d want 0", seed)
+		}
+		if got[0].Suite != "a" || got[1].Suite != "b" || got[2].Suite != "c" {
+			t.Fatalf("alpha order: got %v", suiteNames(got))
+		}
+	})
+
+	t.Run("declared", func(t *testing.T) {
+		got, _ := orderSuites(suites, suiteOrderDeclared, false, 0, nil)
+		if got[0].Suite != "c" || got[1].Suite != "a" || got[2].Suite != "b" {
+			t.Fatalf("declared order: got %v", suiteNames(got))
+		}
+	})
+
+	t.Run("slowest-first", func(t *testing.T) {
+		latency := map[string]int64{"a": 100, "b": 300, "c": 10}
+		got, _ := orderSuites(suites, suiteOrderSlowestFirst, false, 0, latency)
+		if got[0].Suite != "b" || got[1].Suite != "a" || got[2].Suite != "c" {
+			t.Fatalf("slowest-first order: got %v", suiteNames(got))
+		}
+	})
+
+	t.Run("shuffle is deterministic for a fixed seed", func(t *testing.T) {
+		got1, seed1 := orderSuites(suites, suiteOrderAlpha, true, 42, nil)
+		got2, seed2 := orderSuites(suites, suiteOrderAlpha, true, 42, nil)
+		if seed1 != 42 || seed2 != 42 {
+			t.Fatalf("seed: got %d/%d want 42/42", seed1, seed2)
+		}
+		if suiteNames(got1) != suiteNames(got2) {
+			t.Fatalf("shuffle with same seed diverged: %v vs %v", suiteNames(got1), suiteNames(got2))
+		}
+	})
+
+	t.Run("shuffle with zero seed picks a random one", func(t *testing.T) {
+		_, seed := orderSuites(suites, suiteOrderAlpha, true, 0, nil)
+		if seed == 0 {
+			t.Fatalf("expected a non-zero generated seed")
+		}
+	})
+
+	t.Run("does not mutate input slice", func(t *testing.T) {
+		orig := suiteNames(suites)
+		orderSuites(suites, suiteOrderAlpha, false, 0, nil)
+		if suiteNames(suites) != orig {
+			t.Fatalf("orderSuites mutated its input: got %v want %v", suiteNames(suites), orig)
+		}
+	})
+}
+
+func suiteNames(suites []*testcase.TestSuite) string {
+	s := ""
+	for _, suite := range suites {
+		s += suite.Suite + ","
+	}
+	return s
+}
+
+func TestHistoricalSuiteLatencies(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "ai-eval.sqlite")
+
+	stor, err := store.NewSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+
+	runs := []app.SuiteRun{
+		{
+			PromptName:    "p1",
+			PromptVersion: "v1",
+			Suite:         &testcase.TestSuite{Suite: "fast"},
+			Result:        &runner.SuiteResult{TotalCases: 1, PassedCases: 1, TotalLatency: 10},
+		},
+		{
+			PromptName:    "p1",
+			PromptVersion: "v1",
+			Suite:         &testcase.TestSuite{Suite: "slow"},
+			Result:        &runner.SuiteResult{TotalCases: 1, PassedCases: 1, TotalLatency: 900},
+		},
+	}
+	_, summary := app.SummarizeRuns(runs)
+	now := time.Now().UTC()
+	if _, err := app.SaveRun(context.Background(), stor, runs, summary, now, now, nil, false, nil); err != nil {
+		_ = stor.Close()
+		t.Fatalf("SaveRun: %v", err)
+	}
+	_ = stor.Close()
+
+	st := &cliState{cfg: &config.Config{Storage: config.StorageConfig{Type: "sqlite", Path: dbPath}}}
+
+	latency, err := historicalSuiteLatencies(context.Background(), st, "p1")
+	if err != nil {
+		t.Fatalf("historicalSuiteLatencies: %v", err)
+	}
+	if latency["fast"] != 10 || latency["slow"] != 900 {
+		t.Fatalf("latency: got %#v", latency)
+	}
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+	"github.com/stellarlinkco/ai-eval/internal/config"
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func newSuitesCmd(st *cliState) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suites",
+		Short: "Inspect and compare test suites",
+		Args:  cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(st.configPath)
+			if err != nil {
+				return err
+			}
+			st.cfg = cfg
+			return nil
+		},
+	}
+
+	cmd.AddCommand(newSuitesDiffCmd(st))
+	return cmd
+}
+
+type suitesDiffOptions struct {
+	a         string
+	b         string
+	run       bool
+	trials    int
+	output    string
+	precision int
+}
+
+func newSuitesDiffCmd(st *cliState) *cobra.Command {
+	var opts suitesDiffOptions
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Diff two versions of a test suite by case id",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSuitesDiff(cmd, st, &opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.a, "a", "", "path to the old test suite YAML file")
+	cmd.Flags().StringVar(&opts.b, "b", "", "path to the new test suite YAML file")
+	cmd.Flags().BoolVar(&opts.run, "run", false, "also run both suites and compare pass rates on shared cases")
+	cmd.Flags().IntVar(&opts.trials, "trials", -1, "number of trials per case when --run is set (overrides config)")
+	cmd.Flags().StringVar(&opts.output, "output", "", "output format: table|json")
+	cmd.Flags().IntVar(&opts.precision, "precision", -1, "significant digits for pass_rate/avg_score in --run output (overrides config)")
+
+	_ = cmd.MarkFlagRequired("a")
+	_ = cmd.MarkFlagRequired("b")
+
+	return cmd
+}
+
+// suiteCaseDiff describes how a single case id changed between two versions
+// of a suite. Exactly one of Added/Removed/Modified is true.
+type suiteCaseDiff struct {
+	CaseID   string
+	Added    bool
+	Removed  bool
+	Modified bool
+}
+
+// diffSuiteCases compares two suites by case id, reporting cases added in b,
+// removed from a, and cases present in both whose content differs.
+func diffSuiteCases(a, b *testcase.TestSuite) []suiteCaseDiff {
+	aByID := make(map[string]testcase.TestCase, len(a.Cases))
+	for _, c := range a.Cases {
+		aByID[c.ID] = c
+	}
+	bByID := make(map[string]testcase.TestCase, len(b.Cases))
+	for _, c := range b.Cases {
+		bByID[c.ID] = c
+	}
+
+	ids := make([]string, 0, len(aByID)+len(bByID))
+	seen := make(map[string]struct{}, len(aByID)+len(bByID))
+	for _, c := range a.Cases {
+		if _, ok := seen[c.ID]; ok {
+			continue
+		}
+		seen[c.ID] = struct{}{}
+		ids = append(ids, c.ID)
+	}
+	for _, c := range b.Cases {
+		if _, ok := seen[c.ID]; ok {
+			continue
+		}
+		seen[c.ID] = struct{}{}
+		ids = append(ids, c.ID)
+	}
+	sort.Strings(ids)
+
+	diffs := make([]suiteCaseDiff, 0, len(ids))
+	for _, id := range ids {
+		ca, okA := aByID[id]
+		cb, okB := bByID[id]
+		switch {
+		case !okA:
+			diffs = append(diffs, suiteCaseDiff{CaseID: id, Added: true})
+		case !okB:
+			diffs = append(diffs, suiteCaseDiff{CaseID: id, Removed: true})
+		case !reflect.DeepEqual(ca, cb):
+			diffs = append(diffs, suiteCaseDiff{CaseID: id, Modified: true})
+		}
+	}
+	return diffs
+}
+
+func runSuitesDiff(cmd *cobra.Command, st *cliState, opts *suitesDiffOptions) error {
+	if st == nil {
+		return fmt.Errorf("suites diff: nil state")
+	}
+	if opts == nil {
+		return fmt.Errorf("suites diff: nil options")
+	}
+
+	pathA := strings.TrimSpace(opts.a)
+	pathB := strings.TrimSpace(opts.b)
+	if pathA == "" || pathB == "" {
+		return fmt.Errorf("suites diff: missing --a/--b")
+	}
+
+	suiteA, err := testcase.LoadFromFile(pathA)
+	if err != nil {
+		return err
+	}
+	suiteB, err := testcase.LoadFromFile(pathB)
+	if err != nil {
+		return err
+	}
+
+	output, err := resolveOutputFormat(opts.output, "", false)
+	if err != nil {
+		return fmt.Errorf("suites diff: %w", err)
+	}
+
+	diffs := diffSuiteCases(suiteA, suiteB)
+
+	out := cmd.OutOrStdout()
+	if err := printSuitesDiff(out, suiteA, suiteB, diffs, output); err != nil {
+		return err
+	}
+
+	if !opts.run {
+		return nil
+	}
+	if st.cfg == nil {
+		return fmt.Errorf("suites diff: missing config (internal error)")
+	}
+
+	return runSuitesDiffCompare(cmd, st, opts, suiteA, suiteB)
+}
+
+func printSuitesDiff(out io.Writer, a, b *testcase.TestSuite, diffs []suiteCaseDiff, output OutputFormat) error {
+	switch output {
+	case FormatJSON:
+		enc := json.NewEncoder(out)
+		return enc.Encode(map[string]any{
+			"suite_a": a.Suite,
+			"suite_b": b.Suite,
+			"diffs":   diffs,
+		})
+	default:
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(tw, "Suite A: %s\tSuite B: %s\n", a.Suite, b.Suite)
+		fmt.Fprintln(tw, "CASE\tCHANGE")
+		for _, d := range diffs {
+			change := "modified"
+			switch {
+			case d.Added:
+				change = "added"
+			case d.Removed:
+				change = "removed"
+			}
+			fmt.Fprintf(tw, "%s\t%s\n", d.CaseID, change)
+		}
+		return tw.Flush()
+	}
+}
+
+func runSuitesDiffCompare(cmd *cobra.Command, st *cliState, opts *suitesDiffOptions, suiteA, suiteB *testcase.TestSuite) error {
+	promptName := strings.TrimSpace(suiteA.Prompt)
+	if promptName == "" || promptName != strings.TrimSpace(suiteB.Prompt) {
+		return fmt.Errorf("suites diff: --run requires both suites to reference the same prompt (got %q and %q)", suiteA.Prompt, suiteB.Prompt)
+	}
+
+	trials := st.cfg.Evaluation.Trials
+	if opts.trials >= 0 {
+		trials = opts.trials
+	}
+	if trials <= 0 {
+		return fmt.Errorf("suites diff: trials must be > 0 (got %d)", trials)
+	}
+
+	concurrency := st.cfg.Evaluation.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	prompts, err := app.LoadPromptsRecursive(defaultPromptsDir)
+	if err != nil {
+		return err
+	}
+	p, err := app.FindPromptLatestByName(prompts, promptName)
+	if err != nil {
+		return err
+	}
+
+	provider, err := defaultProviderFromConfig(st.cfg)
+	if err != nil {
+		return fmt.Errorf("suites diff: %w", err)
+	}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	reg.Register(evaluator.NotContainsEvaluator{})
+	reg.Register(evaluator.RegexEvaluator{})
+	reg.Register(evaluator.JSONSchemaEvaluator{})
+	reg.Register(evaluator.OpenAPIEvaluator{})
+	reg.Register(evaluator.DiversityEvaluator{})
+	reg.Register(evaluator.ConsistencyEvaluator{})
+
+	r := runner.NewRunner(provider, reg, runner.Config{
+		Trials:               trials,
+		PassThreshold:        st.cfg.Evaluation.Threshold,
+		Concurrency:          concurrency,
+		EvaluatorConcurrency: st.cfg.Evaluation.EvaluatorConcurrency,
+		Timeout:              st.cfg.Evaluation.Timeout,
+		MaxStepsHardFail:     st.cfg.Evaluation.MaxStepsHardFail,
+		StrictSafety:         st.cfg.Evaluation.StrictSafety,
+		ScoreEpsilon:         st.cfg.Evaluation.ScoreEpsilon,
+		PromptWrapper:        st.cfg.Evaluation.PromptWrapper,
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	resA, _ := r.RunSuite(ctx, p, suiteA)
+	resB, _ := r.RunSuite(ctx, p, suiteB)
+
+	summary, _ := buildCompare(resA, resB)
+	precision := resolvePrecision(opts.precision, st.cfg.Evaluation.Precision)
+
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "\nPrompt: %s\n", promptName)
+	_, _ = fmt.Fprintf(out, "Shared cases: %d\n", summary.ComparedCases)
+	_, _ = fmt.Fprintf(out, "PassRate: a=%s b=%s diff=%s\n", formatMetric(summary.V1PassRate, precision), formatMetric(summary.V2PassRate, precision), formatMetricSigned(summary.PassRateDelta, precision))
+	_, _ = fmt.Fprintf(out, "AvgScore: a=%s b=%s diff=%s\n", formatMetric(summary.V1AvgScore, precision), formatMetric(summary.V2AvgScore, precision), formatMetricSigned(summary.AvgScoreDelta, precision))
+	return nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func writeSuiteFile(t *testing.T, dir, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("write suite file: %v", err)
+	}
+	return path
+}
+
+const suiteYAMLA = `
+suite: greet
+prompt: greeter
+cases:
+  - id: hello
+    input:
+      name: world
+    expected:
+      contains: ["hello"]
+  - id: bye
+    input:
+      name: world
+    expected:
+      contains: ["bye"]
+`
+
+const suiteYAMLB = `
+suite: greet
+prompt: greeter
+cases:
+  - id: hello
+    input:
+      name: world
+    expected:
+      contains: ["hi"]
+  - id: goodnight
+    input:
+      name: world
+    expected:
+      contains: ["goodnight"]
+`
+
+func TestDiffSuiteCases(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pathA := writeSuiteFile(t, dir, "a.yaml", suiteYAMLA)
+	pathB := writeSuiteFile(t, dir, "b.yaml", suiteYAMLB)
+
+	a, err := testcase.LoadFromFile(pathA)
+	if err != nil {
+		t.Fatalf("LoadFromFile(a): %v", err)
+	}
+	b, err := testcase.LoadFromFile(pathB)
+	if err != nil {
+		t.Fatalf("LoadFromFile(b): %v", err)
+	}
+
+	diffs := diffSuiteCases(a, b)
+
+	got := map[string]string{}
+	for _, d := range diffs {
+		switch {
+		case d.Added:
+			got[d.CaseID] = "added"
+		case d.Removed:
+			got[d.CaseID] = "removed"
+		case d.Modified:
+			got[d.CaseID] = "modified"
+		}
+	}
+
+	want := map[string]string{
+		"hello":     "modified",
+		"bye":       "removed",
+		"goodnight": "added",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d diffs, want %d: %#v", len(got), len(want), got)
+	}
+	for id, change := range want {
+		if got[id] != change {
+			t.Errorf("case %q: got change %q, want %q", id, got[id], change)
+		}
+	}
+}
+
+func TestRunSuitesDiff_TableOutput(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pathA := writeSuiteFile(t, dir, "a.yaml", suiteYAMLA)
+	pathB := writeSuiteFile(t, dir, "b.yaml", suiteYAMLB)
+
+	var buf bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&buf)
+
+	opts := &suitesDiffOptions{a: pathA, b: pathB}
+	if err := runSuitesDiff(cmd, &cliState{}, opts); err != nil {
+		t.Fatalf("runSuitesDiff: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"hello", "modified", "bye", "removed", "goodnight", "added"} {
+		if !bytes.Contains([]byte(out), []byte(want)) {
+			t.Errorf("output missing %q: %s", want, out)
+		}
+	}
+}
+
+func TestRunSuitesDiff_MissingFlags(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	if err := runSuitesDiff(cmd, &cliState{}, &suitesDiffOptions{}); err == nil {
+		t.Fatalf("expected error for missing --a/--b")
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/version"
+)
+
+func newVersionCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Print build version information",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVersion(cmd, output)
+		},
+	}
+
+	cmd.Flags().StringVar(&output, "output", "text", "output format: text|json")
+
+	return cmd
+}
+
+func runVersion(cmd *cobra.Command, output string) error {
+	outFmt := strings.ToLower(strings.TrimSpace(output))
+	if outFmt == "" {
+		outFmt = "text"
+	}
+	if outFmt != "text" && outFmt != "json" {
+		return fmt.Errorf("version: invalid --output %q (expected text|json)", output)
+	}
+
+	info := version.Get()
+
+	switch outFmt {
+	case "json":
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(info)
+	default:
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "ai-eval %s\ncommit:     %s\nbuilt:      %s\ngo version: %s\n",
+			info.Version, info.Commit, info.Date, info.GoVersion)
+		return err
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/version"
+)
+
+func TestVersionCmd_Text(t *testing.T) {
+	t.Parallel()
+
+	cmd := newVersionCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !strings.Contains(out.String(), "ai-eval "+version.Version) {
+		t.Fatalf("output: got %q", out.String())
+	}
+}
+
+func TestVersionCmd_JSON(t *testing.T) {
+	t.Parallel()
+
+	cmd := newVersionCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--output", "json"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	var info version.Info
+	if err := json.Unmarshal(out.Bytes(), &info); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if info.Version != version.Version || info.GoVersion == "" {
+		t.Fatalf("info: got %#v", info)
+	}
+}
+
+func TestVersionCmd_InvalidOutput(t *testing.T) {
+	t.Parallel()
+
+	cmd := newVersionCmd()
+	cmd.SetArgs([]string{"--output", "wat"})
+
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "invalid --output") {
+		t.Fatalf("Execute: got %v", err)
+	}
+}
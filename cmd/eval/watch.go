@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellarlinkco/ai-eval/internal/app"
+)
+
+// watchPollInterval is how often the watched directories are polled for
+// mtime changes. ai-eval has no fsnotify dependency, so --watch polls
+// rather than using OS file-change notifications.
+const watchPollInterval = 500 * time.Millisecond
+
+// watchDebounce is how long to wait after the first detected change before
+// triggering a rerun, so a burst of saves (e.g. an editor writing a temp
+// file then renaming it over the original) collapses into one run.
+const watchDebounce = 300 * time.Millisecond
+
+// watchedFileExt is the file extension --watch polls for changes under
+// defaultPromptsDir and defaultTestsDir.
+const watchedFileExt = ".yaml"
+
+// newRunContext derives a cancelable context for a single --watch iteration.
+// Factored out so go vet's lostcancel check can see the returned CancelFunc
+// as handed off to the caller, instead of flagging the reassignment of
+// runWatch's cancelRun variable across loop iterations as a leak.
+func newRunContext(parent context.Context) (context.Context, context.CancelFunc) {
+	return context.WithCancel(parent)
+}
+
+// runWatch reruns runOnce whenever a .yaml file under defaultPromptsDir or
+// defaultTestsDir changes, printing the pass-rate delta between consecutive
+// runs. An in-flight run is canceled as soon as a new change arrives, and
+// its (now-incomplete) result is discarded rather than persisted -- see the
+// ctx.Err() check in runOnce. The loop exits cleanly on SIGINT.
+func runWatch(cmd *cobra.Command, st *cliState, opts *runOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	out := cmd.OutOrStdout()
+	mtimes, err := snapshotWatchedFiles()
+	if err != nil {
+		return fmt.Errorf("run --watch: %w", err)
+	}
+
+	var prevSummary *app.RunSummary
+	runCtx, cancelRun := newRunContext(ctx)
+	defer func() { cancelRun() }()
+
+	runAndReport := func() {
+		summary, err := runOnce(runCtx, cmd, st, opts)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			_, _ = fmt.Fprintf(out, "run --watch: %v\n", err)
+			return
+		}
+		printWatchDelta(out, prevSummary, &summary)
+		prevSummary = &summary
+	}
+
+	_, _ = fmt.Fprintf(out, "Watching %s/ and %s/ for changes (Ctrl+C to stop)...\n", defaultPromptsDir, defaultTestsDir)
+	runAndReport()
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			changed, next, err := watchedFilesChanged(mtimes)
+			if err != nil {
+				_, _ = fmt.Fprintf(out, "run --watch: %v\n", err)
+				continue
+			}
+			if !changed {
+				continue
+			}
+
+			select {
+			case <-time.After(watchDebounce):
+			case <-ctx.Done():
+				return nil
+			}
+			// Fold in anything that changed during the debounce window so a
+			// burst of saves triggers one rerun instead of several.
+			if changed2, next2, err := watchedFilesChanged(next); err == nil && changed2 {
+				next = next2
+			}
+			mtimes = next
+
+			cancelRun()
+			runCtx, cancelRun = newRunContext(ctx)
+			_, _ = fmt.Fprintln(out, "\nChange detected, rerunning...")
+			runAndReport()
+		}
+	}
+}
+
+// printWatchDelta prints cur's pass rate, and the delta from prev's pass
+// rate when there was a previous run.
+func printWatchDelta(out io.Writer, prev, cur *app.RunSummary) {
+	if cur == nil {
+		return
+	}
+	if prev == nil {
+		_, _ = fmt.Fprintf(out, "Pass rate: %s\n", formatMetric(passRate(cur), defaultPrecision))
+		return
+	}
+	_, _ = fmt.Fprintf(out, "Pass rate: %s -> %s (%s)\n",
+		formatMetric(passRate(prev), defaultPrecision),
+		formatMetric(passRate(cur), defaultPrecision),
+		formatMetricSigned(passRate(cur)-passRate(prev), defaultPrecision))
+}
+
+func passRate(s *app.RunSummary) float64 {
+	if s == nil || s.TotalCases == 0 {
+		return 0
+	}
+	return float64(s.PassedCases) / float64(s.TotalCases)
+}
+
+// snapshotWatchedFiles records the mtime of every watchedFileExt file under
+// defaultPromptsDir and defaultTestsDir.
+func snapshotWatchedFiles() (map[string]time.Time, error) {
+	out := make(map[string]time.Time)
+	for _, dir := range []string{defaultPromptsDir, defaultTestsDir} {
+		if err := walkWatchedFiles(dir, func(path string, mod time.Time) {
+			out[path] = mod
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// watchedFilesChanged reports whether the set of watched files or their
+// mtimes differ from prev.
+func watchedFilesChanged(prev map[string]time.Time) (bool, map[string]time.Time, error) {
+	next, err := snapshotWatchedFiles()
+	if err != nil {
+		return false, nil, err
+	}
+	if len(next) != len(prev) {
+		return true, next, nil
+	}
+	for path, mod := range next {
+		if prevMod, ok := prev[path]; !ok || !mod.Equal(prevMod) {
+			return true, next, nil
+		}
+	}
+	return false, next, nil
+}
+
+func walkWatchedFiles(dir string, fn func(path string, mod time.Time)) error {
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), watchedFileExt) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fn(path, info.ModTime())
+		return nil
+	})
+	if err != nil && os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stellarlinkco/ai-eval/internal/app"
+)
+
+func TestSnapshotWatchedFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	promptsDir := filepath.Join(dir, defaultPromptsDir)
+	testsDir := filepath.Join(dir, defaultTestsDir)
+	mkdirAll(t, promptsDir)
+	mkdirAll(t, testsDir)
+	writeFile(t, filepath.Join(promptsDir, "p1.yaml"), "name: p1\n")
+	writeFile(t, filepath.Join(testsDir, "s1.yaml"), "suite: s1\n")
+	writeFile(t, filepath.Join(testsDir, "notes.txt"), "ignored\n")
+
+	oldCwd, _ := os.Getwd()
+	_ = os.Chdir(dir)
+	t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+	snap, err := snapshotWatchedFiles()
+	if err != nil {
+		t.Fatalf("snapshotWatchedFiles: %v", err)
+	}
+	if len(snap) != 2 {
+		t.Fatalf("expected 2 watched files, got %d: %v", len(snap), snap)
+	}
+}
+
+func TestWatchedFilesChanged(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	promptsDir := filepath.Join(dir, defaultPromptsDir)
+	testsDir := filepath.Join(dir, defaultTestsDir)
+	mkdirAll(t, promptsDir)
+	mkdirAll(t, testsDir)
+	suitePath := filepath.Join(testsDir, "s1.yaml")
+	writeFile(t, suitePath, "suite: s1\n")
+
+	oldCwd, _ := os.Getwd()
+	_ = os.Chdir(dir)
+	t.Cleanup(func() { _ = os.Chdir(oldCwd) })
+
+	prev, err := snapshotWatchedFiles()
+	if err != nil {
+		t.Fatalf("snapshotWatchedFiles: %v", err)
+	}
+
+	if changed, _, err := watchedFilesChanged(prev); err != nil || changed {
+		t.Fatalf("expected no change, got changed=%v err=%v", changed, err)
+	}
+
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(suitePath, future, future); err != nil {
+		t.Fatalf("Chtimes: %v", err)
+	}
+	changed, next, err := watchedFilesChanged(prev)
+	if err != nil {
+		t.Fatalf("watchedFilesChanged: %v", err)
+	}
+	if !changed {
+		t.Fatalf("expected change after mtime update")
+	}
+
+	if changed, _, err := watchedFilesChanged(next); err != nil || changed {
+		t.Fatalf("expected no further change, got changed=%v err=%v", changed, err)
+	}
+
+	writeFile(t, filepath.Join(promptsDir, "p2.yaml"), "name: p2\n")
+	if changed, _, err := watchedFilesChanged(next); err != nil || !changed {
+		t.Fatalf("expected change after new file, got changed=%v err=%v", changed, err)
+	}
+}
+
+func TestPrintWatchDelta(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	printWatchDelta(&buf, nil, &app.RunSummary{TotalCases: 4, PassedCases: 2})
+	if buf.String() != "Pass rate: 0.5\n" {
+		t.Fatalf("first run: got %q", buf.String())
+	}
+
+	buf.Reset()
+	printWatchDelta(&buf, &app.RunSummary{TotalCases: 4, PassedCases: 2}, &app.RunSummary{TotalCases: 4, PassedCases: 4})
+	if buf.String() != "Pass rate: 0.5 -> 1 (+0.5)\n" {
+		t.Fatalf("delta: got %q", buf.String())
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stellarlinkco/ai-eval/api"
 	"github.com/stellarlinkco/ai-eval/internal/config"
@@ -37,6 +38,20 @@ func (s *stubStore) GetPromptHistory(context.Context, string, int) ([]*store.Sui
 func (s *stubStore) GetVersionComparison(context.Context, string, string, string) (*store.VersionComparison, error) {
 	return nil, nil
 }
+func (s *stubStore) GetFlakyCases(context.Context, string, int) ([]store.FlakyCase, error) {
+	return nil, nil
+}
+func (s *stubStore) AggregateStats(context.Context, store.StatsFilter) ([]store.StatsBucket, error) {
+	return nil, nil
+}
+func (s *stubStore) PruneRuns(context.Context, time.Time, int) (int, error) { return 0, nil }
+func (s *stubStore) CountPrunableRuns(context.Context, time.Time, int) (int, error) {
+	return 0, nil
+}
+func (s *stubStore) SaveRedteamResult(context.Context, *store.RedteamRecord) error { return nil }
+func (s *stubStore) GetRedteamResults(context.Context, string) ([]*store.RedteamRecord, error) {
+	return nil, nil
+}
 func (s *stubStore) Close() error {
 	s.closeCalled++
 	return s.closeErr
@@ -10,7 +10,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stellarlinkco/ai-eval/internal/llm"
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
+	"github.com/stellarlinkco/ai-eval/internal/redact"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
 	"github.com/stellarlinkco/ai-eval/internal/store"
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
@@ -267,6 +269,33 @@ func TestFilterIndexAndSummaries(t *testing.T) {
 	}
 }
 
+func TestSummarizeRuns_LatencyPercentiles(t *testing.T) {
+	_, summary := SummarizeRuns([]SuiteRun{
+		{Result: &runner.SuiteResult{
+			Results: []runner.RunResult{
+				{CaseID: "c1", Trials: []runner.TrialResult{{LatencyMs: 10}, {LatencyMs: 20}}},
+				{CaseID: "c2", Trials: []runner.TrialResult{{LatencyMs: 30}, {LatencyMs: 40}}},
+			},
+		}},
+		{Result: &runner.SuiteResult{
+			Results: []runner.RunResult{
+				{CaseID: "c3", Trials: []runner.TrialResult{{LatencyMs: 50}}},
+			},
+		}},
+	})
+	if summary.LatencyP50 != 30 {
+		t.Fatalf("LatencyP50: got %d want 30", summary.LatencyP50)
+	}
+	if summary.LatencyP95 != 50 || summary.LatencyP99 != 50 {
+		t.Fatalf("LatencyP95/P99: got %d/%d want 50/50", summary.LatencyP95, summary.LatencyP99)
+	}
+
+	_, empty := SummarizeRuns(nil)
+	if empty.LatencyP50 != 0 || empty.LatencyP95 != 0 || empty.LatencyP99 != 0 {
+		t.Fatalf("SummarizeRuns(nil) latency: got %#v", empty)
+	}
+}
+
 func TestSaveRun(t *testing.T) {
 	startedAt := time.Unix(100, 0).UTC()
 	finishedAt := time.Unix(200, 0).UTC()
@@ -277,7 +306,11 @@ func TestSaveRun(t *testing.T) {
 		{
 			PromptName:    "p1",
 			PromptVersion: "v1",
-			Suite:         &testcase.TestSuite{Suite: "s1"},
+			Suite: &testcase.TestSuite{
+				Suite:    "s1",
+				Metadata: map[string]string{"owner": "team-a"},
+				Cases:    []testcase.TestCase{{ID: "c1", Metadata: map[string]string{"jira": "EVAL-1"}}},
+			},
 			Result: &runner.SuiteResult{
 				TotalCases:   1,
 				PassedCases:  1,
@@ -327,7 +360,7 @@ func TestSaveRun(t *testing.T) {
 		t.Fatalf("SummarizeRuns: expected anyFailed")
 	}
 
-	rec, err := SaveRun(nil, w, runs, summary, startedAt, finishedAt, map[string]any{"k": "v"})
+	rec, err := SaveRun(nil, w, runs, summary, startedAt, finishedAt, map[string]any{"k": "v"}, false, nil)
 	if err != nil {
 		t.Fatalf("SaveRun: %v", err)
 	}
@@ -352,43 +385,174 @@ func TestSaveRun(t *testing.T) {
 	if got := w.suites[1].CaseResults[0].Error; got != "boom" {
 		t.Fatalf("case error: got %q want %q", got, "boom")
 	}
+	if got := w.suites[0].Metadata["owner"]; got != "team-a" {
+		t.Fatalf("suite metadata: got %q want %q", got, "team-a")
+	}
+	if got := w.suites[0].CaseResults[0].Metadata["jira"]; got != "EVAL-1" {
+		t.Fatalf("case metadata: got %q want %q", got, "EVAL-1")
+	}
 
-	_, err = SaveRun(context.Background(), nil, runs, summary, startedAt, finishedAt, nil)
+	_, err = SaveRun(context.Background(), nil, runs, summary, startedAt, finishedAt, nil, false, nil)
 	if err == nil {
 		t.Fatalf("SaveRun(nil writer): expected error")
 	}
 
 	w2 := &mockRunWriter{runErr: errors.New("save run")}
-	_, err = SaveRun(context.Background(), w2, runs, summary, startedAt, finishedAt, nil)
+	_, err = SaveRun(context.Background(), w2, runs, summary, startedAt, finishedAt, nil, false, nil)
 	if err == nil || !strings.Contains(err.Error(), "save run") {
 		t.Fatalf("SaveRun(run err): got %v", err)
 	}
 
 	w3 := &mockRunWriter{suiteErrAt: 1}
-	_, err = SaveRun(context.Background(), w3, runs, summary, startedAt, finishedAt, nil)
+	_, err = SaveRun(context.Background(), w3, runs, summary, startedAt, finishedAt, nil, false, nil)
 	if err == nil || !strings.Contains(err.Error(), "save suite result") {
 		t.Fatalf("SaveRun(suite err): got %v", err)
 	}
 
 	w4 := &mockRunWriter{}
-	_, err = SaveRun(context.Background(), w4, []SuiteRun{{Suite: &testcase.TestSuite{Suite: "s"}}}, summary, startedAt, finishedAt, nil)
+	_, err = SaveRun(context.Background(), w4, []SuiteRun{{Suite: &testcase.TestSuite{Suite: "s"}}}, summary, startedAt, finishedAt, nil, false, nil)
 	if err == nil || !strings.Contains(err.Error(), "missing suite result") {
 		t.Fatalf("SaveRun(missing result): got %v", err)
 	}
 
 	w5 := &mockRunWriter{}
-	_, err = SaveRun(context.Background(), w5, []SuiteRun{{Result: &runner.SuiteResult{TotalCases: 1}}}, summary, startedAt, finishedAt, nil)
+	_, err = SaveRun(context.Background(), w5, []SuiteRun{{Result: &runner.SuiteResult{TotalCases: 1}}}, summary, startedAt, finishedAt, nil, false, nil)
 	if err == nil || !strings.Contains(err.Error(), "missing suite result") {
 		t.Fatalf("SaveRun(missing suite): got %v", err)
 	}
 }
 
+func TestSaveRun_PersistResponses(t *testing.T) {
+	startedAt := time.Unix(100, 0).UTC()
+	finishedAt := time.Unix(200, 0).UTC()
+
+	runs := []SuiteRun{
+		{
+			PromptName: "p1",
+			Suite:      &testcase.TestSuite{Suite: "s1", Cases: []testcase.TestCase{{ID: "c1"}}},
+			Result: &runner.SuiteResult{
+				TotalCases: 1,
+				Results: []runner.RunResult{{
+					CaseID: "c1",
+					Trials: []runner.TrialResult{{
+						TrialNum: 1,
+						Response: "the answer is 42",
+						ToolCalls: []llm.ToolUse{{
+							Name:  "lookup",
+							Input: map[string]any{"query": "x", "api_key": "sk-secret"},
+						}},
+					}},
+					RenderedSystem: "you are helpful, api_key=sk-secret",
+					RenderedUser:   "what is 6*7?",
+				}},
+			},
+		},
+	}
+	_, summary := SummarizeRuns(runs)
+
+	t.Run("disabled by default", func(t *testing.T) {
+		w := &mockRunWriter{}
+		if _, err := SaveRun(context.Background(), w, runs, summary, startedAt, finishedAt, nil, false, nil); err != nil {
+			t.Fatalf("SaveRun: %v", err)
+		}
+		if got := w.suites[0].CaseResults[0].Responses; got != nil {
+			t.Fatalf("Responses: got %#v want nil", got)
+		}
+		if got := w.suites[0].CaseResults[0].RenderedSystem; got != "" {
+			t.Fatalf("RenderedSystem: got %q want empty", got)
+		}
+		if got := w.suites[0].CaseResults[0].RenderedUser; got != "" {
+			t.Fatalf("RenderedUser: got %q want empty", got)
+		}
+	})
+
+	t.Run("enabled captures and redacts", func(t *testing.T) {
+		w := &mockRunWriter{}
+		if _, err := SaveRun(context.Background(), w, runs, summary, startedAt, finishedAt, nil, true, nil); err != nil {
+			t.Fatalf("SaveRun: %v", err)
+		}
+		got := w.suites[0].CaseResults[0].Responses
+		if len(got) != 1 || got[0].Response != "the answer is 42" {
+			t.Fatalf("Responses: got %#v", got)
+		}
+		if len(got[0].ToolCalls) != 1 || got[0].ToolCalls[0].Name != "lookup" {
+			t.Fatalf("ToolCalls: got %#v", got[0].ToolCalls)
+		}
+		if got[0].ToolCalls[0].Input["api_key"] != redact.Mask {
+			t.Fatalf("ToolCalls[0].Input[api_key]: got %v want redacted", got[0].ToolCalls[0].Input["api_key"])
+		}
+		if got[0].ToolCalls[0].Input["query"] != "x" {
+			t.Fatalf("ToolCalls[0].Input[query]: got %v", got[0].ToolCalls[0].Input["query"])
+		}
+		if s := w.suites[0].CaseResults[0].RenderedSystem; s != "you are helpful, api_key=sk-secret" {
+			t.Fatalf("RenderedSystem: got %q", s)
+		}
+		if u := w.suites[0].CaseResults[0].RenderedUser; u != "what is 6*7?" {
+			t.Fatalf("RenderedUser: got %q", u)
+		}
+	})
+
+	t.Run("truncates oversized responses", func(t *testing.T) {
+		huge := strings.Repeat("a", maxPersistedResponseBytes+100)
+		big := []SuiteRun{{
+			Suite: &testcase.TestSuite{Suite: "s1", Cases: []testcase.TestCase{{ID: "c1"}}},
+			Result: &runner.SuiteResult{
+				TotalCases: 1,
+				Results: []runner.RunResult{{
+					CaseID:         "c1",
+					Trials:         []runner.TrialResult{{TrialNum: 1, Response: huge}},
+					RenderedSystem: huge,
+				}},
+			},
+		}}
+		_, bigSummary := SummarizeRuns(big)
+
+		w := &mockRunWriter{}
+		if _, err := SaveRun(context.Background(), w, big, bigSummary, startedAt, finishedAt, nil, true, nil); err != nil {
+			t.Fatalf("SaveRun: %v", err)
+		}
+		resp := w.suites[0].CaseResults[0].Responses[0].Response
+		if !strings.HasSuffix(resp, "...[truncated]") {
+			t.Fatalf("Response: want truncation marker, got suffix %q", resp[len(resp)-20:])
+		}
+		if len(resp) > maxPersistedResponseBytes+len("...[truncated]") {
+			t.Fatalf("Response: got length %d, want <= %d", len(resp), maxPersistedResponseBytes+len("...[truncated]"))
+		}
+
+		sys := w.suites[0].CaseResults[0].RenderedSystem
+		if !strings.HasSuffix(sys, "...[truncated]") {
+			t.Fatalf("RenderedSystem: want truncation marker, got suffix %q", sys[len(sys)-20:])
+		}
+		if len(sys) > maxPersistedResponseBytes+len("...[truncated]") {
+			t.Fatalf("RenderedSystem: got length %d, want <= %d", len(sys), maxPersistedResponseBytes+len("...[truncated]"))
+		}
+	})
+}
+
+func TestSaveRun_RunIDFuncOverride(t *testing.T) {
+	oldFunc := RunIDFunc
+	RunIDFunc = func() (string, error) { return "run_deterministic", nil }
+	t.Cleanup(func() { RunIDFunc = oldFunc })
+
+	w := &mockRunWriter{}
+	rec, err := SaveRun(context.Background(), w, nil, RunSummary{}, time.Time{}, time.Time{}, nil, false, nil)
+	if err != nil {
+		t.Fatalf("SaveRun: %v", err)
+	}
+	if rec.ID != "run_deterministic" {
+		t.Fatalf("ID: got %q want %q", rec.ID, "run_deterministic")
+	}
+	if len(w.runs) != 1 || w.runs[0].ID != "run_deterministic" {
+		t.Fatalf("saved run: got %#v", w.runs)
+	}
+}
+
 func TestSaveRun_RunIDError(t *testing.T) {
 	oldReader := rand.Reader
 	rand.Reader = errReader{}
 	t.Cleanup(func() { rand.Reader = oldReader })
 
-	_, err := SaveRun(context.Background(), &mockRunWriter{}, nil, RunSummary{}, time.Time{}, time.Time{}, nil)
+	_, err := SaveRun(context.Background(), &mockRunWriter{}, nil, RunSummary{}, time.Time{}, time.Time{}, nil, false, nil)
 	if err == nil || !strings.Contains(err.Error(), "run: generate run id") {
 		t.Fatalf("SaveRun(run id error): got %v", err)
 	}
@@ -419,6 +583,10 @@ func (w *mockRunWriter) SaveSuiteResult(ctx context.Context, result *store.Suite
 	return nil
 }
 
+func (w *mockRunWriter) PruneRuns(context.Context, time.Time, int) (int, error) { return 0, nil }
+
+func (w *mockRunWriter) SaveRedteamResult(context.Context, *store.RedteamRecord) error { return nil }
+
 type errReader struct{}
 
 func (errReader) Read([]byte) (int, error) {
@@ -16,6 +16,12 @@ func LoadPrompts(dir string) ([]*prompt.Prompt, error) {
 	return prompt.LoadFromDir(dir)
 }
 
+// LoadPromptsForEnv is LoadPrompts plus environment-specific overrides (see
+// prompt.Prompt.Overrides). env == "" behaves identically to LoadPrompts.
+func LoadPromptsForEnv(dir string, env string) ([]*prompt.Prompt, error) {
+	return prompt.LoadFromDirForEnv(dir, env)
+}
+
 func LoadPromptsRecursive(dir string) ([]*prompt.Prompt, error) {
 	var paths []string
 	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
@@ -52,6 +58,14 @@ func LoadTestSuites(dir string) ([]*testcase.TestSuite, error) {
 	return testcase.LoadFromDir(dir)
 }
 
+// LoadTestSuitesWithPolicy is LoadTestSuites, but applies policy to cases
+// with no expected assertions and no evaluators instead of always rejecting
+// them (see testcase.ApplyNoAssertionsPolicy), returning any warnings it
+// produced.
+func LoadTestSuitesWithPolicy(dir string, policy testcase.NoAssertionsPolicy) ([]*testcase.TestSuite, []string, error) {
+	return testcase.LoadFromDirWithPolicy(dir, policy)
+}
+
 func FindPromptByNameVersion(prompts []*prompt.Prompt, name string, version string) (*prompt.Prompt, error) {
 	name = strings.TrimSpace(name)
 	version = strings.TrimSpace(version)
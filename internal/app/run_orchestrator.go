@@ -6,10 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/stellarlinkco/ai-eval/internal/prompt"
+	"github.com/stellarlinkco/ai-eval/internal/redact"
 	"github.com/stellarlinkco/ai-eval/internal/runner"
 	"github.com/stellarlinkco/ai-eval/internal/store"
 	"github.com/stellarlinkco/ai-eval/internal/testcase"
@@ -27,8 +30,12 @@ type RunSummary struct {
 	TotalCases   int   `json:"total_cases"`
 	PassedCases  int   `json:"passed_cases"`
 	FailedCases  int   `json:"failed_cases"`
+	SkippedCases int   `json:"skipped_cases"`
 	TotalLatency int64 `json:"total_latency_ms"`
 	TotalTokens  int   `json:"total_tokens"`
+	LatencyP50   int64 `json:"latency_p50_ms"`
+	LatencyP95   int64 `json:"latency_p95_ms"`
+	LatencyP99   int64 `json:"latency_p99_ms"`
 }
 
 func IndexPrompts(prompts []*prompt.Prompt) (map[string]*prompt.Prompt, error) {
@@ -69,6 +76,7 @@ func IndexSuitesByPrompt(suites []*testcase.TestSuite, promptByName map[string]*
 
 func SummarizeRuns(runs []SuiteRun) (anyFailed bool, summary RunSummary) {
 	summary.TotalSuites = len(runs)
+	var latencies []int64
 	for _, r := range runs {
 		if r.Result == nil {
 			anyFailed = true
@@ -77,8 +85,14 @@ func SummarizeRuns(runs []SuiteRun) (anyFailed bool, summary RunSummary) {
 		summary.TotalCases += r.Result.TotalCases
 		summary.PassedCases += r.Result.PassedCases
 		summary.FailedCases += r.Result.FailedCases
+		summary.SkippedCases += r.Result.SkippedCases
 		summary.TotalLatency += r.Result.TotalLatency
 		summary.TotalTokens += r.Result.TotalTokens
+		for _, rr := range r.Result.Results {
+			for _, tr := range rr.Trials {
+				latencies = append(latencies, tr.LatencyMs)
+			}
+		}
 		if r.Result.FailedCases > 0 {
 			anyFailed = true
 		}
@@ -86,18 +100,55 @@ func SummarizeRuns(runs []SuiteRun) (anyFailed bool, summary RunSummary) {
 	if summary.FailedCases > 0 {
 		anyFailed = true
 	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	summary.LatencyP50 = latencyPercentile(latencies, 50)
+	summary.LatencyP95 = latencyPercentile(latencies, 95)
+	summary.LatencyP99 = latencyPercentile(latencies, 99)
+
 	return anyFailed, summary
 }
 
-func SaveRun(ctx context.Context, writer store.RunWriter, runs []SuiteRun, summary RunSummary, startedAt, finishedAt time.Time, runConfig map[string]any) (*store.RunRecord, error) {
+// latencyPercentile returns the p-th percentile (nearest-rank method) of a
+// slice already sorted ascending. Returns 0 for an empty slice.
+func latencyPercentile(sorted []int64, p float64) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// maxPersistedResponseBytes caps the size of a single trial's raw response
+// text before it's written to the store, so a runaway generation can't blow
+// up a suite_results row. Truncated responses are marked with a trailing
+// note rather than silently cut.
+const maxPersistedResponseBytes = 32 * 1024
+
+// SaveRun persists a run and its suite results. When persistResponses is
+// true, each trial's raw response text and tool calls are also saved (see
+// config.EvaluationConfig.PersistResponses); otherwise only scores/metrics
+// are written, matching prior behavior. redactor masks credentials and PII
+// out of anything persisted (see config.Config.Redactor); a nil redactor
+// uses redact.Default().
+func SaveRun(ctx context.Context, writer store.RunWriter, runs []SuiteRun, summary RunSummary, startedAt, finishedAt time.Time, runConfig map[string]any, persistResponses bool, redactor *redact.Redactor) (*store.RunRecord, error) {
 	if writer == nil {
 		return nil, errors.New("run: missing store")
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	if redactor == nil {
+		redactor = redact.Default()
+	}
 
-	runID, err := newRunID()
+	runID, err := RunIDFunc()
 	if err != nil {
 		return nil, fmt.Errorf("run: generate run id: %w", err)
 	}
@@ -130,20 +181,36 @@ func SaveRun(ctx context.Context, writer store.RunWriter, runs []SuiteRun, summa
 			return nil, errors.New("run: missing suite result")
 		}
 
+		caseMetadata := make(map[string]map[string]string, len(r.Suite.Cases))
+		for _, tc := range r.Suite.Cases {
+			if len(tc.Metadata) > 0 {
+				caseMetadata[tc.ID] = tc.Metadata
+			}
+		}
+
 		caseResults := make([]store.CaseRecord, 0, len(r.Result.Results))
 		for _, rr := range r.Result.Results {
 			cr := store.CaseRecord{
-				CaseID:     rr.CaseID,
-				Passed:     rr.Passed,
-				Score:      rr.Score,
-				PassAtK:    rr.PassAtK,
-				PassExpK:   rr.PassExpK,
-				LatencyMs:  rr.LatencyMs,
-				TokensUsed: rr.TokensUsed,
+				CaseID:           rr.CaseID,
+				Passed:           rr.Passed,
+				Score:            rr.Score,
+				PassAtK:          rr.PassAtK,
+				PassExpK:         rr.PassExpK,
+				LatencyMs:        rr.LatencyMs,
+				TokensUsed:       rr.TokensUsed,
+				PromptTokens:     rr.PromptTokens,
+				CompletionTokens: rr.CompletionTokens,
+				Skipped:          rr.Skipped,
+				Metadata:         caseMetadata[rr.CaseID],
 			}
 			if rr.Error != nil {
 				cr.Error = rr.Error.Error()
 			}
+			if persistResponses {
+				cr.Responses = trialResponseRecords(redactor, rr.Trials)
+				cr.RenderedSystem = truncateResponse(redactor.String(rr.RenderedSystem))
+				cr.RenderedUser = truncateResponse(redactor.String(rr.RenderedUser))
+			}
 			caseResults = append(caseResults, cr)
 		}
 
@@ -156,12 +223,14 @@ func SaveRun(ctx context.Context, writer store.RunWriter, runs []SuiteRun, summa
 			TotalCases:    r.Result.TotalCases,
 			PassedCases:   r.Result.PassedCases,
 			FailedCases:   r.Result.FailedCases,
+			SkippedCases:  r.Result.SkippedCases,
 			PassRate:      r.Result.PassRate,
 			AvgScore:      r.Result.AvgScore,
 			TotalLatency:  r.Result.TotalLatency,
 			TotalTokens:   r.Result.TotalTokens,
 			CreatedAt:     finishedAt,
 			CaseResults:   caseResults,
+			Metadata:      r.Suite.Metadata,
 		}
 		if err := writer.SaveSuiteResult(ctx, suiteRecord); err != nil {
 			return nil, fmt.Errorf("run: save suite result: %w", err)
@@ -171,6 +240,50 @@ func SaveRun(ctx context.Context, writer store.RunWriter, runs []SuiteRun, summa
 	return runRecord, nil
 }
 
+// trialResponseRecords converts trial results into their persisted form,
+// capping response size and redacting anything in tool call arguments that
+// looks like a credential.
+func trialResponseRecords(redactor *redact.Redactor, trials []runner.TrialResult) []store.TrialResponseRecord {
+	if len(trials) == 0 {
+		return nil
+	}
+
+	out := make([]store.TrialResponseRecord, 0, len(trials))
+	for _, tr := range trials {
+		rec := store.TrialResponseRecord{
+			TrialNum: tr.TrialNum,
+			Response: truncateResponse(redactor.String(tr.Response)),
+		}
+		for _, tu := range tr.ToolCalls {
+			rec.ToolCalls = append(rec.ToolCalls, store.ToolCallRecord{
+				Name:  tu.Name,
+				Input: redactor.Map(tu.Input),
+			})
+		}
+		out = append(out, rec)
+	}
+	return out
+}
+
+// truncateResponse caps s at maxPersistedResponseBytes, appending a marker
+// so a truncated response isn't mistaken for a complete one.
+func truncateResponse(s string) string {
+	if len(s) <= maxPersistedResponseBytes {
+		return s
+	}
+	return s[:maxPersistedResponseBytes] + "...[truncated]"
+}
+
+// RunIDFunc generates the ID assigned to a newly saved run. It's a
+// package-level var — the same injection pattern cmd/eval and cmd/server use
+// for osExit/stderrWriter — so tests (including cross-package integration
+// tests in cmd/eval and api, which can't reach an unexported var) can
+// substitute a deterministic generator and assert exact run IDs instead of
+// matching on the "run_" prefix or otherwise treating them as opaque.
+// Production code should never need to reassign this; it defaults to
+// newRunID, which embeds a timestamp and random bytes.
+var RunIDFunc = newRunID
+
 func newRunID() (string, error) {
 	var buf [8]byte
 	if _, err := io.ReadFull(rand.Reader, buf[:]); err != nil {
@@ -0,0 +1,134 @@
+// Package baseline stores and compares against a committed per-prompt
+// snapshot of suite-level metrics (pass_rate/avg_score), so a prompt's
+// expected behavior lives in a reviewable file rather than only in the
+// results store. It backs the `ai-eval baseline update`/`baseline check`
+// commands.
+package baseline
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+)
+
+// Suite is one suite's recorded metrics in a baseline file.
+type Suite struct {
+	PassRate float64 `yaml:"pass_rate"`
+	AvgScore float64 `yaml:"avg_score"`
+}
+
+// Baseline is a prompt's committed baseline: the version it was captured
+// against, and the pass_rate/avg_score to compare later runs against, one
+// entry per suite.
+type Baseline struct {
+	Prompt  string           `yaml:"prompt"`
+	Version string           `yaml:"version"`
+	Suites  map[string]Suite `yaml:"suites"`
+}
+
+// Path returns the conventional baseline file path for a prompt under dir,
+// e.g. Path("baselines", "support-agent") -> "baselines/support-agent.yaml".
+func Path(dir, promptName string) string {
+	return filepath.Join(dir, strings.TrimSpace(promptName)+".yaml")
+}
+
+// Load reads a baseline file written by Save. Callers can distinguish a
+// missing baseline (no prior `baseline update`) with os.IsNotExist.
+func Load(path string) (*Baseline, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var out Baseline
+	if err := yaml.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("baseline: parse %q: %w", path, err)
+	}
+	return &out, nil
+}
+
+// Save writes b to path as YAML, creating parent directories as needed.
+func Save(path string, b *Baseline) error {
+	if b == nil {
+		return fmt.Errorf("baseline: nil baseline")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("baseline: create dir for %q: %w", path, err)
+	}
+	out, err := yaml.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("baseline: marshal: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("baseline: write %q: %w", path, err)
+	}
+	return nil
+}
+
+// FromSuiteResults builds a Baseline for promptName/version from one
+// runner.SuiteResult per suite. Nil results are skipped.
+func FromSuiteResults(promptName, version string, results []*runner.SuiteResult) *Baseline {
+	out := &Baseline{
+		Prompt:  promptName,
+		Version: version,
+		Suites:  make(map[string]Suite, len(results)),
+	}
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		out.Suites[r.Suite] = Suite{PassRate: r.PassRate, AvgScore: r.AvgScore}
+	}
+	return out
+}
+
+// Diff is one suite's baseline-vs-current delta, as computed by Compare.
+type Diff struct {
+	Suite            string
+	BaselinePassRate float64
+	CurrentPassRate  float64
+	PassRateDelta    float64
+	BaselineAvgScore float64
+	CurrentAvgScore  float64
+	AvgScoreDelta    float64
+	Missing          bool // suite has no entry in the baseline
+	Regressed        bool
+}
+
+// Compare diffs each of results against b's recorded metrics for the same
+// suite, flagging a regression when pass_rate or avg_score drops by more
+// than threshold. A suite absent from the baseline is reported (Missing)
+// but never counted as a regression, since there is nothing to compare
+// against yet.
+func Compare(b *Baseline, results []*runner.SuiteResult, threshold float64) []Diff {
+	if b == nil {
+		b = &Baseline{}
+	}
+
+	diffs := make([]Diff, 0, len(results))
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		d := Diff{Suite: r.Suite, CurrentPassRate: r.PassRate, CurrentAvgScore: r.AvgScore}
+
+		base, ok := b.Suites[r.Suite]
+		if !ok {
+			d.Missing = true
+			diffs = append(diffs, d)
+			continue
+		}
+
+		d.BaselinePassRate = base.PassRate
+		d.BaselineAvgScore = base.AvgScore
+		d.PassRateDelta = r.PassRate - base.PassRate
+		d.AvgScoreDelta = r.AvgScore - base.AvgScore
+		d.Regressed = d.PassRateDelta < -threshold || d.AvgScoreDelta < -threshold
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
@@ -0,0 +1,133 @@
+package baseline
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+)
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	if got, want := Path("baselines", "support-agent"), filepath.Join("baselines", "support-agent.yaml"); got != want {
+		t.Fatalf("Path: got %q want %q", got, want)
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "sub", "p.yaml")
+	b := &Baseline{
+		Prompt:  "p",
+		Version: "v1",
+		Suites: map[string]Suite{
+			"s1": {PassRate: 0.9, AvgScore: 0.8},
+		},
+	}
+	if err := Save(path, b); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got.Prompt != "p" || got.Version != "v1" {
+		t.Fatalf("Load: got %#v", got)
+	}
+	if s := got.Suites["s1"]; s.PassRate != 0.9 || s.AvgScore != 0.8 {
+		t.Fatalf("Load suites: got %#v", got.Suites)
+	}
+}
+
+func TestLoad_Missing(t *testing.T) {
+	t.Parallel()
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err == nil || !os.IsNotExist(err) {
+		t.Fatalf("Load(missing): got %v, want os.IsNotExist", err)
+	}
+}
+
+func TestSave_NilBaseline(t *testing.T) {
+	t.Parallel()
+
+	if err := Save(filepath.Join(t.TempDir(), "p.yaml"), nil); err == nil {
+		t.Fatalf("Save(nil): expected error")
+	}
+}
+
+func TestFromSuiteResults(t *testing.T) {
+	t.Parallel()
+
+	b := FromSuiteResults("p", "v1", []*runner.SuiteResult{
+		{Suite: "s1", PassRate: 1, AvgScore: 0.9},
+		nil,
+		{Suite: "s2", PassRate: 0.5, AvgScore: 0.4},
+	})
+	if b.Prompt != "p" || b.Version != "v1" {
+		t.Fatalf("FromSuiteResults: got %#v", b)
+	}
+	if len(b.Suites) != 2 {
+		t.Fatalf("FromSuiteResults suites: got %#v", b.Suites)
+	}
+	if b.Suites["s1"].PassRate != 1 || b.Suites["s2"].AvgScore != 0.4 {
+		t.Fatalf("FromSuiteResults values: got %#v", b.Suites)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	b := &Baseline{Suites: map[string]Suite{
+		"s1": {PassRate: 1, AvgScore: 1},
+	}}
+	results := []*runner.SuiteResult{
+		{Suite: "s1", PassRate: 0.8, AvgScore: 1},
+		{Suite: "s2", PassRate: 1, AvgScore: 1},
+		nil,
+	}
+
+	diffs := Compare(b, results, 0.1)
+	if len(diffs) != 2 {
+		t.Fatalf("Compare: got %#v", diffs)
+	}
+
+	var s1, s2 *Diff
+	for i := range diffs {
+		switch diffs[i].Suite {
+		case "s1":
+			s1 = &diffs[i]
+		case "s2":
+			s2 = &diffs[i]
+		}
+	}
+	if s1 == nil || s1.Missing || !s1.Regressed {
+		t.Fatalf("Compare s1: got %#v", s1)
+	}
+	if s2 == nil || !s2.Missing || s2.Regressed {
+		t.Fatalf("Compare s2 (missing from baseline): got %#v", s2)
+	}
+}
+
+func TestCompare_WithinThreshold(t *testing.T) {
+	t.Parallel()
+
+	b := &Baseline{Suites: map[string]Suite{"s1": {PassRate: 1, AvgScore: 1}}}
+	diffs := Compare(b, []*runner.SuiteResult{{Suite: "s1", PassRate: 0.95, AvgScore: 0.95}}, 0.1)
+	if len(diffs) != 1 || diffs[0].Regressed {
+		t.Fatalf("Compare within threshold: got %#v", diffs)
+	}
+}
+
+func TestCompare_NilBaseline(t *testing.T) {
+	t.Parallel()
+
+	diffs := Compare(nil, []*runner.SuiteResult{{Suite: "s1", PassRate: 1, AvgScore: 1}}, 0)
+	if len(diffs) != 1 || !diffs[0].Missing {
+		t.Fatalf("Compare(nil baseline): got %#v", diffs)
+	}
+}
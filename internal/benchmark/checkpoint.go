@@ -0,0 +1,149 @@
+package benchmark
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// CheckpointRecord is one line of a benchmark checkpoint file: a single
+// question's outcome, persisted so a long run can resume after a crash
+// instead of re-evaluating everything from scratch.
+type CheckpointRecord struct {
+	QuestionID string  `json:"question_id"`
+	Response   string  `json:"response"`
+	Score      float64 `json:"score"`
+}
+
+// Checkpoint tracks already-completed questions for a benchmark run,
+// appending each new one to disk as it completes.
+type Checkpoint struct {
+	mu   sync.Mutex
+	file *os.File
+	done map[string]CheckpointRecord
+}
+
+// OpenCheckpoint loads path's existing records and returns a Checkpoint
+// ready to append new ones. If restart is true, any existing checkpoint is
+// discarded (not loaded, and truncated on disk) rather than resumed from.
+// Corrupted lines are skipped with a warning written to warnOut (falling
+// back to os.Stderr when nil) rather than aborting the load.
+func OpenCheckpoint(path string, restart bool, warnOut io.Writer) (*Checkpoint, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, errors.New("benchmark: empty checkpoint path")
+	}
+
+	cp := &Checkpoint{done: make(map[string]CheckpointRecord)}
+
+	if !restart {
+		if err := cp.load(path, warnOut); err != nil {
+			return nil, err
+		}
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if restart {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("benchmark: open checkpoint %q: %w", path, err)
+	}
+	cp.file = f
+	return cp, nil
+}
+
+func (cp *Checkpoint) load(path string, warnOut io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("benchmark: read checkpoint %q: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var rec CheckpointRecord
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			warnCheckpoint(warnOut, "benchmark: checkpoint %q: skipping corrupted line %d: %v", path, lineNo, err)
+			continue
+		}
+		id := strings.TrimSpace(rec.QuestionID)
+		if id == "" {
+			warnCheckpoint(warnOut, "benchmark: checkpoint %q: skipping line %d: missing question_id", path, lineNo)
+			continue
+		}
+		cp.done[id] = rec
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("benchmark: read checkpoint %q: %w", path, err)
+	}
+	return nil
+}
+
+func warnCheckpoint(w io.Writer, format string, args ...any) {
+	if w == nil {
+		w = os.Stderr
+	}
+	fmt.Fprintf(w, format+"\n", args...)
+}
+
+// Get returns the previously-checkpointed record for id, if any.
+func (cp *Checkpoint) Get(id string) (CheckpointRecord, bool) {
+	id = strings.TrimSpace(id)
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	rec, ok := cp.done[id]
+	return rec, ok
+}
+
+// Append persists rec as a new checkpoint line and marks it done for
+// subsequent Get calls in this process.
+func (cp *Checkpoint) Append(rec CheckpointRecord) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if cp.file == nil {
+		return errors.New("benchmark: checkpoint not open for writing")
+	}
+
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("benchmark: marshal checkpoint record: %w", err)
+	}
+	if _, err := cp.file.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("benchmark: write checkpoint: %w", err)
+	}
+
+	if id := strings.TrimSpace(rec.QuestionID); id != "" {
+		cp.done[id] = rec
+	}
+	return nil
+}
+
+// Close closes the underlying checkpoint file.
+func (cp *Checkpoint) Close() error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.file == nil {
+		return nil
+	}
+	return cp.file.Close()
+}
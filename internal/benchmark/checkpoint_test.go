@@ -0,0 +1,145 @@
+package benchmark
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckpoint_AppendAndGet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.jsonl")
+
+	cp, err := OpenCheckpoint(path, false, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	defer cp.Close()
+
+	if _, ok := cp.Get("1"); ok {
+		t.Fatalf("expected no record for unseen id")
+	}
+	if err := cp.Append(CheckpointRecord{QuestionID: "1", Response: "a", Score: 1}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	rec, ok := cp.Get("1")
+	if !ok || rec.Response != "a" || rec.Score != 1 {
+		t.Fatalf("Get(1)=%+v ok=%v, want {a 1} true", rec, ok)
+	}
+}
+
+func TestCheckpoint_ResumesFromExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.jsonl")
+
+	cp1, err := OpenCheckpoint(path, false, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	if err := cp1.Append(CheckpointRecord{QuestionID: "1", Response: "a", Score: 1}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if err := cp1.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	cp2, err := OpenCheckpoint(path, false, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint (resume): %v", err)
+	}
+	defer cp2.Close()
+
+	rec, ok := cp2.Get("1")
+	if !ok || rec.Response != "a" {
+		t.Fatalf("Get(1)=%+v ok=%v, want resumed record", rec, ok)
+	}
+
+	if err := cp2.Append(CheckpointRecord{QuestionID: "2", Response: "b", Score: 0}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+
+	cp3, err := OpenCheckpoint(path, false, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint (resume again): %v", err)
+	}
+	defer cp3.Close()
+	if _, ok := cp3.Get("1"); !ok {
+		t.Fatalf("expected record 1 to survive across reopen")
+	}
+	if _, ok := cp3.Get("2"); !ok {
+		t.Fatalf("expected record 2 (appended after first resume) to survive across reopen")
+	}
+}
+
+func TestCheckpoint_RestartDiscardsExisting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.jsonl")
+
+	cp1, err := OpenCheckpoint(path, false, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	if err := cp1.Append(CheckpointRecord{QuestionID: "1", Response: "a", Score: 1}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if err := cp1.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	cp2, err := OpenCheckpoint(path, true, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint (restart): %v", err)
+	}
+	defer cp2.Close()
+
+	if _, ok := cp2.Get("1"); ok {
+		t.Fatalf("expected --restart to discard the existing record")
+	}
+}
+
+func TestCheckpoint_MissingFileIsNotAnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "does-not-exist.jsonl")
+
+	cp, err := OpenCheckpoint(path, false, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	defer cp.Close()
+
+	if _, ok := cp.Get("1"); ok {
+		t.Fatalf("expected empty checkpoint for missing file")
+	}
+}
+
+func TestCheckpoint_CorruptedLineSkippedWithWarning(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.jsonl")
+	content := `{"question_id":"1","response":"a","score":1}
+not valid json
+{"response":"missing id","score":1}
+{"question_id":"2","response":"b","score":0}
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	var warnings bytes.Buffer
+	cp, err := OpenCheckpoint(path, false, &warnings)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	defer cp.Close()
+
+	if _, ok := cp.Get("1"); !ok {
+		t.Fatalf("expected record 1 to load")
+	}
+	if _, ok := cp.Get("2"); !ok {
+		t.Fatalf("expected record 2 (after the corrupted lines) to load")
+	}
+	if warnings.Len() == 0 {
+		t.Fatalf("expected warnings for corrupted/invalid lines, got none")
+	}
+}
+
+func TestCheckpoint_EmptyPath(t *testing.T) {
+	if _, err := OpenCheckpoint("  ", false, nil); err == nil {
+		t.Fatalf("expected error for empty checkpoint path")
+	}
+}
@@ -2,6 +2,14 @@ package benchmark
 
 import "context"
 
+// Dataset is implemented by each benchmark dataset (MMLU, GSM8K, HumanEval,
+// ...). Load fetches its questions and Evaluate scores a single model
+// response against a question's Answer.
+//
+// A Dataset may additionally implement CodeExecDataset, AnswerFormatter, or
+// ResponseNormalizer to opt into the corresponding behavior; BenchmarkRunner
+// checks for these via type assertion (the same optional-interface pattern
+// as llm.ToolLoopProvider), so most datasets can ignore them entirely.
 type Dataset interface {
 	Name() string
 	Description() string
@@ -9,11 +17,45 @@ type Dataset interface {
 	Evaluate(response string, expected any) (float64, error)
 }
 
-type Question struct {
-	ID       string
-	Question string
-	Choices  []string
-	Answer   any
-	Category string
+// CodeExecDataset is an optional interface for datasets whose Evaluate runs
+// untrusted model-generated code (e.g. HumanEval). It lets BenchmarkRunner
+// reject a run up front when code execution isn't enabled, rather than
+// discovering it only after every question has already failed individually.
+type CodeExecDataset interface {
+	RequiresCodeExec() bool
+}
+
+// AnswerFormat describes the shape of answer a dataset's Evaluate expects, so
+// shared tooling (prompt formatting, reusable matchers) can act on it without
+// a per-dataset name switch.
+type AnswerFormat string
+
+const (
+	AnswerFormatFreeText       AnswerFormat = "free_text"
+	AnswerFormatMultipleChoice AnswerFormat = "multiple_choice"
+	AnswerFormatNumeric        AnswerFormat = "numeric"
+	AnswerFormatCode           AnswerFormat = "code"
+)
+
+// AnswerFormatter is an optional interface for datasets that declare their
+// AnswerFormat, so BenchmarkRunner can pick a matching prompt template
+// instead of switching on Name().
+type AnswerFormatter interface {
+	AnswerFormat() AnswerFormat
 }
 
+// ResponseNormalizer is an optional interface for datasets that need to
+// canonicalize a raw model response (e.g. stripping code fences, collapsing
+// whitespace) before BenchmarkRunner scores it.
+type ResponseNormalizer interface {
+	Normalize(response string) string
+}
+
+type Question struct {
+	ID         string
+	Question   string
+	Choices    []string
+	Answer     any
+	Category   string
+	Difficulty string
+}
@@ -13,14 +13,17 @@ import (
 const defaultGSM8KPath = "data/benchmark/gsm8k.jsonl"
 
 type GSM8KDataset struct {
-	SampleSize int
+	SampleSize     int
+	SampleStrategy SampleStrategy
+	Seed           int64
 }
 
 type gsm8kRow struct {
-	ID       string `json:"id,omitempty"`
-	TaskID   string `json:"task_id,omitempty"`
-	Question string `json:"question"`
-	Answer   string `json:"answer"`
+	ID         string `json:"id,omitempty"`
+	TaskID     string `json:"task_id,omitempty"`
+	Question   string `json:"question"`
+	Answer     string `json:"answer"`
+	Difficulty string `json:"difficulty,omitempty"`
 }
 
 func (d *GSM8KDataset) Name() string { return "gsm8k" }
@@ -29,6 +32,9 @@ func (d *GSM8KDataset) Description() string {
 	return "GSM8K grade-school math word problems"
 }
 
+// AnswerFormat reports that GSM8K expects a numeric response.
+func (d *GSM8KDataset) AnswerFormat() AnswerFormat { return AnswerFormatNumeric }
+
 func (d *GSM8KDataset) Load(ctx context.Context) ([]Question, error) {
 	if ctx == nil {
 		return nil, errors.New("gsm8k: nil context")
@@ -68,14 +74,15 @@ func (d *GSM8KDataset) Load(ctx context.Context) ([]Question, error) {
 
 		expected := extractExpectedNumber(row.Answer)
 		out = append(out, Question{
-			ID:       id,
-			Question: qText,
-			Answer:   expected,
-			Category: "math",
+			ID:         id,
+			Question:   qText,
+			Answer:     expected,
+			Category:   "math",
+			Difficulty: strings.TrimSpace(row.Difficulty),
 		})
 	}
 
-	out = takeFirstN(out, d.SampleSize)
+	out = sampleQuestions(out, d.SampleSize, d.SampleStrategy, d.Seed)
 	if len(out) == 0 {
 		return takeFirstN(defaultGSM8KSample(), d.SampleSize), nil
 	}
@@ -118,40 +125,58 @@ func extractExpectedNumber(answer string) string {
 	return strings.TrimSpace(s)
 }
 
+// extractLastNumber scans backward for the last run of digits/commas/periods
+// in s. A run anchored on a sentence-ending "." (or a lone ",") with no
+// digit in it (e.g. the "." after a trailing unit word like "... 42 feet.")
+// isn't a number; extractLastNumber skips past it and keeps scanning left
+// instead of giving up, so trailing punctuation and unit words never hide
+// the actual number.
 func extractLastNumber(s string) (string, bool) {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return "", false
 	}
 
-	start := -1
-	end := -1
-	for i := len(s) - 1; i >= 0; i-- {
-		c := s[i]
-		if (c >= '0' && c <= '9') || c == '.' || c == ',' {
-			end = i + 1
-			start = i
-			for start > 0 {
-				pc := s[start-1]
-				if (pc >= '0' && pc <= '9') || pc == '.' || pc == ',' || pc == '-' {
-					start--
-					continue
-				}
+	end := len(s)
+	for end > 0 {
+		i := end - 1
+		for i >= 0 {
+			c := s[i]
+			if (c >= '0' && c <= '9') || c == '.' || c == ',' {
 				break
 			}
+			i--
+		}
+		if i < 0 {
+			return "", false
+		}
+
+		start := i
+		hasDigit := s[i] >= '0' && s[i] <= '9'
+		for start > 0 {
+			pc := s[start-1]
+			if pc >= '0' && pc <= '9' {
+				hasDigit = true
+				start--
+				continue
+			}
+			if pc == '.' || pc == ',' || pc == '-' {
+				start--
+				continue
+			}
 			break
 		}
+
+		if hasDigit {
+			raw := strings.ReplaceAll(s[start:i+1], ",", "")
+			raw = strings.Trim(raw, ".")
+			if raw != "" && raw != "-" {
+				return raw, true
+			}
+		}
+		end = start
 	}
-	if start < 0 || end < 0 || start >= end {
-		return "", false
-	}
-	raw := strings.TrimSpace(s[start:end])
-	raw = strings.ReplaceAll(raw, ",", "")
-	raw = strings.Trim(raw, ".")
-	if raw == "" || raw == "-" {
-		return "", false
-	}
-	return raw, true
+	return "", false
 }
 
 func parseFloat(s string) (float64, bool) {
@@ -189,4 +214,3 @@ func defaultGSM8KSample() []Question {
 		},
 	}
 }
-
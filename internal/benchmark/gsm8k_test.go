@@ -22,6 +22,24 @@ func TestGSM8K_Evaluate(t *testing.T) {
 	}
 }
 
+func TestGSM8K_Evaluate_TrailingUnitsAndCommas(t *testing.T) {
+	ds := &GSM8KDataset{}
+
+	score, err := ds.Evaluate("She has 1,234 dollars left.", "1234")
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if score != 1 {
+		t.Fatalf("score: got %v want %v", score, 1)
+	}
+}
+
+func TestGSM8K_AnswerFormat(t *testing.T) {
+	if got := (&GSM8KDataset{}).AnswerFormat(); got != AnswerFormatNumeric {
+		t.Fatalf("AnswerFormat: got %q want %q", got, AnswerFormatNumeric)
+	}
+}
+
 func TestGSM8K_ExtractLastNumber(t *testing.T) {
 	got, ok := extractLastNumber("Total: 1,234.")
 	if !ok {
@@ -31,4 +49,3 @@ func TestGSM8K_ExtractLastNumber(t *testing.T) {
 		t.Fatalf("extractLastNumber: got %q want %q", got, "1234")
 	}
 }
-
@@ -37,7 +37,9 @@ var (
 )
 
 type HumanEvalDataset struct {
-	SampleSize int
+	SampleSize     int
+	SampleStrategy SampleStrategy
+	Seed           int64
 }
 
 type humanEvalRow struct {
@@ -46,6 +48,7 @@ type humanEvalRow struct {
 	Prompt     string `json:"prompt"`
 	Test       string `json:"test"`
 	EntryPoint string `json:"entry_point,omitempty"`
+	Difficulty string `json:"difficulty,omitempty"`
 }
 
 type humanEvalExpected struct {
@@ -60,6 +63,14 @@ func (d *HumanEvalDataset) Description() string {
 	return "HumanEval code generation benchmark (requires local code execution)"
 }
 
+// AnswerFormat reports that HumanEval expects a code response.
+func (d *HumanEvalDataset) AnswerFormat() AnswerFormat { return AnswerFormatCode }
+
+// RequiresCodeExec reports that HumanEval's Evaluate runs model-generated
+// code, so BenchmarkRunner can reject a run up front unless codeExecEnv is
+// set, instead of failing on every question.
+func (d *HumanEvalDataset) RequiresCodeExec() bool { return true }
+
 func (d *HumanEvalDataset) Load(ctx context.Context) ([]Question, error) {
 	if ctx == nil {
 		return nil, errors.New("humaneval: nil context")
@@ -106,11 +117,12 @@ func (d *HumanEvalDataset) Load(ctx context.Context) ([]Question, error) {
 				Test:       test,
 				EntryPoint: strings.TrimSpace(row.EntryPoint),
 			},
-			Category: "code",
+			Category:   "code",
+			Difficulty: strings.TrimSpace(row.Difficulty),
 		})
 	}
 
-	out = takeFirstN(out, d.SampleSize)
+	out = sampleQuestions(out, d.SampleSize, d.SampleStrategy, d.Seed)
 	if len(out) == 0 {
 		return takeFirstN(defaultHumanEvalSample(), d.SampleSize), nil
 	}
@@ -21,6 +21,16 @@ func TestHumanEvalDataset_NameAndDescription(t *testing.T) {
 	}
 }
 
+func TestHumanEvalDataset_AnswerFormatAndCodeExec(t *testing.T) {
+	ds := &HumanEvalDataset{}
+	if got := ds.AnswerFormat(); got != AnswerFormatCode {
+		t.Fatalf("AnswerFormat: got %q want %q", got, AnswerFormatCode)
+	}
+	if !ds.RequiresCodeExec() {
+		t.Fatalf("RequiresCodeExec: got false want true")
+	}
+}
+
 func TestStripCodeFences(t *testing.T) {
 	tests := []struct {
 		in   string
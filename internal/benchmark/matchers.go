@@ -0,0 +1,30 @@
+package benchmark
+
+import "time"
+
+// MatchMultipleChoice scores a free-form model response against a
+// multiple-choice question. expected must be a MultipleChoiceAnswer (or
+// *MultipleChoiceAnswer); response is parsed for a letter, number, or choice
+// text, per parseMCQResponse. New multiple-choice datasets (e.g. ARC) can use
+// this directly instead of reimplementing MMLU's matching logic.
+func MatchMultipleChoice(response string, expected any) (float64, error) {
+	return (&MMLUDataset{}).Evaluate(response, expected)
+}
+
+// MatchNumeric scores a free-form model response against a numeric expected
+// answer. expected is coerced via fmt.Sprint and parsed as a float; response
+// is scored against the last number found in it, within a small epsilon.
+// GSM8K-style datasets (e.g. TruthfulQA's numeric variants) can use this
+// directly instead of reimplementing GSM8K's matching logic.
+func MatchNumeric(response string, expected any) (float64, error) {
+	return (&GSM8KDataset{}).Evaluate(response, expected)
+}
+
+// RunPythonSandbox executes program under the sandboxing configured by
+// AI_EVAL_SANDBOX_MODE (docker by default) and reports whether it exited
+// zero. It's the same sandboxed-execution helper HumanEvalDataset uses,
+// exported so other code-execution datasets don't need to reimplement
+// sandboxing from scratch.
+func RunPythonSandbox(program string, timeout time.Duration) (bool, error) {
+	return runHumanEvalPython(program, timeout)
+}
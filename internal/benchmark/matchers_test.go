@@ -0,0 +1,51 @@
+package benchmark
+
+import "testing"
+
+func TestMatchMultipleChoice(t *testing.T) {
+	expected := MultipleChoiceAnswer{
+		Answer:  "B",
+		Choices: []string{"Earth", "Mars", "Jupiter", "Venus"},
+	}
+
+	score, err := MatchMultipleChoice("Mars", expected)
+	if err != nil {
+		t.Fatalf("MatchMultipleChoice: %v", err)
+	}
+	if score != 1 {
+		t.Fatalf("score: got %v want %v", score, 1)
+	}
+
+	score, err = MatchMultipleChoice("Earth", expected)
+	if err != nil {
+		t.Fatalf("MatchMultipleChoice: %v", err)
+	}
+	if score != 0 {
+		t.Fatalf("score: got %v want %v", score, 0)
+	}
+}
+
+func TestMatchNumeric(t *testing.T) {
+	score, err := MatchNumeric("The answer is 42.", "42")
+	if err != nil {
+		t.Fatalf("MatchNumeric: %v", err)
+	}
+	if score != 1 {
+		t.Fatalf("score: got %v want %v", score, 1)
+	}
+
+	score, err = MatchNumeric("The answer is 41.", "42")
+	if err != nil {
+		t.Fatalf("MatchNumeric: %v", err)
+	}
+	if score != 0 {
+		t.Fatalf("score: got %v want %v", score, 0)
+	}
+}
+
+func TestRunPythonSandbox(t *testing.T) {
+	t.Setenv(sandboxModeEnv, sandboxModeDisabled)
+	if _, err := RunPythonSandbox("print('x')\n", 0); err == nil {
+		t.Fatalf("expected error for disabled sandbox mode")
+	}
+}
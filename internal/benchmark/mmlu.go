@@ -12,21 +12,27 @@ import (
 const defaultMMLUPath = "data/benchmark/mmlu.jsonl"
 
 type MMLUDataset struct {
-	Subjects   []string
-	SampleSize int
+	Subjects       []string
+	SampleSize     int
+	SampleStrategy SampleStrategy
+	Seed           int64
 }
 
 type mmluRow struct {
-	ID       string   `json:"id,omitempty"`
-	TaskID   string   `json:"task_id,omitempty"`
-	Question string   `json:"question"`
-	Choices  []string `json:"choices"`
-	Answer   any      `json:"answer"`
-	Subject  string   `json:"subject,omitempty"`
-	Category string   `json:"category,omitempty"`
+	ID         string   `json:"id,omitempty"`
+	TaskID     string   `json:"task_id,omitempty"`
+	Question   string   `json:"question"`
+	Choices    []string `json:"choices"`
+	Answer     any      `json:"answer"`
+	Subject    string   `json:"subject,omitempty"`
+	Category   string   `json:"category,omitempty"`
+	Difficulty string   `json:"difficulty,omitempty"`
 }
 
-type mcqExpected struct {
+// MultipleChoiceAnswer is the Question.Answer shape expected by MMLUDataset
+// and MatchMultipleChoice: the correct answer (a letter, 1- or 0-based index,
+// or exact choice text) plus the choices it's relative to.
+type MultipleChoiceAnswer struct {
 	Answer  any      `json:"answer"`
 	Choices []string `json:"choices,omitempty"`
 }
@@ -37,6 +43,9 @@ func (d *MMLUDataset) Description() string {
 	return "MMLU (Massive Multitask Language Understanding) multiple-choice benchmark"
 }
 
+// AnswerFormat reports that MMLU expects a multiple-choice response.
+func (d *MMLUDataset) AnswerFormat() AnswerFormat { return AnswerFormatMultipleChoice }
+
 func (d *MMLUDataset) Load(ctx context.Context) ([]Question, error) {
 	if ctx == nil {
 		return nil, errors.New("mmlu: nil context")
@@ -73,7 +82,7 @@ func (d *MMLUDataset) Load(ctx context.Context) ([]Question, error) {
 		}
 
 		choices := compactStrings(row.Choices)
-		expected := mcqExpected{Answer: row.Answer, Choices: choices}
+		expected := MultipleChoiceAnswer{Answer: row.Answer, Choices: choices}
 
 		id := strings.TrimSpace(row.ID)
 		if id == "" {
@@ -89,15 +98,16 @@ func (d *MMLUDataset) Load(ctx context.Context) ([]Question, error) {
 		}
 
 		out = append(out, Question{
-			ID:       id,
-			Question: qText,
-			Choices:  choices,
-			Answer:   expected,
-			Category: category,
+			ID:         id,
+			Question:   qText,
+			Choices:    choices,
+			Answer:     expected,
+			Category:   category,
+			Difficulty: strings.TrimSpace(row.Difficulty),
 		})
 	}
 
-	out = takeFirstN(out, d.SampleSize)
+	out = sampleQuestions(out, d.SampleSize, d.SampleStrategy, d.Seed)
 	if len(out) == 0 {
 		return takeFirstN(defaultMMLUSample(), d.SampleSize), nil
 	}
@@ -123,9 +133,9 @@ func (d *MMLUDataset) Evaluate(response string, expected any) (float64, error) {
 
 func unwrapMCQExpected(expected any) (any, []string) {
 	switch v := expected.(type) {
-	case mcqExpected:
+	case MultipleChoiceAnswer:
 		return v.Answer, v.Choices
-	case *mcqExpected:
+	case *MultipleChoiceAnswer:
 		if v == nil {
 			return nil, nil
 		}
@@ -340,21 +350,21 @@ func defaultMMLUSample() []Question {
 			Category: "misc",
 			Question: "Which planet is known as the Red Planet?",
 			Choices:  []string{"Earth", "Mars", "Jupiter", "Venus"},
-			Answer:   mcqExpected{Answer: "B", Choices: []string{"Earth", "Mars", "Jupiter", "Venus"}},
+			Answer:   MultipleChoiceAnswer{Answer: "B", Choices: []string{"Earth", "Mars", "Jupiter", "Venus"}},
 		},
 		{
 			ID:       "mmlu-sample-2",
 			Category: "math",
 			Question: "What is 7 * 6?",
 			Choices:  []string{"36", "40", "42", "48"},
-			Answer:   mcqExpected{Answer: "C", Choices: []string{"36", "40", "42", "48"}},
+			Answer:   MultipleChoiceAnswer{Answer: "C", Choices: []string{"36", "40", "42", "48"}},
 		},
 		{
 			ID:       "mmlu-sample-3",
 			Category: "science",
 			Question: "Water boils at what temperature at sea level (Celsius)?",
 			Choices:  []string{"50", "75", "100", "125"},
-			Answer:   mcqExpected{Answer: "C", Choices: []string{"50", "75", "100", "125"}},
+			Answer:   MultipleChoiceAnswer{Answer: "C", Choices: []string{"50", "75", "100", "125"}},
 		},
 	}
 }
@@ -91,7 +91,7 @@ func TestMMLUDataset_Load_FromFile_FilterAndDefaults(t *testing.T) {
 		t.Fatalf("choices=%#v", out[0].Choices)
 	}
 
-	exp, ok := out[0].Answer.(mcqExpected)
+	exp, ok := out[0].Answer.(MultipleChoiceAnswer)
 	if !ok {
 		t.Fatalf("answer type=%T", out[0].Answer)
 	}
@@ -169,19 +169,19 @@ func TestMMLU_HelperFunctions(t *testing.T) {
 	}
 
 	{
-		a, c := unwrapMCQExpected(mcqExpected{Answer: "B", Choices: choices})
+		a, c := unwrapMCQExpected(MultipleChoiceAnswer{Answer: "B", Choices: choices})
 		if a != "B" || len(c) != 4 {
 			t.Fatalf("unwrap=%v/%v", a, c)
 		}
 	}
 	{
-		a, c := unwrapMCQExpected(&mcqExpected{Answer: "A", Choices: choices})
+		a, c := unwrapMCQExpected(&MultipleChoiceAnswer{Answer: "A", Choices: choices})
 		if a != "A" || len(c) != 4 {
 			t.Fatalf("unwrap ptr=%v/%v", a, c)
 		}
 	}
 	{
-		a, c := unwrapMCQExpected((*mcqExpected)(nil))
+		a, c := unwrapMCQExpected((*MultipleChoiceAnswer)(nil))
 		if a != nil || c != nil {
 			t.Fatalf("unwrap nil ptr=%v/%v", a, c)
 		}
@@ -275,19 +275,19 @@ func TestMMLUDataset_Evaluate_ErrorPaths(t *testing.T) {
 	ds := &MMLUDataset{}
 
 	{
-		_, err := ds.Evaluate("A", mcqExpected{Answer: true})
+		_, err := ds.Evaluate("A", MultipleChoiceAnswer{Answer: true})
 		if err == nil {
 			t.Fatalf("expected error")
 		}
 	}
 	{
-		_, err := ds.Evaluate("", mcqExpected{Answer: "A"})
+		_, err := ds.Evaluate("", MultipleChoiceAnswer{Answer: "A"})
 		if err == nil {
 			t.Fatalf("expected error")
 		}
 	}
 	{
-		_, err := ds.Evaluate("A", mcqExpected{Answer: ""})
+		_, err := ds.Evaluate("A", MultipleChoiceAnswer{Answer: ""})
 		if err == nil {
 			t.Fatalf("expected error")
 		}
@@ -27,9 +27,15 @@ func TestMMLU_ParseMCQResponse(t *testing.T) {
 	}
 }
 
+func TestMMLU_AnswerFormat(t *testing.T) {
+	if got := (&MMLUDataset{}).AnswerFormat(); got != AnswerFormatMultipleChoice {
+		t.Fatalf("AnswerFormat: got %q want %q", got, AnswerFormatMultipleChoice)
+	}
+}
+
 func TestMMLU_Evaluate(t *testing.T) {
 	ds := &MMLUDataset{}
-	expected := mcqExpected{
+	expected := MultipleChoiceAnswer{
 		Answer:  "B",
 		Choices: []string{"Earth", "Mars", "Jupiter", "Venus"},
 	}
@@ -50,4 +56,3 @@ func TestMMLU_Evaluate(t *testing.T) {
 		t.Fatalf("score: got %v want %v", score, 0)
 	}
 }
-
@@ -0,0 +1,59 @@
+package benchmark
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// tokenBucket rate-limits calls to at most qps per second, allowing bursts up
+// to its capacity so a pool of idle workers isn't serialized purely by the
+// limiter the moment they all have work ready.
+type tokenBucket struct {
+	mu     sync.Mutex
+	tokens float64
+	burst  float64
+	qps    float64
+	last   time.Time
+}
+
+// newTokenBucket returns a limiter allowing qps calls per second, with a
+// burst capacity of burst tokens (at least 1).
+func newTokenBucket(qps float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		tokens: float64(burst),
+		burst:  float64(burst),
+		qps:    qps,
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done, whichever comes
+// first.
+func (tb *tokenBucket) wait(ctx context.Context) error {
+	for {
+		tb.mu.Lock()
+		now := time.Now()
+		tb.tokens = math.Min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.qps)
+		tb.last = now
+		if tb.tokens >= 1 {
+			tb.tokens--
+			tb.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - tb.tokens) / tb.qps * float64(time.Second))
+		tb.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
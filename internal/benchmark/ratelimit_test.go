@@ -0,0 +1,34 @@
+package benchmark
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_BurstThenThrottles(t *testing.T) {
+	tb := newTokenBucket(1000, 2)
+	ctx := context.Background()
+
+	// Burst capacity of 2 should be available immediately.
+	for i := 0; i < 2; i++ {
+		if err := tb.wait(ctx); err != nil {
+			t.Fatalf("wait(%d): %v", i, err)
+		}
+	}
+}
+
+func TestTokenBucket_WaitRespectsContext(t *testing.T) {
+	tb := newTokenBucket(0.001, 1)
+	// Drain the single burst token.
+	if err := tb.wait(context.Background()); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := tb.wait(ctx); err == nil {
+		t.Fatalf("expected context deadline error, got nil")
+	}
+}
@@ -3,7 +3,9 @@ package benchmark
 import (
 	"context"
 	"errors"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/stellarlinkco/ai-eval/internal/leaderboard"
@@ -13,6 +15,30 @@ import (
 type BenchmarkRunner struct {
 	Provider llm.Provider
 	Store    *leaderboard.Store
+
+	// Warmup is the number of throwaway provider calls issued before timed
+	// evaluation begins, to absorb cold-start latency (e.g. connection setup,
+	// provider-side cold caches) so the recorded latency reflects
+	// steady-state performance. These calls are excluded from Score,
+	// TotalTime, and TotalTokens. Zero (the default) skips warm-up entirely.
+	Warmup int
+
+	// Concurrency is the number of questions evaluated against Provider at
+	// once. Zero or one runs questions sequentially, identical to issuing
+	// them one at a time.
+	Concurrency int
+
+	// QPS caps the rate of Provider.CompleteWithTools calls across all
+	// workers, via a token bucket with a burst equal to Concurrency. Zero (the
+	// default) leaves the rate unbounded.
+	QPS float64
+
+	// Checkpoint, if set, is consulted before evaluating each question (a hit
+	// skips calling Provider entirely, reusing the checkpointed response and
+	// score) and appended to after every question that does run, so an
+	// interrupted run can resume without re-paying for already-completed
+	// questions.
+	Checkpoint *Checkpoint
 }
 
 type BenchmarkResult struct {
@@ -28,6 +54,7 @@ type BenchmarkResult struct {
 type QuestionResult struct {
 	ID       string
 	Category string
+	Response string
 	Score    float64
 	Passed   bool
 	Latency  time.Duration
@@ -48,8 +75,9 @@ func (r *BenchmarkRunner) Run(ctx context.Context, dataset Dataset) (*BenchmarkR
 	if dataset == nil {
 		return nil, errors.New("benchmark: nil dataset")
 	}
-
-	start := time.Now()
+	if ce, ok := dataset.(CodeExecDataset); ok && ce.RequiresCodeExec() && strings.TrimSpace(os.Getenv(codeExecEnv)) != "1" {
+		return nil, errHumanEvalCodeExecDisabled
+	}
 
 	qs, err := dataset.Load(ctx)
 	if err != nil {
@@ -59,67 +87,245 @@ func (r *BenchmarkRunner) Run(ctx context.Context, dataset Dataset) (*BenchmarkR
 		return nil, errors.New("benchmark: empty dataset")
 	}
 
+	if r.Warmup > 0 {
+		r.warmUp(ctx, dataset, &qs[0])
+	}
+
+	start := time.Now()
+
 	out := &BenchmarkResult{
 		Model:   strings.TrimSpace(r.Provider.Name()),
 		Dataset: strings.TrimSpace(dataset.Name()),
-		Results: make([]QuestionResult, 0, len(qs)),
 	}
 
+	concurrency := r.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var limiter *tokenBucket
+	if r.QPS > 0 {
+		limiter = newTokenBucket(r.QPS, concurrency)
+	}
+
+	var (
+		results     []QuestionResult
+		sumScore    float64
+		totalTokens int
+		runErr      error
+	)
+	if concurrency == 1 {
+		results, sumScore, totalTokens, runErr = r.runSequential(ctx, dataset, qs, limiter)
+	} else {
+		results, sumScore, totalTokens, runErr = r.runPooled(ctx, dataset, qs, limiter, concurrency)
+	}
+
+	out.Results = results
+	out.TotalTime = time.Since(start)
+	out.TotalTokens = totalTokens
+	out.Score = safeAvg(sumScore, len(results))
+	out.Accuracy = out.Score
+	return out, runErr
+}
+
+// runSequential evaluates qs against Provider one at a time, in order. It
+// stops (without evaluating the rest) as soon as ctx is done, returning
+// whatever it completed so far.
+func (r *BenchmarkRunner) runSequential(ctx context.Context, dataset Dataset, qs []Question, limiter *tokenBucket) ([]QuestionResult, float64, int, error) {
+	results := make([]QuestionResult, 0, len(qs))
 	var sumScore float64
 	totalTokens := 0
 
 	for _, q := range qs {
 		if err := ctx.Err(); err != nil {
-			out.TotalTime = time.Since(start)
-			out.TotalTokens = totalTokens
-			out.Score = safeAvg(sumScore, len(out.Results))
-			out.Accuracy = out.Score
-			return out, err
+			return results, sumScore, totalTokens, err
 		}
-
-		prompt := formatPrompt(dataset.Name(), &q)
-		req := &llm.Request{
-			Messages:    []llm.Message{{Role: "user", Content: prompt}},
-			MaxTokens:   1024,
-			Temperature: 0,
+		if r.Checkpoint != nil {
+			if rec, ok := r.Checkpoint.Get(q.ID); ok {
+				rr := checkpointedResult(q, rec)
+				sumScore += rr.Score
+				totalTokens += rr.Tokens
+				results = append(results, rr)
+				continue
+			}
+		}
+		if limiter != nil {
+			if err := limiter.wait(ctx); err != nil {
+				return results, sumScore, totalTokens, err
+			}
 		}
 
-		res, callErr := r.Provider.CompleteWithTools(ctx, req)
+		rr := r.evalOrResume(ctx, dataset, q)
+		sumScore += rr.Score
+		totalTokens += rr.Tokens
+		results = append(results, rr)
+	}
 
-		rr := QuestionResult{
-			ID:       strings.TrimSpace(q.ID),
-			Category: strings.TrimSpace(q.Category),
-		}
+	return results, sumScore, totalTokens, nil
+}
 
-		var response string
-		if res != nil {
-			response = res.TextContent
-			rr.Latency = time.Duration(res.LatencyMs) * time.Millisecond
-			rr.Tokens = res.InputTokens + res.OutputTokens
-			totalTokens += rr.Tokens
+// runPooled fans qs out over concurrency workers, each waiting on limiter (if
+// set) before calling Provider. Every question is written into its own slot
+// by index, so the final results preserve question order regardless of which
+// worker finished first. A canceled ctx stops workers from starting new
+// calls promptly; questions that were never started (or were mid-flight when
+// ctx was canceled and never wrote a result) are simply absent from the
+// returned slice, so accuracy is computed only over what was actually
+// evaluated.
+func (r *BenchmarkRunner) runPooled(ctx context.Context, dataset Dataset, qs []Question, limiter *tokenBucket, concurrency int) ([]QuestionResult, float64, int, error) {
+	slots := make([]*QuestionResult, len(qs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := range qs {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
 		}
-		if callErr != nil {
-			rr.Error = callErr.Error()
-			out.Results = append(out.Results, rr)
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, q Question) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if r.Checkpoint != nil {
+				if rec, ok := r.Checkpoint.Get(q.ID); ok {
+					rr := checkpointedResult(q, rec)
+					slots[i] = &rr
+					return
+				}
+			}
+			if limiter != nil {
+				if err := limiter.wait(ctx); err != nil {
+					return
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			rr := r.evalOrResume(ctx, dataset, q)
+			slots[i] = &rr
+		}(i, qs[i])
+	}
+	wg.Wait()
+
+	results := make([]QuestionResult, 0, len(qs))
+	var sumScore float64
+	totalTokens := 0
+	for _, rr := range slots {
+		if rr == nil {
 			continue
 		}
+		results = append(results, *rr)
+		sumScore += rr.Score
+		totalTokens += rr.Tokens
+	}
+
+	return results, sumScore, totalTokens, ctx.Err()
+}
+
+// evalQuestion sends q to Provider and scores the response against dataset,
+// recording any provider or evaluation error on the returned QuestionResult
+// rather than propagating it, so one bad question doesn't abort the run.
+func (r *BenchmarkRunner) evalQuestion(ctx context.Context, dataset Dataset, q Question) QuestionResult {
+	prompt := formatPrompt(promptAnswerFormat(dataset), &q)
+	req := &llm.Request{
+		Messages:    []llm.Message{{Role: "user", Content: prompt}},
+		MaxTokens:   1024,
+		Temperature: 0,
+	}
+
+	res, callErr := r.Provider.CompleteWithTools(ctx, req)
+
+	rr := QuestionResult{
+		ID:       strings.TrimSpace(q.ID),
+		Category: strings.TrimSpace(q.Category),
+	}
 
-		score, evalErr := dataset.Evaluate(response, q.Answer)
-		if evalErr != nil {
-			rr.Error = evalErr.Error()
+	var response string
+	if res != nil {
+		response = res.TextContent
+		rr.Latency = time.Duration(res.LatencyMs) * time.Millisecond
+		rr.Tokens = res.InputTokens + res.OutputTokens
+	}
+	if callErr != nil {
+		rr.Error = callErr.Error()
+		return rr
+	}
+
+	if n, ok := dataset.(ResponseNormalizer); ok {
+		response = n.Normalize(response)
+	}
+
+	score, evalErr := dataset.Evaluate(response, q.Answer)
+	if evalErr != nil {
+		rr.Error = evalErr.Error()
+	}
+	rr.Response = response
+	rr.Score = score
+	rr.Passed = score >= 1.0-1e-9
+	return rr
+}
+
+// checkpointedResult builds a QuestionResult directly from a checkpointed
+// record, without calling Provider. Latency and Tokens are left zero since
+// they weren't persisted to the checkpoint.
+func checkpointedResult(q Question, rec CheckpointRecord) QuestionResult {
+	return QuestionResult{
+		ID:       strings.TrimSpace(q.ID),
+		Category: strings.TrimSpace(q.Category),
+		Response: rec.Response,
+		Score:    rec.Score,
+		Passed:   rec.Score >= 1.0-1e-9,
+	}
+}
+
+// evalOrResume returns the checkpointed result for q if one exists,
+// otherwise evaluates it against Provider and (if r.Checkpoint is set)
+// appends the outcome so a future run can resume past it.
+func (r *BenchmarkRunner) evalOrResume(ctx context.Context, dataset Dataset, q Question) QuestionResult {
+	if r.Checkpoint != nil {
+		if rec, ok := r.Checkpoint.Get(q.ID); ok {
+			return checkpointedResult(q, rec)
 		}
-		rr.Score = score
-		rr.Passed = score >= 1.0-1e-9
+	}
 
-		sumScore += score
-		out.Results = append(out.Results, rr)
+	rr := r.evalQuestion(ctx, dataset, q)
+
+	if r.Checkpoint != nil {
+		if err := r.Checkpoint.Append(CheckpointRecord{QuestionID: rr.ID, Response: rr.Response, Score: rr.Score}); err != nil && rr.Error == "" {
+			rr.Error = err.Error()
+		}
 	}
 
-	out.TotalTime = time.Since(start)
-	out.TotalTokens = totalTokens
-	out.Score = safeAvg(sumScore, len(out.Results))
-	out.Accuracy = out.Score
-	return out, nil
+	return rr
+}
+
+// warmUp issues r.Warmup throwaway calls against the provider using the
+// dataset's first question, to absorb cold-start latency before the timed
+// loop begins. Responses and errors are discarded; a warm-up call that fails
+// (e.g. transient network error) still counts toward the requested count
+// rather than being retried.
+func (r *BenchmarkRunner) warmUp(ctx context.Context, dataset Dataset, q *Question) {
+	prompt := formatPrompt(promptAnswerFormat(dataset), q)
+	req := &llm.Request{
+		Messages:    []llm.Message{{Role: "user", Content: prompt}},
+		MaxTokens:   1024,
+		Temperature: 0,
+	}
+
+	for i := 0; i < r.Warmup; i++ {
+		if ctx.Err() != nil {
+			return
+		}
+		_, _ = r.Provider.CompleteWithTools(ctx, req)
+	}
 }
 
 func safeAvg(sum float64, n int) float64 {
@@ -129,18 +335,26 @@ func safeAvg(sum float64, n int) float64 {
 	return sum / float64(n)
 }
 
-func formatPrompt(datasetName string, q *Question) string {
+// promptAnswerFormat returns dataset's declared AnswerFormat, or
+// AnswerFormatFreeText for datasets that don't implement AnswerFormatter.
+func promptAnswerFormat(dataset Dataset) AnswerFormat {
+	if af, ok := dataset.(AnswerFormatter); ok {
+		return af.AnswerFormat()
+	}
+	return AnswerFormatFreeText
+}
+
+func formatPrompt(format AnswerFormat, q *Question) string {
 	if q == nil {
 		return ""
 	}
 
-	name := strings.ToLower(strings.TrimSpace(datasetName))
-	switch name {
-	case "mmlu":
+	switch format {
+	case AnswerFormatMultipleChoice:
 		return formatMCQPrompt(q.Question, q.Choices)
-	case "gsm8k":
+	case AnswerFormatNumeric:
 		return "Solve the following math problem. Reply with only the final numeric answer.\n\n" + strings.TrimSpace(q.Question) + "\n"
-	case "humaneval":
+	case AnswerFormatCode:
 		return "Write code to solve the following. Reply with code only.\n\n" + strings.TrimSpace(q.Question) + "\n"
 	default:
 		if len(q.Choices) > 0 {
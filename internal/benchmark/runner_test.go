@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -51,6 +52,25 @@ func (d *fakeDataset) Evaluate(response string, expected any) (float64, error) {
 	return d.eval(response, expected)
 }
 
+type fakeCodeExecDataset struct {
+	fakeDataset
+	requiresCodeExec bool
+}
+
+func (d *fakeCodeExecDataset) RequiresCodeExec() bool { return d.requiresCodeExec }
+
+type fakeNormalizingDataset struct {
+	fakeDataset
+	normalize func(response string) string
+}
+
+func (d *fakeNormalizingDataset) Normalize(response string) string {
+	if d.normalize == nil {
+		return response
+	}
+	return d.normalize(response)
+}
+
 func TestBenchmarkRunner_Run_Errors(t *testing.T) {
 	ctx := context.Background()
 	ds := &fakeDataset{name: "x", load: func(ctx context.Context) ([]Question, error) {
@@ -88,6 +108,73 @@ func TestBenchmarkRunner_Run_Errors(t *testing.T) {
 	}
 }
 
+func TestBenchmarkRunner_Run_RequiresCodeExec(t *testing.T) {
+	ctx := context.Background()
+	provider := &fakeProvider{name: "p"}
+	ds := &fakeCodeExecDataset{
+		fakeDataset: fakeDataset{name: "x", load: func(ctx context.Context) ([]Question, error) {
+			_ = ctx
+			return []Question{{ID: "1", Question: "q", Answer: "a"}}, nil
+		}},
+		requiresCodeExec: true,
+	}
+
+	t.Run("rejected up front when disabled", func(t *testing.T) {
+		t.Setenv(codeExecEnv, "")
+		r := &BenchmarkRunner{Provider: provider}
+		_, err := r.Run(ctx, ds)
+		if !errors.Is(err, errHumanEvalCodeExecDisabled) {
+			t.Fatalf("err=%v", err)
+		}
+	})
+
+	t.Run("allowed when enabled", func(t *testing.T) {
+		t.Setenv(codeExecEnv, "1")
+		r := &BenchmarkRunner{Provider: &fakeProvider{name: "p", fn: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			return &llm.EvalResult{TextContent: "a"}, nil
+		}}}
+		ds.eval = func(response string, expected any) (float64, error) { return 1, nil }
+		res, err := r.Run(ctx, ds)
+		if err != nil {
+			t.Fatalf("err=%v", err)
+		}
+		if res.Score != 1 {
+			t.Fatalf("Score=%v", res.Score)
+		}
+	})
+}
+
+func TestBenchmarkRunner_Run_Normalizes(t *testing.T) {
+	ctx := context.Background()
+	var gotResponse string
+
+	ds := &fakeNormalizingDataset{
+		fakeDataset: fakeDataset{
+			name: "x",
+			load: func(ctx context.Context) ([]Question, error) {
+				_ = ctx
+				return []Question{{ID: "1", Question: "q", Answer: "a"}}, nil
+			},
+			eval: func(response string, expected any) (float64, error) {
+				gotResponse = response
+				return 1, nil
+			},
+		},
+		normalize: func(response string) string { return strings.ToUpper(response) },
+	}
+
+	r := &BenchmarkRunner{Provider: &fakeProvider{name: "p", fn: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		return &llm.EvalResult{TextContent: "abc"}, nil
+	}}}
+
+	if _, err := r.Run(ctx, ds); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if gotResponse != "ABC" {
+		t.Fatalf("gotResponse=%q", gotResponse)
+	}
+}
+
 func TestBenchmarkRunner_Run_LoadErrorAndEmptyDataset(t *testing.T) {
 	ctx := context.Background()
 	provider := &fakeProvider{name: "p"}
@@ -242,43 +329,58 @@ func TestFormatPrompt(t *testing.T) {
 	q := &Question{Question: " Q ", Choices: []string{"c1", "c2"}}
 
 	{
-		got := formatPrompt("mmlu", q)
+		got := formatPrompt(AnswerFormatMultipleChoice, q)
 		if !strings.Contains(got, "multiple-choice") || !strings.Contains(got, "A. c1") {
-			t.Fatalf("mmlu=%q", got)
+			t.Fatalf("multiple_choice=%q", got)
 		}
 	}
 	{
-		got := formatPrompt("gsm8k", q)
+		got := formatPrompt(AnswerFormatNumeric, q)
 		if !strings.Contains(got, "Solve the following math problem") || !strings.Contains(got, "Q") {
-			t.Fatalf("gsm8k=%q", got)
+			t.Fatalf("numeric=%q", got)
 		}
 	}
 	{
-		got := formatPrompt("humaneval", q)
+		got := formatPrompt(AnswerFormatCode, q)
 		if !strings.Contains(got, "Write code") || !strings.Contains(got, "Q") {
-			t.Fatalf("humaneval=%q", got)
+			t.Fatalf("code=%q", got)
 		}
 	}
 	{
-		got := formatPrompt("unknown", q)
+		got := formatPrompt(AnswerFormatFreeText, q)
 		if !strings.Contains(got, "multiple-choice") || !strings.Contains(got, "Reply with just the letter") {
-			t.Fatalf("unknown with choices=%q", got)
+			t.Fatalf("free_text with choices=%q", got)
 		}
 	}
 	{
-		got := formatPrompt("unknown", &Question{Question: "X"})
+		got := formatPrompt(AnswerFormatFreeText, &Question{Question: "X"})
 		if got != "X\n" {
-			t.Fatalf("unknown no choices=%q", got)
+			t.Fatalf("free_text no choices=%q", got)
 		}
 	}
 	{
-		got := formatPrompt("mmlu", nil)
+		got := formatPrompt(AnswerFormatMultipleChoice, nil)
 		if got != "" {
 			t.Fatalf("nil question=%q", got)
 		}
 	}
 }
 
+func TestPromptAnswerFormat(t *testing.T) {
+	if got := promptAnswerFormat(&MMLUDataset{}); got != AnswerFormatMultipleChoice {
+		t.Fatalf("mmlu: got %q", got)
+	}
+	if got := promptAnswerFormat(&GSM8KDataset{}); got != AnswerFormatNumeric {
+		t.Fatalf("gsm8k: got %q", got)
+	}
+	if got := promptAnswerFormat(&HumanEvalDataset{}); got != AnswerFormatCode {
+		t.Fatalf("humaneval: got %q", got)
+	}
+	if got := promptAnswerFormat(&fakeDataset{name: "x"}); got != AnswerFormatFreeText {
+		t.Fatalf("fakeDataset: got %q", got)
+	}
+}
+
 func TestFormatMCQPrompt(t *testing.T) {
 	got := formatMCQPrompt("Q", []string{"A1", "A2"})
 	if !strings.Contains(got, "A. A1") || !strings.Contains(got, "B. A2") {
@@ -289,6 +391,52 @@ func TestFormatMCQPrompt(t *testing.T) {
 	}
 }
 
+func TestBenchmarkRunner_Run_Warmup(t *testing.T) {
+	ctx := context.Background()
+
+	calls := 0
+	provider := &fakeProvider{
+		name: "p",
+		fn: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			_ = ctx
+			_ = req
+			calls++
+			return &llm.EvalResult{TextContent: "response", LatencyMs: 1, InputTokens: 5, OutputTokens: 5}, nil
+		},
+	}
+
+	ds := &fakeDataset{
+		name: "d",
+		load: func(ctx context.Context) ([]Question, error) {
+			_ = ctx
+			return []Question{{ID: "1", Question: "q1", Answer: "a1"}}, nil
+		},
+		eval: func(response string, expected any) (float64, error) {
+			_ = response
+			_ = expected
+			return 1, nil
+		},
+	}
+
+	r := &BenchmarkRunner{Provider: provider, Warmup: 3}
+	res, err := r.Run(ctx, ds)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if calls != 4 {
+		t.Fatalf("calls=%d, want 3 warm-up + 1 timed", calls)
+	}
+	if len(res.Results) != 1 {
+		t.Fatalf("Results=%#v", res.Results)
+	}
+	if res.TotalTokens != 10 {
+		t.Fatalf("TotalTokens=%d, warm-up calls must not count", res.TotalTokens)
+	}
+	if res.Score != 1 || res.Accuracy != 1 {
+		t.Fatalf("Score/Accuracy=%v/%v", res.Score, res.Accuracy)
+	}
+}
+
 func TestBenchmarkRunner_Run_ContextCanceledDuringLoop(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -349,3 +497,223 @@ func TestBenchmarkRunner_Run_ContextCanceledDuringLoop(t *testing.T) {
 		t.Fatalf("TotalTime=%v", res.TotalTime)
 	}
 }
+
+func TestBenchmarkRunner_Run_ConcurrencyPreservesOrder(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	provider := &fakeProvider{
+		name: "p",
+		fn: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			_ = ctx
+			mu.Lock()
+			calls[req.Messages[0].Content]++
+			mu.Unlock()
+			return &llm.EvalResult{TextContent: req.Messages[0].Content, LatencyMs: 1, InputTokens: 1, OutputTokens: 1}, nil
+		},
+	}
+
+	n := 20
+	qs := make([]Question, n)
+	for i := range qs {
+		qs[i] = Question{ID: strings.Repeat("q", i+1), Question: strings.Repeat("q", i+1), Answer: strings.Repeat("q", i+1)}
+	}
+
+	ds := &fakeDataset{
+		name: "d",
+		load: func(ctx context.Context) ([]Question, error) {
+			_ = ctx
+			return qs, nil
+		},
+		eval: func(response string, expected any) (float64, error) {
+			if strings.TrimRight(response, "\n") == expected.(string) {
+				return 1, nil
+			}
+			return 0, nil
+		},
+	}
+
+	r := &BenchmarkRunner{Provider: provider, Concurrency: 8}
+	res, err := r.Run(ctx, ds)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(res.Results) != n {
+		t.Fatalf("Results len=%d, want %d", len(res.Results), n)
+	}
+	for i, rr := range res.Results {
+		if rr.ID != qs[i].ID {
+			t.Fatalf("Results[%d].ID=%q, want %q (order not preserved)", i, rr.ID, qs[i].ID)
+		}
+	}
+	if res.Score != 1 || res.Accuracy != 1 {
+		t.Fatalf("Score/Accuracy=%v/%v", res.Score, res.Accuracy)
+	}
+}
+
+func TestBenchmarkRunner_Run_ConcurrencyStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	called := 0
+	provider := &fakeProvider{
+		name: "p",
+		fn: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			_ = ctx
+			_ = req
+			mu.Lock()
+			called++
+			n := called
+			mu.Unlock()
+			if n == 1 {
+				cancel()
+			}
+			return &llm.EvalResult{TextContent: "ok", LatencyMs: 1, InputTokens: 1, OutputTokens: 1}, nil
+		},
+	}
+
+	qs := make([]Question, 50)
+	for i := range qs {
+		qs[i] = Question{ID: "q", Question: "q", Answer: "a"}
+	}
+	ds := &fakeDataset{
+		name: "d",
+		load: func(ctx context.Context) ([]Question, error) {
+			_ = ctx
+			return qs, nil
+		},
+		eval: func(response string, expected any) (float64, error) {
+			_ = response
+			_ = expected
+			return 1, nil
+		},
+	}
+
+	r := &BenchmarkRunner{Provider: provider, Concurrency: 4}
+	res, err := r.Run(ctx, ds)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err=%v", err)
+	}
+	if res == nil {
+		t.Fatalf("nil result")
+	}
+	if len(res.Results) >= len(qs) {
+		t.Fatalf("expected cancellation to stop workers before all %d questions ran, got %d", len(qs), len(res.Results))
+	}
+}
+
+func TestBenchmarkRunner_Run_QPSLimitsRate(t *testing.T) {
+	ctx := context.Background()
+
+	provider := &fakeProvider{
+		name: "p",
+		fn: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			_ = ctx
+			_ = req
+			return &llm.EvalResult{TextContent: "ok", LatencyMs: 1, InputTokens: 1, OutputTokens: 1}, nil
+		},
+	}
+
+	qs := []Question{
+		{ID: "1", Question: "q1", Answer: "a"},
+		{ID: "2", Question: "q2", Answer: "a"},
+		{ID: "3", Question: "q3", Answer: "a"},
+	}
+	ds := &fakeDataset{
+		name: "d",
+		load: func(ctx context.Context) ([]Question, error) {
+			_ = ctx
+			return qs, nil
+		},
+		eval: func(response string, expected any) (float64, error) {
+			_ = response
+			_ = expected
+			return 1, nil
+		},
+	}
+
+	r := &BenchmarkRunner{Provider: provider, Concurrency: 3, QPS: 20}
+	start := time.Now()
+	res, err := r.Run(ctx, ds)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(res.Results) != 3 {
+		t.Fatalf("Results len=%d", len(res.Results))
+	}
+	// Burst is 3 (== Concurrency), so all 3 calls fit in the initial burst
+	// and should complete quickly rather than being spread out at 20/s.
+	if elapsed > time.Second {
+		t.Fatalf("elapsed=%v, expected burst to avoid throttling 3 calls", elapsed)
+	}
+}
+
+func TestBenchmarkRunner_Run_ChecksCheckpointBeforeCallingProvider(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/checkpoint.jsonl"
+
+	cp, err := OpenCheckpoint(path, false, nil)
+	if err != nil {
+		t.Fatalf("OpenCheckpoint: %v", err)
+	}
+	if err := cp.Append(CheckpointRecord{QuestionID: "1", Response: "cached", Score: 1}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+
+	ctx := context.Background()
+	var mu sync.Mutex
+	called := map[string]int{}
+	provider := &fakeProvider{
+		name: "p",
+		fn: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			_ = ctx
+			mu.Lock()
+			called[req.Messages[0].Content]++
+			mu.Unlock()
+			return &llm.EvalResult{TextContent: "fresh", LatencyMs: 1, InputTokens: 1, OutputTokens: 1}, nil
+		},
+	}
+	qs := []Question{
+		{ID: "1", Question: "q1", Answer: "a"},
+		{ID: "2", Question: "q2", Answer: "a"},
+	}
+	ds := &fakeDataset{
+		name: "d",
+		load: func(ctx context.Context) ([]Question, error) {
+			_ = ctx
+			return qs, nil
+		},
+		eval: func(response string, expected any) (float64, error) {
+			_ = expected
+			if response == "fresh" {
+				return 1, nil
+			}
+			return 0, nil
+		},
+	}
+
+	r := &BenchmarkRunner{Provider: provider, Checkpoint: cp}
+	res, err := r.Run(ctx, ds)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if called["q1\n"] != 0 {
+		t.Fatalf("expected checkpointed question 1 to skip the provider, got %d calls", called["q1\n"])
+	}
+	if called["q2\n"] != 1 {
+		t.Fatalf("expected fresh question 2 to call the provider once, got %d", called["q2\n"])
+	}
+	if res.Results[0].Response != "cached" || res.Results[0].Score != 1 {
+		t.Fatalf("Results[0]=%+v, want checkpointed cached/1", res.Results[0])
+	}
+	if res.Results[1].Response != "fresh" {
+		t.Fatalf("Results[1]=%+v, want fresh provider response", res.Results[1])
+	}
+
+	if rec, ok := cp.Get("2"); !ok || rec.Response != "fresh" {
+		t.Fatalf("expected question 2 to be appended to the checkpoint, got %+v ok=%v", rec, ok)
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -103,3 +106,142 @@ func takeFirstN[T any](in []T, n int) []T {
 	return append(out, in[:n]...)
 }
 
+// SampleStrategy selects how a Dataset's Load narrows its rows down to
+// SampleSize questions. The zero value, SampleStrategyHead, preserves the
+// original behavior (the first SampleSize rows, in file order).
+type SampleStrategy string
+
+const (
+	SampleStrategyHead         SampleStrategy = ""
+	SampleStrategyUniform      SampleStrategy = "uniform"
+	SampleStrategyStratified   SampleStrategy = "stratified"
+	SampleStrategyHardWeighted SampleStrategy = "hard-weighted"
+)
+
+// ParseSampleStrategy validates a --sample-strategy flag value. An empty
+// string maps to SampleStrategyHead so the flag can be omitted without
+// changing existing behavior.
+func ParseSampleStrategy(s string) (SampleStrategy, error) {
+	switch strategy := SampleStrategy(strings.ToLower(strings.TrimSpace(s))); strategy {
+	case SampleStrategyHead, SampleStrategyUniform, SampleStrategyStratified, SampleStrategyHardWeighted:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("benchmark: invalid sample strategy %q (expected uniform|stratified|hard-weighted)", s)
+	}
+}
+
+// sampleQuestions narrows in down to n questions using strategy, seeded by
+// seed for reproducibility. n <= 0 or n >= len(in) returns in unchanged, and
+// SampleStrategyHead (the default) falls back to takeFirstN.
+func sampleQuestions(in []Question, n int, strategy SampleStrategy, seed int64) []Question {
+	if n <= 0 || n >= len(in) {
+		return in
+	}
+	switch strategy {
+	case SampleStrategyUniform:
+		return sampleUniform(in, n, seed)
+	case SampleStrategyStratified:
+		return sampleStratified(in, n, seed)
+	case SampleStrategyHardWeighted:
+		return sampleHardWeighted(in, n, seed)
+	default:
+		return takeFirstN(in, n)
+	}
+}
+
+// sampleUniform returns a seeded random subset of size n, giving every
+// question an equal chance of selection.
+func sampleUniform(in []Question, n int, seed int64) []Question {
+	rng := rand.New(rand.NewSource(seed))
+	shuffled := make([]Question, len(in))
+	copy(shuffled, in)
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return shuffled[:n]
+}
+
+// sampleStratified draws questions from each Category in proportion to its
+// share of in, so a small sample still represents every category instead of
+// whichever happen to sort first.
+func sampleStratified(in []Question, n int, seed int64) []Question {
+	rng := rand.New(rand.NewSource(seed))
+
+	groups := make(map[string][]Question)
+	var categories []string
+	for _, q := range in {
+		if _, ok := groups[q.Category]; !ok {
+			categories = append(categories, q.Category)
+		}
+		groups[q.Category] = append(groups[q.Category], q)
+	}
+	sort.Strings(categories)
+	for _, cat := range categories {
+		g := groups[cat]
+		rng.Shuffle(len(g), func(i, j int) { g[i], g[j] = g[j], g[i] })
+	}
+
+	out := make([]Question, 0, n)
+	remaining := n
+	for i, cat := range categories {
+		quota := remaining / (len(categories) - i)
+		if quota <= 0 {
+			quota = 1
+		}
+		if quota > len(groups[cat]) {
+			quota = len(groups[cat])
+		}
+		out = append(out, groups[cat][:quota]...)
+		remaining -= quota
+		if remaining <= 0 {
+			break
+		}
+	}
+	return takeFirstN(out, n)
+}
+
+// sampleHardWeighted draws a weighted sample without replacement, biased
+// toward higher-difficulty questions via the A-ExpJ weighted reservoir
+// algorithm: each item's key is rand()^(1/weight), and the n largest keys
+// win. Difficulty is read from Question.Difficulty via difficultyWeight.
+func sampleHardWeighted(in []Question, n int, seed int64) []Question {
+	rng := rand.New(rand.NewSource(seed))
+
+	type keyed struct {
+		q   Question
+		key float64
+	}
+	keys := make([]keyed, len(in))
+	for i, q := range in {
+		u := rng.Float64()
+		if u <= 0 {
+			u = math.SmallestNonzeroFloat64
+		}
+		keys[i] = keyed{q: q, key: math.Pow(u, 1/difficultyWeight(q.Difficulty))}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].key > keys[j].key })
+
+	out := make([]Question, n)
+	for i := 0; i < n; i++ {
+		out[i] = keys[i].q
+	}
+	return out
+}
+
+// difficultyWeight maps a dataset row's free-form difficulty label to a
+// sampling weight for sampleHardWeighted. Numeric labels are used directly;
+// named labels use a coarse three-tier scale; anything else (including an
+// empty string, for datasets that don't carry difficulty) is treated as
+// medium so hard-weighted sampling degrades to roughly uniform.
+func difficultyWeight(difficulty string) float64 {
+	d := strings.ToLower(strings.TrimSpace(difficulty))
+	if f, err := strconv.ParseFloat(d, 64); err == nil && f > 0 {
+		return f
+	}
+	switch d {
+	case "easy":
+		return 1
+	case "hard":
+		return 3
+	default:
+		return 2
+	}
+}
@@ -204,3 +204,132 @@ func TestTakeFirstN(t *testing.T) {
 		}
 	}
 }
+
+func TestParseSampleStrategy(t *testing.T) {
+	if got, err := ParseSampleStrategy(""); err != nil || got != SampleStrategyHead {
+		t.Fatalf("empty: got %q err=%v", got, err)
+	}
+	if got, err := ParseSampleStrategy(" Uniform "); err != nil || got != SampleStrategyUniform {
+		t.Fatalf("uniform: got %q err=%v", got, err)
+	}
+	if got, err := ParseSampleStrategy("stratified"); err != nil || got != SampleStrategyStratified {
+		t.Fatalf("stratified: got %q err=%v", got, err)
+	}
+	if got, err := ParseSampleStrategy("hard-weighted"); err != nil || got != SampleStrategyHardWeighted {
+		t.Fatalf("hard-weighted: got %q err=%v", got, err)
+	}
+	if _, err := ParseSampleStrategy("bogus"); err == nil {
+		t.Fatalf("expected error for invalid strategy")
+	}
+}
+
+func TestSampleQuestions_NoOp(t *testing.T) {
+	in := []Question{{ID: "1"}, {ID: "2"}}
+	if out := sampleQuestions(in, 0, SampleStrategyUniform, 1); len(out) != 2 {
+		t.Fatalf("n<=0: len=%d", len(out))
+	}
+	if out := sampleQuestions(in, 5, SampleStrategyUniform, 1); len(out) != 2 {
+		t.Fatalf("n>=len: len=%d", len(out))
+	}
+}
+
+func TestSampleQuestions_Head(t *testing.T) {
+	in := []Question{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+	out := sampleQuestions(in, 2, SampleStrategyHead, 0)
+	if len(out) != 2 || out[0].ID != "1" || out[1].ID != "2" {
+		t.Fatalf("out=%#v", out)
+	}
+}
+
+func TestSampleQuestions_UniformDeterministicWithSeed(t *testing.T) {
+	in := make([]Question, 20)
+	for i := range in {
+		in[i] = Question{ID: string(rune('a' + i))}
+	}
+
+	a := sampleQuestions(in, 5, SampleStrategyUniform, 42)
+	b := sampleQuestions(in, 5, SampleStrategyUniform, 42)
+	if len(a) != 5 || len(b) != 5 {
+		t.Fatalf("len(a)=%d len(b)=%d", len(a), len(b))
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			t.Fatalf("same seed produced different samples: %#v vs %#v", a, b)
+		}
+	}
+
+	c := sampleQuestions(in, 5, SampleStrategyUniform, 7)
+	same := true
+	for i := range a {
+		if a[i].ID != c[i].ID {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Fatalf("different seeds produced identical samples")
+	}
+}
+
+func TestSampleQuestions_Stratified(t *testing.T) {
+	var in []Question
+	for i := 0; i < 8; i++ {
+		in = append(in, Question{ID: "math", Category: "math"})
+	}
+	for i := 0; i < 2; i++ {
+		in = append(in, Question{ID: "history", Category: "history"})
+	}
+
+	out := sampleQuestions(in, 5, SampleStrategyStratified, 1)
+	if len(out) != 5 {
+		t.Fatalf("len(out)=%d", len(out))
+	}
+
+	counts := make(map[string]int)
+	for _, q := range out {
+		counts[q.Category]++
+	}
+	if counts["history"] == 0 {
+		t.Fatalf("expected stratified sample to include the minority category, got %#v", counts)
+	}
+}
+
+func TestSampleQuestions_HardWeightedPrefersHarderItems(t *testing.T) {
+	var in []Question
+	for i := 0; i < 25; i++ {
+		in = append(in, Question{ID: "easy", Difficulty: "easy"})
+	}
+	for i := 0; i < 25; i++ {
+		in = append(in, Question{ID: "hard", Difficulty: "hard"})
+	}
+
+	out := sampleQuestions(in, 10, SampleStrategyHardWeighted, 1)
+	if len(out) != 10 {
+		t.Fatalf("len(out)=%d", len(out))
+	}
+
+	hardCount := 0
+	for _, q := range out {
+		if q.ID == "hard" {
+			hardCount++
+		}
+	}
+	if hardCount <= 5 {
+		t.Fatalf("expected hard-weighted sampling to skew toward the harder group, got %d/10 hard", hardCount)
+	}
+}
+
+func TestDifficultyWeight(t *testing.T) {
+	cases := map[string]float64{
+		"":       2,
+		"easy":   1,
+		"medium": 2,
+		"hard":   3,
+		"4":      4,
+	}
+	for in, want := range cases {
+		if got := difficultyWeight(in); got != want {
+			t.Fatalf("difficultyWeight(%q): got %v want %v", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Package calibrate measures how well an evaluator's automated verdict on a
+// fixed response agrees with a human's, so evaluator thresholds and rubrics
+// (e.g. llm_judge criteria) can be tuned against ground truth rather than
+// intuition.
+package calibrate
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+// Dataset is a labeled set of fixed responses to score against the
+// configured evaluators.
+type Dataset struct {
+	Cases []LabeledCase `yaml:"cases"`
+}
+
+// LabeledCase pairs a fixed response and its evaluator config (the same
+// shapes as testcase.TestCase.Expected/Evaluators) with a human judgment.
+type LabeledCase struct {
+	ID          string                     `yaml:"id"`
+	Description string                     `yaml:"description,omitempty"`
+	Response    string                     `yaml:"response"`
+	Expected    testcase.Expected          `yaml:"expected,omitempty"`
+	Evaluators  []testcase.EvaluatorConfig `yaml:"evaluators,omitempty"`
+
+	// HumanPass and HumanScore are the ground truth to compare the
+	// evaluator's verdict against. At least one must be set; either or both
+	// may be present on the same case.
+	HumanPass  *bool    `yaml:"human_pass,omitempty"`
+	HumanScore *float64 `yaml:"human_score,omitempty"`
+}
+
+// LoadDataset loads and validates a calibration dataset from a YAML file.
+func LoadDataset(path string) (*Dataset, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("calibrate: read %q: %w", path, err)
+	}
+
+	var ds Dataset
+	if err := yaml.Unmarshal(b, &ds); err != nil {
+		return nil, fmt.Errorf("calibrate: parse %q: %w", path, err)
+	}
+	if err := validate(&ds); err != nil {
+		return nil, fmt.Errorf("calibrate: validate %q: %w", path, err)
+	}
+	return &ds, nil
+}
+
+func validate(ds *Dataset) error {
+	if ds == nil || len(ds.Cases) == 0 {
+		return fmt.Errorf("no cases")
+	}
+
+	seenIDs := make(map[string]struct{}, len(ds.Cases))
+	for i, c := range ds.Cases {
+		id := strings.TrimSpace(c.ID)
+		if id == "" {
+			return fmt.Errorf("cases[%d]: missing id", i)
+		}
+		if _, ok := seenIDs[id]; ok {
+			return fmt.Errorf("cases[%d] (%s): duplicate id", i, id)
+		}
+		seenIDs[id] = struct{}{}
+
+		if len(c.Evaluators) == 0 {
+			return fmt.Errorf("cases[%d] (%s): no evaluators", i, id)
+		}
+		if c.HumanPass == nil && c.HumanScore == nil {
+			return fmt.Errorf("cases[%d] (%s): missing human_pass or human_score", i, id)
+		}
+	}
+	return nil
+}
+
+// CaseAgreement records one case's evaluator verdict alongside its human
+// label.
+type CaseAgreement struct {
+	ID             string
+	HumanPass      *bool
+	HumanScore     *float64
+	EvaluatorPass  bool
+	EvaluatorScore float64
+}
+
+// Report summarizes agreement between evaluator verdicts and human labels
+// across a Dataset.
+type Report struct {
+	Total int
+
+	// Accuracy, Precision, Recall, and F1 are computed over cases with
+	// HumanPass set, treating the evaluator's Passed as the predicted label.
+	Accuracy  float64
+	Precision float64
+	Recall    float64
+	F1        float64
+
+	// Correlation is the Pearson correlation between evaluator score and
+	// HumanScore, over cases with HumanScore set. 0 if fewer than two such
+	// cases exist.
+	Correlation float64
+
+	Cases []CaseAgreement
+}
+
+// Run scores every case in ds via r.EvaluateResponse (no generation, the
+// same scoring path a real trial takes) and reports how well the
+// evaluator's verdict agrees with the human label.
+func Run(ctx context.Context, r *runner.Runner, ds *Dataset) (*Report, error) {
+	if r == nil {
+		return nil, fmt.Errorf("calibrate: nil runner")
+	}
+	if ds == nil || len(ds.Cases) == 0 {
+		return nil, fmt.Errorf("calibrate: empty dataset")
+	}
+
+	report := &Report{
+		Total: len(ds.Cases),
+		Cases: make([]CaseAgreement, 0, len(ds.Cases)),
+	}
+
+	var truePos, falsePos, falseNeg, trueNeg int
+	var n float64
+	var sumHuman, sumEval, sumHumanSq, sumEvalSq, sumHumanEval float64
+
+	for _, c := range ds.Cases {
+		tc := &testcase.TestCase{ID: c.ID, Expected: c.Expected, Evaluators: c.Evaluators}
+		_, passed, score := r.EvaluateResponse(ctx, tc, c.Response)
+
+		report.Cases = append(report.Cases, CaseAgreement{
+			ID:             c.ID,
+			HumanPass:      c.HumanPass,
+			HumanScore:     c.HumanScore,
+			EvaluatorPass:  passed,
+			EvaluatorScore: score,
+		})
+
+		if c.HumanPass != nil {
+			switch {
+			case *c.HumanPass && passed:
+				truePos++
+			case *c.HumanPass && !passed:
+				falseNeg++
+			case !*c.HumanPass && passed:
+				falsePos++
+			default:
+				trueNeg++
+			}
+		}
+
+		if c.HumanScore != nil {
+			h := *c.HumanScore
+			n++
+			sumHuman += h
+			sumEval += score
+			sumHumanSq += h * h
+			sumEvalSq += score * score
+			sumHumanEval += h * score
+		}
+	}
+
+	if labeled := truePos + falsePos + falseNeg + trueNeg; labeled > 0 {
+		report.Accuracy = float64(truePos+trueNeg) / float64(labeled)
+	}
+	if truePos+falsePos > 0 {
+		report.Precision = float64(truePos) / float64(truePos+falsePos)
+	}
+	if truePos+falseNeg > 0 {
+		report.Recall = float64(truePos) / float64(truePos+falseNeg)
+	}
+	if report.Precision+report.Recall > 0 {
+		report.F1 = 2 * report.Precision * report.Recall / (report.Precision + report.Recall)
+	}
+
+	if n > 1 {
+		denom := math.Sqrt((n*sumHumanSq - sumHuman*sumHuman) * (n*sumEvalSq - sumEval*sumEval))
+		if denom != 0 {
+			report.Correlation = (n*sumHumanEval - sumHuman*sumEval) / denom
+		}
+	}
+
+	return report, nil
+}
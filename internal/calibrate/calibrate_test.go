@@ -0,0 +1,171 @@
+package calibrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func newTestRunner() *runner.Runner {
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	return runner.NewRunner(nil, reg, runner.Config{Trials: 1, Concurrency: 1})
+}
+
+func boolPtr(b bool) *bool        { return &b }
+func floatPtr(f float64) *float64 { return &f }
+
+func TestRun_AccuracyAndCorrelation(t *testing.T) {
+	t.Parallel()
+
+	ds := &Dataset{
+		Cases: []LabeledCase{
+			{
+				ID:         "match",
+				Response:   "hello world",
+				Evaluators: []testcase.EvaluatorConfig{{Type: "exact"}},
+				Expected:   testcase.Expected{ExactMatch: "hello world"},
+				HumanPass:  boolPtr(true),
+				HumanScore: floatPtr(1.0),
+			},
+			{
+				ID:         "mismatch",
+				Response:   "goodbye",
+				Evaluators: []testcase.EvaluatorConfig{{Type: "exact"}},
+				Expected:   testcase.Expected{ExactMatch: "hello world"},
+				HumanPass:  boolPtr(false),
+				HumanScore: floatPtr(0.0),
+			},
+			{
+				// Evaluator disagrees with the human here: exact match fails
+				// but the human considered it a pass.
+				ID:         "disagreement",
+				Response:   "close enough",
+				Evaluators: []testcase.EvaluatorConfig{{Type: "exact"}},
+				Expected:   testcase.Expected{ExactMatch: "hello world"},
+				HumanPass:  boolPtr(true),
+				HumanScore: floatPtr(0.9),
+			},
+		},
+	}
+
+	report, err := Run(context.Background(), newTestRunner(), ds)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if report.Total != 3 {
+		t.Fatalf("Total: got %d", report.Total)
+	}
+	if got := report.Accuracy; got < 0.66 || got > 0.67 {
+		t.Fatalf("Accuracy: got %v, want ~0.667 (2/3)", got)
+	}
+	if report.Precision != 1.0 {
+		t.Fatalf("Precision: got %v, want 1.0 (no false positives)", report.Precision)
+	}
+	if got := report.Recall; got < 0.49 || got > 0.51 {
+		t.Fatalf("Recall: got %v, want ~0.5 (1/2)", got)
+	}
+	if report.Correlation <= 0 {
+		t.Fatalf("Correlation: got %v, want positive (scores and labels move together)", report.Correlation)
+	}
+	if len(report.Cases) != 3 {
+		t.Fatalf("Cases: got %d", len(report.Cases))
+	}
+}
+
+func TestRun_NilRunnerOrEmptyDataset(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Run(context.Background(), nil, &Dataset{Cases: []LabeledCase{{ID: "a"}}}); err == nil {
+		t.Fatalf("expected error for nil runner")
+	}
+	if _, err := Run(context.Background(), newTestRunner(), &Dataset{}); err == nil {
+		t.Fatalf("expected error for empty dataset")
+	}
+}
+
+func TestLoadDataset(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "dataset.yaml")
+	yamlContent := `
+cases:
+  - id: c1
+    response: "hello world"
+    expected:
+      exact_match: "hello world"
+    evaluators:
+      - type: exact
+    human_pass: true
+`
+	if err := os.WriteFile(path, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("write dataset: %v", err)
+	}
+
+	ds, err := LoadDataset(path)
+	if err != nil {
+		t.Fatalf("LoadDataset: %v", err)
+	}
+	if len(ds.Cases) != 1 || ds.Cases[0].ID != "c1" {
+		t.Fatalf("LoadDataset: got %#v", ds)
+	}
+}
+
+func TestLoadDataset_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"no cases": `cases: []`,
+		"missing id": `
+cases:
+  - response: "x"
+    evaluators: [{type: exact}]
+    human_pass: true
+`,
+		"duplicate id": `
+cases:
+  - id: c1
+    response: "x"
+    evaluators: [{type: exact}]
+    human_pass: true
+  - id: c1
+    response: "y"
+    evaluators: [{type: exact}]
+    human_pass: true
+`,
+		"no evaluators": `
+cases:
+  - id: c1
+    response: "x"
+    human_pass: true
+`,
+		"no human label": `
+cases:
+  - id: c1
+    response: "x"
+    evaluators: [{type: exact}]
+`,
+	}
+
+	for name, content := range cases {
+		content := content
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			path := filepath.Join(dir, "dataset.yaml")
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatalf("write dataset: %v", err)
+			}
+			if _, err := LoadDataset(path); err == nil {
+				t.Fatalf("expected error for case %q", name)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/stellarlinkco/ai-eval/internal/redact"
 )
 
 const DefaultPath = "configs/config.yaml"
@@ -15,6 +17,22 @@ type Config struct {
 	LLM        LLMConfig        `yaml:"llm"`
 	Evaluation EvaluationConfig `yaml:"evaluation"`
 	Storage    StorageConfig    `yaml:"storage"`
+	APICache   APICacheConfig   `yaml:"api_cache,omitempty"`
+	Benchmark  BenchmarkConfig  `yaml:"benchmark,omitempty"`
+	Redaction  RedactionConfig  `yaml:"redaction,omitempty"`
+
+	// Environment selects which prompt.Override to apply when loading
+	// prompts (see prompt.Prompt.Overrides), e.g. "prod" or "staging".
+	// Commands that support a --env flag use it in preference to this.
+	Environment string `yaml:"environment,omitempty"`
+
+	// LenientPromptLoading, when true, makes the API's prompt-loading
+	// endpoints skip individual files that fail to parse (see
+	// prompt.LoadFromDirLenient) instead of failing the whole load, so one
+	// bad file doesn't take down the whole library. `eval run` and other
+	// commands that need every referenced prompt to be valid always load
+	// strictly regardless of this setting. Defaults to false.
+	LenientPromptLoading bool `yaml:"lenient_prompt_loading,omitempty"`
 }
 
 type LLMConfig struct {
@@ -26,14 +44,88 @@ type ProviderConfig struct {
 	APIKey  string `yaml:"api_key"`
 	BaseURL string `yaml:"base_url,omitempty"`
 	Model   string `yaml:"model,omitempty"`
+
+	// Headers are attached to every outbound HTTP request for this provider.
+	// Useful for corporate LLM gateways that require custom auth or routing
+	// headers. Currently only honored by the OpenAI-compatible provider.
+	Headers map[string]string `yaml:"headers,omitempty"`
 }
 
 type EvaluationConfig struct {
-	Trials       int           `yaml:"trials"`
-	Threshold    float64       `yaml:"threshold"`
-	OutputFormat string        `yaml:"output_format,omitempty"`
-	Concurrency  int           `yaml:"concurrency,omitempty"`
-	Timeout      time.Duration `yaml:"timeout,omitempty"`
+	Trials               int           `yaml:"trials"`
+	Threshold            float64       `yaml:"threshold"`
+	OutputFormat         string        `yaml:"output_format,omitempty"`
+	Concurrency          int           `yaml:"concurrency,omitempty"`
+	EvaluatorConcurrency int           `yaml:"evaluator_concurrency,omitempty"` // Max concurrent LLM evaluator calls; 0 uses Concurrency
+	Timeout              time.Duration `yaml:"timeout,omitempty"`
+
+	// AdaptiveConcurrency enables the AIMD concurrency controller: Concurrency
+	// becomes the starting level, and it self-tunes between MinConcurrency and
+	// MaxConcurrency as the provider's error rate rises and falls.
+	AdaptiveConcurrency bool `yaml:"adaptive_concurrency,omitempty"`
+	MinConcurrency      int  `yaml:"min_concurrency,omitempty"` // Floor when AdaptiveConcurrency is set; defaults to 1
+	MaxConcurrency      int  `yaml:"max_concurrency,omitempty"` // Ceiling when AdaptiveConcurrency is set; defaults to 4x Concurrency
+
+	// MaxStepsHardFail is the default hard tool-step gate applied to every
+	// case that doesn't set testcase.TestCase.MaxStepsHardFail itself. 0
+	// disables the gate by default.
+	MaxStepsHardFail int `yaml:"max_steps_hard_fail,omitempty"`
+
+	// StrictSafety, when true, fails a trial outright on any nonzero
+	// toxicity/bias score regardless of the evaluator's configured
+	// threshold. Can also be enabled per-invocation with `eval run
+	// --strict-safety`. Defaults to false.
+	StrictSafety bool `yaml:"strict_safety,omitempty"`
+
+	// PersistResponses, when true, saves each trial's raw response text and
+	// tool calls alongside its scores so cases can be re-evaluated with new
+	// evaluators without re-calling the model. Off by default since it grows
+	// the store considerably. Can also be enabled per-invocation with `eval
+	// run --persist-responses`. Responses are size-capped and redacted; see
+	// app.SaveRun.
+	PersistResponses bool `yaml:"persist_responses,omitempty"`
+
+	// ScoreEpsilon, when > 0, treats any evaluator score below this
+	// threshold as exactly 0 after clamping to [0,1]. Absorbs
+	// floating-point noise from LLM judges (e.g. 0.003 instead of 0)
+	// that would otherwise skew SuiteResult.AvgScore. 0 disables the
+	// transform; clamping to [0,1] itself always applies.
+	ScoreEpsilon float64 `yaml:"score_epsilon,omitempty"`
+
+	// PromptWrapper is a Go text/template applied to every rendered prompt
+	// before it's sent to the model, with the rendered prompt available as
+	// `{{.prompt}}`. Lets a standard preamble/footer (e.g. an org compliance
+	// notice) be enforced without editing each prompt file. A prompt can opt
+	// out via prompt.Prompt.SkipPromptWrapper. Empty disables wrapping. Can
+	// be disabled per-invocation with `eval run --disable-prompt-wrapper` to
+	// compare runs with and without it.
+	PromptWrapper string `yaml:"prompt_wrapper,omitempty"`
+
+	// Precision sets how many significant digits pass_rate/avg_score/score
+	// (and their deltas) are rounded to in human-readable table/github
+	// output for run, compare, history, and leaderboard. Stabilizes CI
+	// snapshots against float noise that doesn't reflect a real change.
+	// 0 (unset) defaults to 4. Can be overridden per-invocation with
+	// `--precision`. JSON output always reports full float64 precision for
+	// programmatic consumers, regardless of this setting.
+	Precision int `yaml:"precision,omitempty"`
+
+	// Context holds run-scoped values (e.g. current_date, tenant_id) merged
+	// into every case's Input before rendering, so a run doesn't need to
+	// repeat the same values in each case. A case's own Input always wins
+	// over Context on key conflicts. Can be extended per-invocation with
+	// `eval run --context KEY=VALUE` (repeatable); flag values are merged
+	// on top of this config, and both are recorded in the run config for
+	// reproducibility.
+	Context map[string]any `yaml:"context,omitempty"`
+
+	// NoAssertionsPolicy controls what `eval run` does with a case that has
+	// no Expected assertions and no Evaluators, which would otherwise
+	// "pass" trivially without checking anything. "error" (the default,
+	// used when unset) rejects such suites at load time; "default_evaluator"
+	// instead gives the case a "non_empty" evaluator and prints a warning
+	// per affected case. See testcase.NoAssertionsPolicy.
+	NoAssertionsPolicy string `yaml:"no_assertions_policy,omitempty"`
 }
 
 type StorageConfig struct {
@@ -41,6 +133,48 @@ type StorageConfig struct {
 	Path string `yaml:"path,omitempty"` // SQLite file path
 }
 
+// APICacheConfig controls the optional response cache for expensive,
+// idempotent API endpoints (diagnose, optimize).
+type APICacheConfig struct {
+	Enabled bool          `yaml:"enabled,omitempty"`
+	TTL     time.Duration `yaml:"ttl,omitempty"` // Defaults to 10m when Enabled and unset
+}
+
+// BenchmarkConfig controls `ai-eval benchmark --providers` sweeps.
+type BenchmarkConfig struct {
+	// MaxParallelProviders caps how many providers a `--providers` sweep
+	// evaluates at once, so benchmarking a long model list doesn't fire
+	// every provider concurrently and blow through a shared API key's rate
+	// limit. Defaults to 1 (fully sequential) when unset or <= 0.
+	MaxParallelProviders int `yaml:"max_parallel_providers,omitempty"`
+}
+
+// RedactionConfig controls how secrets and PII are masked before logged
+// requests or persisted run data leave memory. Both fields are optional;
+// an unset field keeps the corresponding built-in default (see
+// internal/redact).
+type RedactionConfig struct {
+	// KeyPatterns are lower-cased substrings matched against map/struct
+	// keys that should be masked wherever a Redactor sees them (e.g. in
+	// stored run config or logged query parameters). Unset uses
+	// redact.New's built-in defaults ("api_key", "token", "secret", ...).
+	KeyPatterns []string `yaml:"key_patterns,omitempty"`
+
+	// PIIPatterns are regexes scrubbed out of free-form text such as
+	// persisted prompt renders and model responses. An invalid pattern is
+	// skipped. Unset disables PII scrubbing.
+	PIIPatterns []string `yaml:"pii_patterns,omitempty"`
+}
+
+// Redactor builds a redact.Redactor from cfg's Redaction settings. A nil
+// cfg returns redact.Default().
+func (c *Config) Redactor() *redact.Redactor {
+	if c == nil {
+		return redact.Default()
+	}
+	return redact.New(c.Redaction.KeyPatterns, c.Redaction.PIIPatterns)
+}
+
 func Load(path string) (*Config, error) {
 	path = strings.TrimSpace(path)
 	if path == "" {
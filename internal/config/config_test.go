@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/redact"
 )
 
 func TestLoad_ReadError(t *testing.T) {
@@ -33,6 +35,32 @@ func TestLoad_ParseError(t *testing.T) {
 	}
 }
 
+func TestConfig_Redactor_NilConfigUsesDefault(t *testing.T) {
+	var c *Config
+	r := c.Redactor()
+	if !r.KeyMatches("api_key") {
+		t.Fatalf("Redactor: nil config should still mask default key patterns")
+	}
+}
+
+func TestConfig_Redactor_UsesConfiguredPatterns(t *testing.T) {
+	c := &Config{Redaction: RedactionConfig{
+		KeyPatterns: []string{"internal_id"},
+		PIIPatterns: []string{`\d{3}-\d{2}-\d{4}`},
+	}}
+
+	r := c.Redactor()
+	if !r.KeyMatches("internal_id") {
+		t.Fatalf("Redactor: expected configured key pattern to match")
+	}
+	if r.KeyMatches("api_key") {
+		t.Fatalf("Redactor: configured key patterns should replace, not extend, the built-in defaults")
+	}
+	if got, want := r.String("ssn is 123-45-6789"), "ssn is "+redact.Mask; got != want {
+		t.Fatalf("Redactor: PII scrub got %q want %q", got, want)
+	}
+}
+
 func TestLoad_DefaultPathDefaultsAndEnvOverrides(t *testing.T) {
 	dir := t.TempDir()
 	if err := os.MkdirAll(filepath.Join(dir, "configs"), 0o755); err != nil {
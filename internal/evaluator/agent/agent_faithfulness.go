@@ -0,0 +1,187 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+// AgentFaithfulnessEvaluator checks that a tool-using agent's final answer is
+// grounded in what its tools actually returned, catching agents that ignore
+// a tool result or invent facts no tool call ever produced. It is the
+// tool-output analogue of rag.FaithfulnessEvaluator, which checks groundedness
+// against a fixed retrieval context instead.
+type AgentFaithfulnessEvaluator struct {
+	Client llm.Provider
+}
+
+func (AgentFaithfulnessEvaluator) Name() string {
+	return "agent_faithfulness"
+}
+
+type agentFaithfulnessOutput struct {
+	Score             float64  `json:"score"`
+	Reasoning         string   `json:"reasoning"`
+	UnsupportedClaims []string `json:"unsupported_claims"`
+}
+
+func (e *AgentFaithfulnessEvaluator) Evaluate(ctx context.Context, response string, expected any) (*evaluator.Result, error) {
+	if e == nil {
+		return nil, errors.New("agent_faithfulness: nil evaluator")
+	}
+	if e.Client == nil {
+		return nil, errors.New("agent_faithfulness: nil llm provider")
+	}
+
+	threshold := 0.8
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
+	var toolResults []llm.ToolCallResult
+
+	switch v := expected.(type) {
+	case nil:
+	case map[string]any:
+		if raw, ok := v["threshold"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("agent_faithfulness: expected.threshold must be number, got %T", raw)
+			}
+			if f > 0 {
+				threshold = f
+			}
+		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("agent_faithfulness: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("agent_faithfulness: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("agent_faithfulness: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
+		if raw, ok := v["tool_results"]; ok {
+			tr, err := toolCallResultsFromAny(raw)
+			if err != nil {
+				return nil, fmt.Errorf("agent_faithfulness: expected.tool_results: %w", err)
+			}
+			toolResults = tr
+		}
+	default:
+		return nil, fmt.Errorf("agent_faithfulness: expected must be map[string]any, got %T", expected)
+	}
+
+	if threshold > 1 {
+		threshold = 1
+	}
+
+	if len(toolResults) == 0 {
+		return &evaluator.Result{
+			Passed:  true,
+			Score:   1,
+			Message: "agent_faithfulness: no tool outputs available, evaluator skipped",
+		}, nil
+	}
+
+	var toolOutputs bytes.Buffer
+	for i, tr := range toolResults {
+		fmt.Fprintf(&toolOutputs, "%d. %s(%v)", i+1, tr.Call.Name, tr.Call.Input)
+		if tr.Error != "" {
+			fmt.Fprintf(&toolOutputs, " -> error: %s\n", tr.Error)
+			continue
+		}
+		fmt.Fprintf(&toolOutputs, " -> %s\n", tr.Output)
+	}
+
+	var prompt bytes.Buffer
+	prompt.WriteString("You are an expert evaluator of tool-using AI agents. Determine whether the agent's final answer is strictly grounded in the results its tools actually returned.\n\n")
+	prompt.WriteString("## Tool Calls and Outputs\n")
+	prompt.WriteString(toolOutputs.String())
+	prompt.WriteString("\n## Agent's Final Answer\n")
+	prompt.WriteString(response)
+	prompt.WriteString("\n\n## Instructions\n")
+	prompt.WriteString("Score faithfulness from 0.0 to 1.0.\n")
+	prompt.WriteString("- 0.0: The answer contradicts the tool outputs, or asserts facts no tool call returned\n")
+	prompt.WriteString("- 1.0: Every factual claim in the answer is supported by, or a reasonable summary of, the tool outputs\n\n")
+	prompt.WriteString("Output ONLY valid JSON in this exact format:\n")
+	prompt.WriteString("{\"score\": <number 0.0-1.0>, \"reasoning\": \"<brief explanation>\", \"unsupported_claims\": [\"<claim>\", ...]}")
+
+	resp, err := e.Client.Complete(ctx, &llm.Request{
+		Messages:    []llm.Message{{Role: "user", Content: prompt.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("agent_faithfulness: llm: %w", err)
+	}
+	if resp == nil {
+		return nil, errors.New("agent_faithfulness: nil llm response")
+	}
+
+	raw := strings.TrimSpace(llm.Text(resp))
+	var out agentFaithfulnessOutput
+	if err := llm.ParseJSON(raw, &out); err != nil {
+		return &evaluator.Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: "invalid agent_faithfulness output",
+			Details: map[string]any{"error": err.Error(), "output": raw},
+		}, nil
+	}
+
+	score := clamp01(out.Score)
+	passed := score >= threshold
+	reasoning := strings.TrimSpace(out.Reasoning)
+	if reasoning == "" {
+		reasoning = "no reasoning provided"
+	}
+
+	details := map[string]any{
+		"threshold": threshold,
+	}
+	if len(out.UnsupportedClaims) > 0 {
+		details["unsupported_claims"] = out.UnsupportedClaims
+	}
+
+	return &evaluator.Result{
+		Passed:  passed,
+		Score:   score,
+		Message: reasoning,
+		Details: details,
+	}, nil
+}
+
+// toolCallResultsFromAny narrows the runner-threaded expected.tool_results
+// value. Unlike tool_selection's tool_calls, this data only ever comes from
+// the runner's own multi-turn loop (there's no JSON-driven equivalent), so
+// no []any fallback is needed.
+func toolCallResultsFromAny(v any) ([]llm.ToolCallResult, error) {
+	switch results := v.(type) {
+	case nil:
+		return nil, nil
+	case []llm.ToolCallResult:
+		return results, nil
+	default:
+		return nil, fmt.Errorf("expected []llm.ToolCallResult, got %T", v)
+	}
+}
@@ -0,0 +1,149 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+func TestAgentFaithfulnessEvaluator_NoToolResultsSkips(t *testing.T) {
+	t.Parallel()
+
+	e := &AgentFaithfulnessEvaluator{Client: &stubProvider{}}
+	res, err := e.Evaluate(context.Background(), "the answer", map[string]any{"threshold": 0.9})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed || res.Score != 1 {
+		t.Fatalf("got passed=%v score=%v want true/1 when no tool outputs available", res.Passed, res.Score)
+	}
+}
+
+func TestAgentFaithfulnessEvaluator_GroundedPasses(t *testing.T) {
+	t.Parallel()
+
+	e := &AgentFaithfulnessEvaluator{Client: &stubProvider{fn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+		_ = ctx
+		_ = req
+		return textResponse(`{"score": 0.95, "reasoning": "matches the tool result", "unsupported_claims": []}`), nil
+	}}}
+
+	res, err := e.Evaluate(context.Background(), "the weather is sunny", map[string]any{
+		"tool_results": []llm.ToolCallResult{
+			{Call: llm.ToolUse{Name: "get_weather", Input: map[string]any{"city": "sf"}}, Output: "sunny, 72F"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed || res.Score != 0.95 {
+		t.Fatalf("res=%#v", res)
+	}
+}
+
+func TestAgentFaithfulnessEvaluator_UnsupportedFails(t *testing.T) {
+	t.Parallel()
+
+	e := &AgentFaithfulnessEvaluator{Client: &stubProvider{fn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+		_ = ctx
+		_ = req
+		return textResponse(`{"score": 0.1, "reasoning": "invents a fact", "unsupported_claims": ["it will rain tomorrow"]}`), nil
+	}}}
+
+	res, err := e.Evaluate(context.Background(), "it will rain tomorrow", map[string]any{
+		"tool_results": []llm.ToolCallResult{
+			{Call: llm.ToolUse{Name: "get_weather"}, Output: "sunny, 72F"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed || res.Score != 0.1 {
+		t.Fatalf("res=%#v", res)
+	}
+	if got, ok := res.Details["unsupported_claims"]; !ok || len(got.([]string)) != 1 {
+		t.Fatalf("Details unsupported_claims: %#v", res.Details)
+	}
+}
+
+func TestAgentFaithfulnessEvaluator_ToolError(t *testing.T) {
+	t.Parallel()
+
+	var seenPrompt string
+	e := &AgentFaithfulnessEvaluator{Client: &stubProvider{fn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+		_ = ctx
+		seenPrompt = req.Messages[0].Content
+		return textResponse(`{"score": 1, "reasoning": "ok"}`), nil
+	}}}
+
+	_, err := e.Evaluate(context.Background(), "no results found", map[string]any{
+		"tool_results": []llm.ToolCallResult{
+			{Call: llm.ToolUse{Name: "lookup"}, Error: "not found"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !strings.Contains(seenPrompt, "error: not found") {
+		t.Fatalf("expected prompt to include tool error, got %q", seenPrompt)
+	}
+}
+
+func TestAgentFaithfulnessEvaluator_NilClient(t *testing.T) {
+	t.Parallel()
+
+	e := &AgentFaithfulnessEvaluator{}
+	if _, err := e.Evaluate(context.Background(), "x", nil); err == nil {
+		t.Fatalf("expected error for nil client")
+	}
+}
+
+func TestAgentFaithfulnessEvaluator_ExpectedTypeErrors(t *testing.T) {
+	t.Parallel()
+
+	e := &AgentFaithfulnessEvaluator{Client: &stubProvider{}}
+	if _, err := e.Evaluate(context.Background(), "x", 123); err == nil {
+		t.Fatalf("expected error for non-map expected")
+	}
+	if _, err := e.Evaluate(context.Background(), "x", map[string]any{"threshold": "x"}); err == nil {
+		t.Fatalf("expected error for bad threshold")
+	}
+	if _, err := e.Evaluate(context.Background(), "x", map[string]any{"tool_results": "nope"}); err == nil {
+		t.Fatalf("expected error for bad tool_results")
+	}
+}
+
+func TestAgentFaithfulnessEvaluator_ProviderErrorAndInvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	toolResults := map[string]any{
+		"tool_results": []llm.ToolCallResult{
+			{Call: llm.ToolUse{Name: "lookup"}, Output: "42"},
+		},
+	}
+
+	e := &AgentFaithfulnessEvaluator{Client: &stubProvider{fn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+		_ = ctx
+		_ = req
+		return nil, errors.New("boom")
+	}}}
+	if _, err := e.Evaluate(context.Background(), "x", toolResults); err == nil || !strings.Contains(err.Error(), "llm") {
+		t.Fatalf("err=%v", err)
+	}
+
+	e.Client = &stubProvider{fn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+		_ = ctx
+		_ = req
+		return textResponse("not json"), nil
+	}}
+	res, err := e.Evaluate(context.Background(), "x", toolResults)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed || res.Score != 0 {
+		t.Fatalf("res=%#v", res)
+	}
+}
@@ -37,6 +37,9 @@ func (e *TaskCompletionEvaluator) Evaluate(ctx context.Context, response string,
 	task := ""
 	criteria := []string(nil)
 	threshold := 0.6
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
 
 	switch v := expected.(type) {
 	case nil:
@@ -62,6 +65,29 @@ func (e *TaskCompletionEvaluator) Evaluate(ctx context.Context, response string,
 			}
 			threshold = f
 		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("task_completion: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("task_completion: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("task_completion: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
 	default:
 		return nil, fmt.Errorf("task_completion: expected must be map[string]any, got %T", expected)
 	}
@@ -100,8 +126,10 @@ func (e *TaskCompletionEvaluator) Evaluate(ctx context.Context, response string,
 	prompt.WriteString("{\"score\": <number 0.0-1.0>, \"reasoning\": \"<brief explanation>\", \"missing\": [\"<missing item>\", ...]}")
 
 	resp, err := e.Client.Complete(ctx, &llm.Request{
-		Messages:  []llm.Message{{Role: "user", Content: prompt.String()}},
-		MaxTokens: 512,
+		Messages:    []llm.Message{{Role: "user", Content: prompt.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("task_completion: llm: %w", err)
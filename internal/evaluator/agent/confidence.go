@@ -0,0 +1,94 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+// ConfidenceEvaluator scores a response by the model's own token-level
+// confidence, derived from provider logprobs (see llm.Request.Logprobs).
+// It passes when the geometric mean probability of the response's output
+// tokens clears the configured threshold. Providers that don't return
+// logprobs (or runs that didn't request them) leave nothing to score, so
+// the evaluator degrades to an ungated pass rather than a false failure.
+type ConfidenceEvaluator struct{}
+
+func (ConfidenceEvaluator) Name() string {
+	return "confidence"
+}
+
+func (ConfidenceEvaluator) Evaluate(ctx context.Context, response string, expected any) (*evaluator.Result, error) {
+	_ = ctx
+	_ = response
+
+	threshold := 0.5
+	var logprobs []llm.TokenLogprob
+
+	switch v := expected.(type) {
+	case nil:
+	case map[string]any:
+		if raw, ok := v["threshold"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("confidence: expected.threshold must be number, got %T", raw)
+			}
+			if f > 0 {
+				threshold = f
+			}
+		}
+		if raw, ok := v["logprobs"]; ok {
+			lp, err := asTokenLogprobs(raw)
+			if err != nil {
+				return nil, fmt.Errorf("confidence: expected.logprobs: %w", err)
+			}
+			logprobs = lp
+		}
+	default:
+		return nil, fmt.Errorf("confidence: expected must be map[string]any, got %T", expected)
+	}
+
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("confidence: threshold must be in [0,1], got %v", threshold)
+	}
+
+	if len(logprobs) == 0 {
+		return &evaluator.Result{
+			Passed:  true,
+			Score:   1,
+			Message: "confidence: no logprobs available, evaluator skipped",
+		}, nil
+	}
+
+	sum := 0.0
+	for _, tok := range logprobs {
+		sum += tok.LogProb
+	}
+	meanLogProb := sum / float64(len(logprobs))
+	confidence := clamp01(math.Exp(meanLogProb))
+
+	return &evaluator.Result{
+		Passed:  confidence >= threshold,
+		Score:   confidence,
+		Message: fmt.Sprintf("confidence %.3f (threshold %.3f)", confidence, threshold),
+		Details: map[string]any{
+			"threshold":    threshold,
+			"token_count":  len(logprobs),
+			"mean_logprob": meanLogProb,
+		},
+	}, nil
+}
+
+func asTokenLogprobs(v any) ([]llm.TokenLogprob, error) {
+	switch lp := v.(type) {
+	case nil:
+		return nil, nil
+	case []llm.TokenLogprob:
+		return lp, nil
+	default:
+		return nil, fmt.Errorf("expected []llm.TokenLogprob, got %T", v)
+	}
+}
@@ -0,0 +1,83 @@
+package agent
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+func TestConfidenceEvaluator_NoLogprobsSkips(t *testing.T) {
+	t.Parallel()
+
+	e := ConfidenceEvaluator{}
+	res, err := e.Evaluate(context.Background(), "", map[string]any{"threshold": 0.9})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed || res.Score != 1 {
+		t.Fatalf("got passed=%v score=%v want true/1 when logprobs unavailable", res.Passed, res.Score)
+	}
+}
+
+func TestConfidenceEvaluator_HighConfidencePasses(t *testing.T) {
+	t.Parallel()
+
+	e := ConfidenceEvaluator{}
+	res, err := e.Evaluate(context.Background(), "", map[string]any{
+		"threshold": 0.5,
+		"logprobs": []llm.TokenLogprob{
+			{Token: "yes", LogProb: -0.01},
+			{Token: ",", LogProb: -0.02},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed {
+		t.Fatalf("expected high-confidence tokens to pass, got %#v", res)
+	}
+	if res.Score <= 0.9 || res.Score > 1 {
+		t.Fatalf("Score: got %v want close to 1", res.Score)
+	}
+}
+
+func TestConfidenceEvaluator_LowConfidenceFails(t *testing.T) {
+	t.Parallel()
+
+	e := ConfidenceEvaluator{}
+	res, err := e.Evaluate(context.Background(), "", map[string]any{
+		"threshold": 0.8,
+		"logprobs": []llm.TokenLogprob{
+			{Token: "maybe", LogProb: math.Log(0.2)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed {
+		t.Fatalf("expected low-confidence token to fail threshold, got %#v", res)
+	}
+	if math.Abs(res.Score-0.2) > 1e-9 {
+		t.Fatalf("Score: got %v want ~0.2", res.Score)
+	}
+}
+
+func TestConfidenceEvaluator_InvalidThreshold(t *testing.T) {
+	t.Parallel()
+
+	e := ConfidenceEvaluator{}
+	if _, err := e.Evaluate(context.Background(), "", map[string]any{"threshold": 1.5}); err == nil {
+		t.Fatalf("expected error for out-of-range threshold")
+	}
+}
+
+func TestConfidenceEvaluator_InvalidExpectedType(t *testing.T) {
+	t.Parallel()
+
+	e := ConfidenceEvaluator{}
+	if _, err := e.Evaluate(context.Background(), "", "not a map"); err == nil {
+		t.Fatalf("expected error for non-map expected")
+	}
+}
@@ -0,0 +1,139 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+// GuardrailToolEvaluator checks that a required "guardrail" tool (e.g. a
+// policy-check tool) ran during an earlier turn than the one that produced
+// the final answer. testcase.ToolCallExpect's Order field only orders tool
+// calls relative to each other, so it can't express "the model must not
+// answer at all until this tool has run" — this evaluator checks that
+// boundary directly against the multi-turn transcript.
+type GuardrailToolEvaluator struct{}
+
+func (GuardrailToolEvaluator) Name() string {
+	return "tool_before_answer"
+}
+
+func (GuardrailToolEvaluator) Evaluate(ctx context.Context, response string, expected any) (*evaluator.Result, error) {
+	_ = ctx
+	_ = response
+
+	var requiredTool string
+	var rawTurns any
+	hasAnswer := false
+
+	switch v := expected.(type) {
+	case nil:
+	case map[string]any:
+		if raw, ok := v["required_tool"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("tool_before_answer: expected.required_tool must be string, got %T", raw)
+			}
+			requiredTool = s
+		}
+		rawTurns = v["tool_turns"]
+		if raw, ok := v["has_answer"]; ok {
+			b, ok := raw.(bool)
+			if !ok {
+				return nil, fmt.Errorf("tool_before_answer: expected.has_answer must be bool, got %T", raw)
+			}
+			hasAnswer = b
+		}
+	default:
+		return nil, fmt.Errorf("tool_before_answer: expected must be map[string]any, got %T", expected)
+	}
+
+	requiredTool = strings.TrimSpace(requiredTool)
+	if requiredTool == "" {
+		return nil, fmt.Errorf("tool_before_answer: missing required_tool")
+	}
+
+	turns, err := toolTurnNames(rawTurns)
+	if err != nil {
+		return nil, fmt.Errorf("tool_before_answer: expected.tool_turns: %w", err)
+	}
+
+	if !hasAnswer {
+		return &evaluator.Result{
+			Passed:  true,
+			Score:   1,
+			Message: "no final answer to check",
+			Details: map[string]any{"required_tool": requiredTool},
+		}, nil
+	}
+
+	// The last turn is the one that produced the final answer, so the
+	// guardrail tool must appear in an earlier turn, not that one.
+	calledBefore := false
+	if len(turns) > 0 {
+		for _, names := range turns[:len(turns)-1] {
+			for _, name := range names {
+				if name == requiredTool {
+					calledBefore = true
+					break
+				}
+			}
+			if calledBefore {
+				break
+			}
+		}
+	}
+
+	score := 0.0
+	msg := fmt.Sprintf("%q was not called before the final answer", requiredTool)
+	if calledBefore {
+		score = 1.0
+		msg = fmt.Sprintf("%q was called before the final answer", requiredTool)
+	}
+
+	return &evaluator.Result{
+		Passed:  calledBefore,
+		Score:   score,
+		Message: msg,
+		Details: map[string]any{
+			"required_tool": requiredTool,
+			"called_before": calledBefore,
+		},
+	}, nil
+}
+
+// toolTurnNames flattens the tool names called during each turn, tolerating
+// both the native [][]llm.ToolUse the runner passes at eval time and the
+// []any/map shape that would arrive if this were ever driven from JSON
+// (e.g. the /api/evaluate endpoint).
+func toolTurnNames(v any) ([][]string, error) {
+	switch turns := v.(type) {
+	case nil:
+		return nil, nil
+	case [][]llm.ToolUse:
+		out := make([][]string, 0, len(turns))
+		for _, turn := range turns {
+			names, err := toolNamesFromAny(turn)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, names)
+		}
+		return out, nil
+	case []any:
+		out := make([][]string, 0, len(turns))
+		for i, turn := range turns {
+			names, err := toolNamesFromAny(turn)
+			if err != nil {
+				return nil, fmt.Errorf("turn[%d]: %w", i, err)
+			}
+			out = append(out, names)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("must be an array of turns, got %T", v)
+	}
+}
@@ -0,0 +1,96 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ConsistencyEvaluator checks that every trial of a case produced the same
+// response, for prompts expected to be deterministic (e.g. temperature 0,
+// cached). Unlike every other evaluator, its input isn't a single trial's
+// response — it's called once per case, after all trials have run (see
+// runner.RunCase), against the full set of trial responses.
+type ConsistencyEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (ConsistencyEvaluator) Name() string {
+	return "consistency"
+}
+
+// Evaluate ignores response and expects expected to be a map[string]any with
+// "responses" ([]string, one per trial) and an optional "threshold"
+// (0-1, similarity required between every pair; 0 or omitted requires exact
+// match). Details reports the distinct responses observed.
+func (ConsistencyEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	cfg, ok := expected.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("consistency: expected map[string]any, got %T", expected)
+	}
+
+	responses, err := asStringSlice(cfg["responses"])
+	if err != nil {
+		return nil, fmt.Errorf("consistency: responses: %w", err)
+	}
+
+	threshold := 1.0
+	if raw, ok := cfg["threshold"]; ok {
+		f, ok := asFloat(raw)
+		if !ok {
+			return nil, fmt.Errorf("consistency: threshold must be number, got %T", raw)
+		}
+		if f > 0 {
+			threshold = f
+		}
+	}
+
+	var distinct []string
+	for _, r := range responses {
+		r = strings.TrimSpace(r)
+		isNew := true
+		for _, d := range distinct {
+			if consistencySimilar(r, d, threshold) {
+				isNew = false
+				break
+			}
+		}
+		if isNew {
+			distinct = append(distinct, r)
+		}
+	}
+
+	passed := len(distinct) <= 1
+	details := map[string]any{
+		"trials":   len(responses),
+		"distinct": distinct,
+	}
+
+	if passed {
+		return &Result{
+			Passed:  true,
+			Score:   1.0,
+			Message: fmt.Sprintf("all %d trial(s) consistent", len(responses)),
+			Details: details,
+		}, nil
+	}
+
+	return &Result{
+		Passed:  false,
+		Score:   0.0,
+		Message: fmt.Sprintf("%d distinct response(s) across %d trial(s)", len(distinct), len(responses)),
+		Details: details,
+	}, nil
+}
+
+// consistencySimilar reports whether a and b are close enough to count as
+// the same response: identical when threshold requires an exact match
+// (threshold >= 1), or with high enough token overlap otherwise.
+func consistencySimilar(a, b string, threshold float64) bool {
+	if a == b {
+		return true
+	}
+	if threshold >= 1.0 {
+		return false
+	}
+	return jaccardSimilarity(diversityTokens(strings.ToLower(a)), diversityTokens(strings.ToLower(b))) >= threshold
+}
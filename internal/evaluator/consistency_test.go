@@ -0,0 +1,79 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestConsistencyEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (ConsistencyEvaluator{}).Name(); got != "consistency" {
+		t.Fatalf("Name() = %q, want %q", got, "consistency")
+	}
+}
+
+func TestConsistencyEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := ConsistencyEvaluator{}
+
+	if _, err := e.Evaluate(context.Background(), "", "not a map"); err == nil {
+		t.Fatalf("expected error for non-map expected")
+	}
+	if _, err := e.Evaluate(context.Background(), "", map[string]any{"responses": []any{1}}); err == nil {
+		t.Fatalf("expected error for non-string response entry")
+	}
+
+	t.Run("identical trials pass", func(t *testing.T) {
+		res, err := e.Evaluate(context.Background(), "", map[string]any{
+			"responses": []string{"ok", "ok", "ok"},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Score != 1.0 {
+			t.Fatalf("expected pass, got %#v", res)
+		}
+	})
+
+	t.Run("differing trials fail by default", func(t *testing.T) {
+		res, err := e.Evaluate(context.Background(), "", map[string]any{
+			"responses": []string{"ok", "different"},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed || res.Score != 0.0 {
+			t.Fatalf("expected failure, got %#v", res)
+		}
+		if distinct, ok := res.Details["distinct"].([]string); !ok || len(distinct) != 2 {
+			t.Fatalf("Details: got %#v", res.Details)
+		}
+	})
+
+	t.Run("threshold allows near-duplicates", func(t *testing.T) {
+		res, err := e.Evaluate(context.Background(), "", map[string]any{
+			"responses": []string{"the quick brown fox", "the quick brown fox jumps"},
+			"threshold": 0.5,
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed {
+			t.Fatalf("expected pass under a lenient threshold, got %#v", res)
+		}
+	})
+
+	t.Run("single trial trivially passes", func(t *testing.T) {
+		res, err := e.Evaluate(context.Background(), "", map[string]any{
+			"responses": []string{"ok"},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Score != 1.0 {
+			t.Fatalf("expected pass, got %#v", res)
+		}
+	})
+}
@@ -110,6 +110,79 @@ func (NotContainsEvaluator) Evaluate(ctx context.Context, response string, expec
 	}, nil
 }
 
+// ContainsAtLeastEvaluator checks that at least a minimum number of
+// candidate substrings appear in the response — a middle ground between
+// ContainsEvaluator (all) and a single substring check (any), for
+// checklist-style outputs where only some of the items are expected.
+type ContainsAtLeastEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (ContainsAtLeastEvaluator) Name() string {
+	return "contains_at_least"
+}
+
+// Evaluate expects expected to be a map[string]any with "substrings"
+// ([]string) and "min_count" (number, default 1).
+func (ContainsAtLeastEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	cfg, ok := expected.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("contains_at_least: expected map[string]any, got %T", expected)
+	}
+
+	substrings, err := asStringSlice(cfg["substrings"])
+	if err != nil {
+		return nil, fmt.Errorf("contains_at_least: substrings: %w", err)
+	}
+
+	minCount := 1
+	if raw, ok := cfg["min_count"]; ok {
+		f, ok := asFloat(raw)
+		if !ok {
+			return nil, fmt.Errorf("contains_at_least: min_count must be number, got %T", raw)
+		}
+		if n := int(f); n > 0 {
+			minCount = n
+		}
+	}
+
+	total := len(substrings)
+	found := 0
+	var missing []string
+	var foundList []string
+	for _, s := range substrings {
+		if strings.Contains(response, s) {
+			found++
+			foundList = append(foundList, s)
+			continue
+		}
+		missing = append(missing, s)
+	}
+
+	passed := found >= minCount
+	score := 0.0
+	if total > 0 {
+		score = float64(found) / float64(total)
+	}
+
+	details := map[string]any{
+		"found": found,
+		"total": total,
+	}
+	if len(foundList) > 0 {
+		details["found_items"] = foundList
+	}
+	if len(missing) > 0 {
+		details["missing"] = missing
+	}
+
+	return &Result{
+		Passed:  passed,
+		Score:   score,
+		Message: fmt.Sprintf("found %d/%d (min %d required)", found, total, minCount),
+		Details: details,
+	}, nil
+}
+
 func asStringSlice(expected any) ([]string, error) {
 	switch v := expected.(type) {
 	case nil:
@@ -0,0 +1,82 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContainsAtLeastEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (ContainsAtLeastEvaluator{}).Name(); got != "contains_at_least" {
+		t.Fatalf("Name() = %q, want %q", got, "contains_at_least")
+	}
+}
+
+func TestContainsAtLeastEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := ContainsAtLeastEvaluator{}
+
+	{
+		_, err := e.Evaluate(context.Background(), "hello world", "not a map")
+		if err == nil {
+			t.Fatalf("expected error for non-map expected")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "hello world", map[string]any{"substrings": 123})
+		if err == nil {
+			t.Fatalf("expected error for non-list substrings")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "hello world", map[string]any{"substrings": []string{"hello"}, "min_count": "two"})
+		if err == nil {
+			t.Fatalf("expected error for non-numeric min_count")
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "The report covers latency and cost.", map[string]any{
+			"substrings": []string{"latency", "cost", "throughput", "availability"},
+			"min_count":  2,
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed {
+			t.Fatalf("expected pass with 2/4 found and min_count 2, got %#v", res)
+		}
+		if res.Details["found"] != 2 || res.Details["total"] != 4 {
+			t.Fatalf("Details: got %v", res.Details)
+		}
+		missing, ok := res.Details["missing"].([]string)
+		if !ok || len(missing) != 2 {
+			t.Fatalf("expected 2 missing items in Details, got %v", res.Details["missing"])
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "The report covers latency only.", map[string]any{
+			"substrings": []string{"latency", "cost", "throughput"},
+			"min_count":  2,
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed {
+			t.Fatalf("expected failure with only 1/3 found and min_count 2, got %#v", res)
+		}
+	}
+	{
+		// min_count defaults to 1 when omitted.
+		res, err := e.Evaluate(context.Background(), "cost is high", map[string]any{
+			"substrings": []string{"latency", "cost"},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed {
+			t.Fatalf("expected pass with default min_count 1, got %#v", res)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package evaluator
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -263,4 +264,57 @@ func TestSimilarityEvaluator_MoreBranches(t *testing.T) {
 			t.Fatalf("res=%#v", res)
 		}
 	})
+
+	t.Run("MultipleReferences_ScoresBestMatch", func(t *testing.T) {
+		e := &SimilarityEvaluator{
+			Provider: &stubProvider{name: "p", fn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+				_ = ctx
+				score := "0.2"
+				if strings.Contains(req.Messages[0].Content, "## Reference Answer\nParis") {
+					score = "0.95"
+				}
+				return textResponse(fmt.Sprintf(`{"score": %s, "reasoning": "matched"}`, score)), nil
+			}},
+		}
+		res, err := e.Evaluate(context.Background(), "The capital is Paris", map[string]any{
+			"references": []string{"London", "Paris", "Berlin"},
+			"min_score":  0.6,
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res == nil || !res.Passed || res.Score != 0.95 {
+			t.Fatalf("res=%#v", res)
+		}
+		if res.Details["matched_reference_index"] != 1 {
+			t.Fatalf("matched_reference_index=%#v", res.Details["matched_reference_index"])
+		}
+	})
+
+	t.Run("MultipleReferences_NoneClearThreshold", func(t *testing.T) {
+		e := &SimilarityEvaluator{
+			Provider: &stubProvider{name: "p", fn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+				_ = ctx
+				_ = req
+				return textResponse(`{"score": 0.1, "reasoning": "no match"}`), nil
+			}},
+		}
+		res, err := e.Evaluate(context.Background(), "x", map[string]any{
+			"references": []string{"a", "b"},
+			"min_score":  0.6,
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res == nil || res.Passed {
+			t.Fatalf("res=%#v", res)
+		}
+	})
+
+	t.Run("ReferencesTypeError", func(t *testing.T) {
+		e := &SimilarityEvaluator{Provider: &stubProvider{name: "p"}}
+		if _, err := e.Evaluate(context.Background(), "x", map[string]any{"references": 123}); err == nil {
+			t.Fatalf("expected error")
+		}
+	})
 }
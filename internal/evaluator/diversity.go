@@ -0,0 +1,150 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// diversitySimilarityThreshold is the Jaccard token-overlap above which two
+// items are treated as restatements of the same idea rather than distinct
+// answers. Set low enough that adding a filler word like "a" to an
+// otherwise identical item (e.g. "solar-powered backpack" vs "a solar
+// powered backpack") still counts as a restatement.
+const diversitySimilarityThreshold = 0.75
+
+// DiversityEvaluator checks that a response contains at least a minimum
+// number of distinct items, for prompts that ask a model to brainstorm
+// several different answers rather than one. Items are split on a
+// configured delimiter, normalized, and collapsed against near-duplicates
+// so padding out the count with reworded repeats doesn't pass.
+type DiversityEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (DiversityEvaluator) Name() string {
+	return "diversity"
+}
+
+// Evaluate splits the response into items and reports whether the number of
+// distinct items meets expected["min_distinct"].
+func (DiversityEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	cfg, ok := expected.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("diversity: expected map[string]any, got %T", expected)
+	}
+
+	delimiter, _ := cfg["delimiter"].(string)
+	if delimiter == "" {
+		delimiter = "\n"
+	}
+
+	minDistinct := 1
+	if raw, ok := cfg["min_distinct"]; ok {
+		f, ok := asFloat(raw)
+		if !ok {
+			return nil, fmt.Errorf("diversity: expected.min_distinct must be number, got %T", raw)
+		}
+		if n := int(f); n > 0 {
+			minDistinct = n
+		}
+	}
+
+	var items []string
+	for _, raw := range strings.Split(response, delimiter) {
+		item := strings.TrimSpace(raw)
+		if item == "" {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return &Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: "no items found in response",
+			Details: map[string]any{"distinct": 0, "total": 0},
+		}, nil
+	}
+
+	var distinct []string
+	var duplicates []string
+	for _, item := range items {
+		dup := false
+		for _, d := range distinct {
+			if diversitySimilar(item, d) {
+				dup = true
+				break
+			}
+		}
+		if dup {
+			duplicates = append(duplicates, item)
+			continue
+		}
+		distinct = append(distinct, item)
+	}
+
+	passed := len(distinct) >= minDistinct
+	score := float64(len(distinct)) / float64(minDistinct)
+	if score > 1.0 {
+		score = 1.0
+	}
+
+	details := map[string]any{
+		"distinct": len(distinct),
+		"total":    len(items),
+	}
+	if len(duplicates) > 0 {
+		details["duplicates"] = duplicates
+	}
+
+	return &Result{
+		Passed:  passed,
+		Score:   score,
+		Message: fmt.Sprintf("%d distinct item(s) of %d required", len(distinct), minDistinct),
+		Details: details,
+	}, nil
+}
+
+// diversitySimilar reports whether two items are near-duplicates: identical
+// once normalized, or with high enough token overlap to read as the same
+// idea restated.
+func diversitySimilar(a, b string) bool {
+	na, nb := normalizeDiversityItem(a), normalizeDiversityItem(b)
+	if na == nb {
+		return true
+	}
+	return jaccardSimilarity(diversityTokens(na), diversityTokens(nb)) >= diversitySimilarityThreshold
+}
+
+func normalizeDiversityItem(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// diversityTokens splits s into words, treating hyphens as word separators
+// so "solar-powered" collapses against "solar powered" instead of counting
+// as a single token that never overlaps with either word.
+func diversityTokens(s string) map[string]struct{} {
+	tokens := make(map[string]struct{})
+	for _, f := range strings.Fields(strings.ReplaceAll(s, "-", " ")) {
+		tokens[f] = struct{}{}
+	}
+	return tokens
+}
+
+func jaccardSimilarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1.0
+	}
+	intersection := 0
+	for t := range a {
+		if _, ok := b[t]; ok {
+			intersection++
+		}
+	}
+	union := len(a) + len(b) - intersection
+	if union == 0 {
+		return 0.0
+	}
+	return float64(intersection) / float64(union)
+}
@@ -0,0 +1,73 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDiversityEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (DiversityEvaluator{}).Name(); got != "diversity" {
+		t.Fatalf("Name() = %q, want %q", got, "diversity")
+	}
+}
+
+func TestDiversityEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := DiversityEvaluator{}
+
+	{
+		_, err := e.Evaluate(context.Background(), "a\nb", "not a map")
+		if err == nil {
+			t.Fatalf("expected error for non-map expected")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "a\nb", map[string]any{"min_distinct": "three"})
+		if err == nil {
+			t.Fatalf("expected error for non-numeric min_distinct")
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "\n\n", map[string]any{"min_distinct": 2})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed || res.Details["total"] != 0 {
+			t.Fatalf("empty response: got passed=%v details=%v", res.Passed, res.Details)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "Solar-powered backpack\nA smart umbrella\nSelf-watering plant pot", map[string]any{"min_distinct": 3})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Score != 1.0 || res.Details["distinct"] != 3 {
+			t.Fatalf("distinct items: got passed=%v score=%v details=%v", res.Passed, res.Score, res.Details)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "Solar-powered backpack\nA solar powered backpack\nSmart umbrella", map[string]any{"min_distinct": 3})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed || res.Details["distinct"] != 2 {
+			t.Fatalf("near-duplicate collapse: got passed=%v details=%v", res.Passed, res.Details)
+		}
+		dups, ok := res.Details["duplicates"].([]string)
+		if !ok || len(dups) != 1 {
+			t.Fatalf("expected 1 duplicate in Details, got %v", res.Details["duplicates"])
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "a;b;c", map[string]any{"delimiter": ";", "min_distinct": 3})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Details["total"] != 3 {
+			t.Fatalf("custom delimiter: got passed=%v details=%v", res.Passed, res.Details)
+		}
+	}
+}
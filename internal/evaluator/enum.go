@@ -0,0 +1,99 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// EnumEvaluator checks that a response is exactly one label from a fixed
+// set, for classification prompts that must output one of a known set of
+// categories. Unlike contains/regex, a response that includes extra text
+// around a valid label still fails — the whole (normalized) response must
+// equal one allowed label.
+type EnumEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (EnumEvaluator) Name() string {
+	return "enum"
+}
+
+// Evaluate expects expected to be a map[string]any with "labels" ([]string,
+// required) and an optional "expected_label" (string) to additionally
+// require the parsed label to equal a specific one.
+func (EnumEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	cfg, ok := expected.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("enum: expected map[string]any, got %T", expected)
+	}
+
+	labels, err := asStringSlice(cfg["labels"])
+	if err != nil {
+		return nil, fmt.Errorf("enum: labels: %w", err)
+	}
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("enum: labels must not be empty")
+	}
+
+	expectedLabel := ""
+	if raw, ok := cfg["expected_label"]; ok {
+		s, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("enum: expected.expected_label must be string, got %T", raw)
+		}
+		expectedLabel = normalizeEnumLabel(s)
+	}
+
+	parsed := normalizeEnumLabel(response)
+
+	var matched string
+	for _, label := range labels {
+		if normalizeEnumLabel(label) == parsed {
+			matched = label
+			break
+		}
+	}
+
+	inSet := matched != ""
+	passed := inSet
+	if inSet && expectedLabel != "" {
+		passed = normalizeEnumLabel(matched) == expectedLabel
+	}
+
+	score := 0.0
+	if passed {
+		score = 1.0
+	}
+
+	msg := fmt.Sprintf("%q is not one of %v", response, labels)
+	if inSet {
+		msg = fmt.Sprintf("parsed label %q", matched)
+		if expectedLabel != "" && !passed {
+			msg = fmt.Sprintf("parsed label %q, want %q", matched, expectedLabel)
+		}
+	}
+
+	return &Result{
+		Passed:  passed,
+		Score:   score,
+		Message: msg,
+		Details: map[string]any{
+			"parsed_label": matched,
+			"in_set":       inSet,
+			"labels":       labels,
+		},
+	}, nil
+}
+
+// normalizeEnumLabel trims surrounding whitespace and a single layer of
+// quotes, then lowercases, so labels like `"Positive"`, "positive\n", and
+// "positive" all compare equal.
+func normalizeEnumLabel(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 {
+		if (s[0] == '"' && s[len(s)-1] == '"') || (s[0] == '\'' && s[len(s)-1] == '\'') {
+			s = s[1 : len(s)-1]
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(s))
+}
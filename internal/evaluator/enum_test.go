@@ -0,0 +1,79 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEnumEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (EnumEvaluator{}).Name(); got != "enum" {
+		t.Fatalf("Name() = %q, want %q", got, "enum")
+	}
+}
+
+func TestEnumEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := EnumEvaluator{}
+
+	{
+		_, err := e.Evaluate(context.Background(), "positive", "not a map")
+		if err == nil {
+			t.Fatalf("expected error for non-map expected")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "positive", map[string]any{"labels": []string{}})
+		if err == nil {
+			t.Fatalf("expected error for empty labels")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "positive", map[string]any{"labels": []string{"positive", "negative"}, "expected_label": 123})
+		if err == nil {
+			t.Fatalf("expected error for non-string expected_label")
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "Positive\n", map[string]any{
+			"labels": []string{"positive", "negative", "neutral"},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Score != 1.0 {
+			t.Fatalf("expected pass for label in set, got %#v", res)
+		}
+		if res.Details["parsed_label"] != "positive" {
+			t.Fatalf("Details.parsed_label: got %v", res.Details["parsed_label"])
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "somewhat positive", map[string]any{
+			"labels": []string{"positive", "negative", "neutral"},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed || res.Details["in_set"] != false {
+			t.Fatalf("expected failure for response not exactly matching a label, got %#v", res)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "\"negative\"", map[string]any{
+			"labels":         []string{"positive", "negative", "neutral"},
+			"expected_label": "positive",
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed {
+			t.Fatalf("expected failure when parsed label doesn't match expected_label, got %#v", res)
+		}
+		if res.Details["parsed_label"] != "negative" {
+			t.Fatalf("Details.parsed_label: got %v", res.Details["parsed_label"])
+		}
+	}
+}
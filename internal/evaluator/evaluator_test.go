@@ -25,6 +25,48 @@ func TestRegistry(t *testing.T) {
 	}
 }
 
+type stubPluginEvaluator struct {
+	name string
+}
+
+func (e stubPluginEvaluator) Name() string { return e.name }
+
+func (e stubPluginEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	return &Result{Passed: true, Score: 1.0}, nil
+}
+
+func TestRegisterAndSeedGlobal(t *testing.T) {
+	globalMu.Lock()
+	globalRegistry = NewRegistry()
+	globalMu.Unlock()
+
+	Register(stubPluginEvaluator{name: "plugin_custom"})
+
+	r := NewRegistry()
+	SeedGlobal(r)
+
+	if _, ok := r.Get("plugin_custom"); !ok {
+		t.Fatalf("Get(plugin_custom) ok=false after SeedGlobal")
+	}
+}
+
+func TestSeedGlobal_DoesNotOverrideExisting(t *testing.T) {
+	globalMu.Lock()
+	globalRegistry = NewRegistry()
+	globalMu.Unlock()
+
+	Register(stubPluginEvaluator{name: "exact"})
+
+	r := NewRegistry()
+	r.Register(ExactEvaluator{})
+	SeedGlobal(r)
+
+	e, _ := r.Get("exact")
+	if _, ok := e.(ExactEvaluator); !ok {
+		t.Fatalf("SeedGlobal overrode pre-registered evaluator: got %T", e)
+	}
+}
+
 func TestExactEvaluator(t *testing.T) {
 	t.Parallel()
 
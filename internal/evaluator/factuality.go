@@ -37,6 +37,9 @@ func (e *FactualityEvaluator) Evaluate(ctx context.Context, response string, exp
 	}
 
 	groundTruth := strings.TrimSpace(e.GroundTruth)
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
 
 	switch v := expected.(type) {
 	case nil:
@@ -52,6 +55,29 @@ func (e *FactualityEvaluator) Evaluate(ctx context.Context, response string, exp
 			}
 			groundTruth = strings.TrimSpace(s)
 		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("factuality: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("factuality: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("factuality: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
 	default:
 		return nil, fmt.Errorf("factuality: expected must be string or map[string]any, got %T", expected)
 	}
@@ -73,8 +99,10 @@ func (e *FactualityEvaluator) Evaluate(ctx context.Context, response string, exp
 	prompt.WriteString("{\"has_error\": <true|false>, \"errors\": [\"<error 1>\", \"<error 2>\"], \"reasoning\": \"<brief explanation>\"}")
 
 	resp, err := e.Provider.Complete(ctx, &llm.Request{
-		Messages:  []llm.Message{{Role: "user", Content: prompt.String()}},
-		MaxTokens: 512,
+		Messages:    []llm.Message{{Role: "user", Content: prompt.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("factuality: llm: %w", err)
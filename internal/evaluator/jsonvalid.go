@@ -0,0 +1,141 @@
+package evaluator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// JSONValidEvaluator checks that a response is syntactically valid JSON. It
+// is lighter than JSONSchemaEvaluator when only validity (not shape) needs
+// checking: the base check only requires the response to start with a
+// well-formed JSON value, while the optional "canonical" flag additionally
+// rejects trailing data after that value and duplicate object keys.
+type JSONValidEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (JSONValidEvaluator) Name() string {
+	return "json_valid"
+}
+
+// Evaluate parses response as JSON. expected, if non-nil, is a
+// map[string]any with an optional "canonical" bool.
+func (JSONValidEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	canonical := false
+	if m, ok := expected.(map[string]any); ok {
+		if v, ok := m["canonical"].(bool); ok {
+			canonical = v
+		}
+	}
+
+	dec := json.NewDecoder(strings.NewReader(response))
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return &Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: fmt.Sprintf("invalid json: %s", jsonErrorPosition(err)),
+			Details: map[string]any{"error": err.Error()},
+		}, nil
+	}
+
+	if !canonical {
+		return &Result{Passed: true, Score: 1.0, Message: "valid json"}, nil
+	}
+
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		if err == nil {
+			err = fmt.Errorf("trailing data after JSON value")
+		}
+		return &Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: fmt.Sprintf("not canonical: %s", jsonErrorPosition(err)),
+			Details: map[string]any{"error": err.Error()},
+		}, nil
+	}
+
+	if path, ok := findDuplicateKey([]byte(response)); ok {
+		return &Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: fmt.Sprintf("not canonical: duplicate key at %s", path),
+		}, nil
+	}
+
+	return &Result{Passed: true, Score: 1.0, Message: "valid canonical json"}, nil
+}
+
+// jsonErrorPosition formats a JSON decode error together with the byte
+// offset it occurred at, when the decoder reports one.
+func jsonErrorPosition(err error) string {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return fmt.Sprintf("%s (offset %d)", err.Error(), syntaxErr.Offset)
+	}
+	return err.Error()
+}
+
+// findDuplicateKey walks data looking for the first object with a repeated
+// key, returning its path in the same "$.field[idx]" notation as
+// JSONSchemaEvaluator's validation errors.
+func findDuplicateKey(data []byte) (string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	path, dup, _ := duplicateKeyWalk(dec, "$")
+	return path, dup
+}
+
+func duplicateKeyWalk(dec *json.Decoder, path string) (string, bool, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", false, err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return "", false, nil
+	}
+
+	switch delim {
+	case '{':
+		seen := make(map[string]struct{})
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return "", false, err
+			}
+			key, _ := keyTok.(string)
+			if _, ok := seen[key]; ok {
+				return fmt.Sprintf("%s.%s", path, key), true, nil
+			}
+			seen[key] = struct{}{}
+
+			if childPath, dup, err := duplicateKeyWalk(dec, fmt.Sprintf("%s.%s", path, key)); err != nil {
+				return "", false, err
+			} else if dup {
+				return childPath, true, nil
+			}
+		}
+		_, err := dec.Token() // consume '}'
+		return "", false, err
+
+	case '[':
+		i := 0
+		for dec.More() {
+			if childPath, dup, err := duplicateKeyWalk(dec, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return "", false, err
+			} else if dup {
+				return childPath, true, nil
+			}
+			i++
+		}
+		_, err := dec.Token() // consume ']'
+		return "", false, err
+	}
+
+	return "", false, nil
+}
@@ -0,0 +1,121 @@
+package evaluator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestJSONValidEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (JSONValidEvaluator{}).Name(); got != "json_valid" {
+		t.Fatalf("Name() = %q, want %q", got, "json_valid")
+	}
+}
+
+func TestJSONValidEvaluator_ValidJSON(t *testing.T) {
+	t.Parallel()
+
+	e := JSONValidEvaluator{}
+	res, err := e.Evaluate(context.Background(), `{"a": 1}`, nil)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed || res.Score != 1.0 {
+		t.Fatalf("got %#v, want passed", res)
+	}
+}
+
+func TestJSONValidEvaluator_InvalidJSONReportsOffset(t *testing.T) {
+	t.Parallel()
+
+	e := JSONValidEvaluator{}
+	res, err := e.Evaluate(context.Background(), `{"a": }`, nil)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed {
+		t.Fatalf("expected failure for malformed json")
+	}
+	if !strings.Contains(res.Message, "offset") {
+		t.Fatalf("Message: got %q, want it to include the parse error offset", res.Message)
+	}
+}
+
+func TestJSONValidEvaluator_TrailingDataOnlyFailsWhenCanonical(t *testing.T) {
+	t.Parallel()
+
+	e := JSONValidEvaluator{}
+
+	res, err := e.Evaluate(context.Background(), `{"a": 1} extra`, nil)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed {
+		t.Fatalf("non-canonical check should ignore trailing data, got %#v", res)
+	}
+
+	res, err = e.Evaluate(context.Background(), `{"a": 1} extra`, map[string]any{"canonical": true})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed {
+		t.Fatalf("canonical check should reject trailing data, got %#v", res)
+	}
+}
+
+func TestJSONValidEvaluator_DuplicateKeyOnlyFailsWhenCanonical(t *testing.T) {
+	t.Parallel()
+
+	e := JSONValidEvaluator{}
+	dup := `{"a": 1, "b": {"c": 1, "c": 2}}`
+
+	res, err := e.Evaluate(context.Background(), dup, nil)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed {
+		t.Fatalf("non-canonical check should ignore duplicate keys, got %#v", res)
+	}
+
+	res, err = e.Evaluate(context.Background(), dup, map[string]any{"canonical": true})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed {
+		t.Fatalf("canonical check should reject duplicate keys, got %#v", res)
+	}
+	if !strings.Contains(res.Message, "$.b.c") {
+		t.Fatalf("Message: got %q, want the duplicate key path", res.Message)
+	}
+}
+
+func TestJSONValidEvaluator_DuplicateKeyInArray(t *testing.T) {
+	t.Parallel()
+
+	e := JSONValidEvaluator{}
+	res, err := e.Evaluate(context.Background(), `[{"a": 1}, {"a": 1, "a": 2}]`, map[string]any{"canonical": true})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed {
+		t.Fatalf("expected failure for duplicate key nested in array")
+	}
+	if !strings.Contains(res.Message, "$[1].a") {
+		t.Fatalf("Message: got %q, want the duplicate key path", res.Message)
+	}
+}
+
+func TestJSONValidEvaluator_CanonicalJSONPasses(t *testing.T) {
+	t.Parallel()
+
+	e := JSONValidEvaluator{}
+	res, err := e.Evaluate(context.Background(), `{"a": 1, "b": [1, 2, 3]}`, map[string]any{"canonical": true})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed {
+		t.Fatalf("got %#v, want passed", res)
+	}
+}
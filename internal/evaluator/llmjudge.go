@@ -80,6 +80,9 @@ func (e *LLMJudgeEvaluator) Evaluate(ctx context.Context, response string, expec
 	scoreScale := e.ScoreScale
 	scoreThreshold := e.ScoreThreshold
 	contextText := ""
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
 
 	if scoreScale <= 0 {
 		scoreScale = 5
@@ -143,6 +146,29 @@ func (e *LLMJudgeEvaluator) Evaluate(ctx context.Context, response string, expec
 			}
 			scoreThreshold = f
 		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("llm_judge: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("llm_judge: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("llm_judge: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
 	default:
 		return nil, fmt.Errorf("llm_judge: expected must be string or map[string]any, got %T", expected)
 	}
@@ -178,8 +204,10 @@ func (e *LLMJudgeEvaluator) Evaluate(ctx context.Context, response string, expec
 	}
 
 	resp, err := e.Provider.Complete(ctx, &llm.Request{
-		Messages:  []llm.Message{{Role: "user", Content: promptBuf.String()}},
-		MaxTokens: 512,
+		Messages:    []llm.Message{{Role: "user", Content: promptBuf.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("llm_judge: llm: %w", err)
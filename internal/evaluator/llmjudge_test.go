@@ -101,6 +101,58 @@ func TestLLMJudgeEvaluator_Evaluate(t *testing.T) {
 	}
 }
 
+func TestLLMJudgeEvaluator_LLMParamsOverride(t *testing.T) {
+	t.Parallel()
+
+	var gotModel string
+	var gotTemperature float64
+	var gotMaxTokens float64
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		b, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		_ = json.Unmarshal(b, &req)
+		gotModel, _ = req["model"].(string)
+		gotTemperature, _ = req["temperature"].(float64)
+		gotMaxTokens, _ = req["max_tokens"].(float64)
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(messageResponse(
+			"msg_1",
+			req["model"].(string),
+			"end_turn",
+			[]map[string]any{textBlock(`{"score": 4, "reasoning": "ok"}`)},
+			1,
+			1,
+		))
+	}))
+	t.Cleanup(srv.Close)
+
+	provider := llm.NewClaudeProvider("k", srv.URL+"/v1", "claude-default")
+	e := &LLMJudgeEvaluator{Provider: provider}
+
+	_, err := e.Evaluate(context.Background(), "A", map[string]any{
+		"criteria":    "Be strict.",
+		"score_scale": 5,
+		"temperature": 0.4,
+		"max_tokens":  128,
+		"model":       "claude-override",
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if gotModel != "claude-override" {
+		t.Fatalf("model: got %q want %q", gotModel, "claude-override")
+	}
+	if gotTemperature != 0.4 {
+		t.Fatalf("temperature: got %v want 0.4", gotTemperature)
+	}
+	if gotMaxTokens != 128 {
+		t.Fatalf("max_tokens: got %v want 128", gotMaxTokens)
+	}
+}
+
 func TestLLMJudgeEvaluator_InvalidJSON(t *testing.T) {
 	t.Parallel()
 
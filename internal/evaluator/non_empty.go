@@ -0,0 +1,35 @@
+package evaluator
+
+import (
+	"context"
+	"strings"
+)
+
+// NonEmptyEvaluator checks that the response isn't blank. It ignores
+// expected entirely, which makes it a reasonable stand-in for a case that
+// configures no other assertion (see testcase.ApplyNoAssertionsPolicy):
+// better than silently passing with nothing checked at all, without
+// requiring a real assertion to be written.
+type NonEmptyEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (NonEmptyEvaluator) Name() string {
+	return "non_empty"
+}
+
+// Evaluate passes if response has any non-whitespace content.
+func (NonEmptyEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	passed := strings.TrimSpace(response) != ""
+	score := 0.0
+	msg := "response is empty"
+	if passed {
+		score = 1.0
+		msg = "response is non-empty"
+	}
+
+	return &Result{
+		Passed:  passed,
+		Score:   score,
+		Message: msg,
+	}, nil
+}
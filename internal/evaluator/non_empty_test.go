@@ -0,0 +1,36 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNonEmptyEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (NonEmptyEvaluator{}).Name(); got != "non_empty" {
+		t.Fatalf("Name() = %q, want %q", got, "non_empty")
+	}
+}
+
+func TestNonEmptyEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := NonEmptyEvaluator{}
+
+	res, err := e.Evaluate(context.Background(), "hello", nil)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed || res.Score != 1.0 {
+		t.Fatalf("expected pass for non-empty response, got %#v", res)
+	}
+
+	res, err = e.Evaluate(context.Background(), "   \n\t", nil)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed || res.Score != 0.0 {
+		t.Fatalf("expected failure for whitespace-only response, got %#v", res)
+	}
+}
@@ -0,0 +1,153 @@
+package evaluator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPIEvaluator validates JSON output against the request body schema of a
+// named operation in an OpenAPI document. It is higher-level than
+// JSONSchemaEvaluator: instead of an inline schema, the schema is resolved
+// from a spec file plus an operationId, matching how agents typically
+// produce API-call payloads for a known endpoint.
+type OpenAPIEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (OpenAPIEvaluator) Name() string {
+	return "openapi"
+}
+
+// Evaluate resolves the request body schema for expected["operation_id"] in
+// the document at expected["spec"] and validates the response JSON against
+// it.
+func (OpenAPIEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	cfg, ok := expected.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("openapi: expected map[string]any, got %T", expected)
+	}
+
+	specPath, _ := cfg["spec"].(string)
+	specPath = strings.TrimSpace(specPath)
+	if specPath == "" {
+		return nil, errors.New("openapi: missing spec")
+	}
+	operationID, _ := cfg["operation_id"].(string)
+	operationID = strings.TrimSpace(operationID)
+	if operationID == "" {
+		return nil, errors.New("openapi: missing operation_id")
+	}
+
+	schema, err := resolveOperationRequestSchema(specPath, operationID)
+	if err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+
+	var value any
+	dec := json.NewDecoder(strings.NewReader(response))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return &Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: "invalid json",
+			Details: map[string]any{"error": err.Error()},
+		}, nil
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		if err == nil {
+			err = fmt.Errorf("extra data after JSON value")
+		}
+		return &Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: "invalid json",
+			Details: map[string]any{"error": err.Error()},
+		}, nil
+	}
+
+	if err := validateJSONSchema(value, schema, "$"); err != nil {
+		var se *schemaError
+		if errors.As(err, &se) {
+			return nil, fmt.Errorf("openapi: operation %q: %w", operationID, err)
+		}
+		return &Result{
+			Passed:  false,
+			Score:   0.0,
+			Message: fmt.Sprintf("does not match operation %q request schema", operationID),
+			Details: map[string]any{"path": err.Error()},
+		}, nil
+	}
+
+	return &Result{
+		Passed:  true,
+		Score:   1.0,
+		Message: fmt.Sprintf("valid against operation %q request schema", operationID),
+	}, nil
+}
+
+// resolveOperationRequestSchema loads the OpenAPI document at path and
+// returns the application/json request body schema for the given
+// operationId.
+func resolveOperationRequestSchema(path string, operationID string) (map[string]any, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read spec %q: %w", path, err)
+	}
+
+	var doc map[string]any
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("parse spec %q: %w", path, err)
+	}
+
+	paths, ok := doc["paths"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("spec %q: missing paths", path)
+	}
+
+	for _, rawPathItem := range paths {
+		pathItem, ok := rawPathItem.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, rawOperation := range pathItem {
+			operation, ok := rawOperation.(map[string]any)
+			if !ok {
+				continue
+			}
+			id, _ := operation["operationId"].(string)
+			if id != operationID {
+				continue
+			}
+			return requestBodySchema(operation)
+		}
+	}
+
+	return nil, fmt.Errorf("spec %q: operation %q not found", path, operationID)
+}
+
+func requestBodySchema(operation map[string]any) (map[string]any, error) {
+	requestBody, ok := operation["requestBody"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("operation has no requestBody")
+	}
+	content, ok := requestBody["content"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("requestBody has no content")
+	}
+	media, ok := content["application/json"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("requestBody has no application/json content")
+	}
+	schema, ok := media["schema"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("application/json content has no schema")
+	}
+	return schema, nil
+}
@@ -0,0 +1,113 @@
+package evaluator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testOpenAPISpec = `
+openapi: 3.0.0
+info:
+  title: test
+  version: "1.0"
+paths:
+  /orders:
+    post:
+      operationId: createOrder
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required:
+                - sku
+              properties:
+                sku:
+                  type: string
+                qty:
+                  type: integer
+`
+
+func writeTestSpec(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "spec.yaml")
+	if err := os.WriteFile(path, []byte(testOpenAPISpec), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestOpenAPIEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (OpenAPIEvaluator{}).Name(); got != "openapi" {
+		t.Fatalf("Name() = %q, want %q", got, "openapi")
+	}
+}
+
+func TestOpenAPIEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := OpenAPIEvaluator{}
+	spec := writeTestSpec(t)
+
+	{
+		_, err := e.Evaluate(context.Background(), "{}", "not a map")
+		if err == nil {
+			t.Fatalf("expected error for non-map expected")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "{}", map[string]any{"operation_id": "createOrder"})
+		if err == nil {
+			t.Fatalf("expected error for missing spec")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "{}", map[string]any{"spec": spec})
+		if err == nil {
+			t.Fatalf("expected error for missing operation_id")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "{}", map[string]any{"spec": spec, "operation_id": "doesNotExist"})
+		if err == nil {
+			t.Fatalf("expected error for unknown operation")
+		}
+	}
+
+	expected := map[string]any{"spec": spec, "operation_id": "createOrder"}
+
+	{
+		res, err := e.Evaluate(context.Background(), `{"sku":"abc-123","qty":2}`, expected)
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Score != 1.0 {
+			t.Fatalf("valid: got passed=%v score=%v msg=%q", res.Passed, res.Score, res.Message)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), `{"qty":2}`, expected)
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed || res.Score != 0.0 {
+			t.Fatalf("missing required: got passed=%v score=%v", res.Passed, res.Score)
+		}
+		if res.Details["path"] == nil {
+			t.Fatalf("expected Details[path] on failure, got %v", res.Details)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), `not json`, expected)
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed || res.Score != 0.0 {
+			t.Fatalf("invalid json: got passed=%v score=%v", res.Passed, res.Score)
+		}
+	}
+}
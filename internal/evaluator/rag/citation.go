@@ -0,0 +1,103 @@
+package rag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+)
+
+// citationPattern matches bracketed citation markers like "[1]" or "[Doc A]"
+// and bare URLs, the two forms RAG responses typically use to cite sources.
+var citationPattern = regexp.MustCompile(`\[[^\]\n]+\]|https?://\S+`)
+
+// CitationEvaluator checks that every citation marker or URL in the response
+// is grounded in the provided retrieval context, i.e. actually appears in it.
+// Unlike FaithfulnessEvaluator it does not call an LLM: it is a plain
+// substring check over the literal citation text.
+type CitationEvaluator struct{}
+
+func (CitationEvaluator) Name() string {
+	return "citation"
+}
+
+func (CitationEvaluator) Evaluate(ctx context.Context, response string, expected any) (*evaluator.Result, error) {
+	_ = ctx
+
+	contextText := ""
+
+	switch v := expected.(type) {
+	case nil:
+	case map[string]any:
+		if raw, ok := v["context"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("citation: expected.context must be string, got %T", raw)
+			}
+			contextText = strings.TrimSpace(s)
+		}
+	default:
+		return nil, fmt.Errorf("citation: expected must be map[string]any, got %T", expected)
+	}
+
+	if contextText == "" {
+		return nil, errors.New("citation: missing context")
+	}
+
+	citations := dedupeCitations(citationPattern.FindAllString(response, -1))
+	if len(citations) == 0 {
+		return &evaluator.Result{
+			Passed:  true,
+			Score:   1.0,
+			Message: "no citations found in response",
+		}, nil
+	}
+
+	grounded := 0
+	var ungrounded []string
+	for _, c := range citations {
+		marker := strings.Trim(c, "[]")
+		if strings.Contains(contextText, marker) {
+			grounded++
+			continue
+		}
+		ungrounded = append(ungrounded, c)
+	}
+
+	total := len(citations)
+	score := float64(grounded) / float64(total)
+	passed := len(ungrounded) == 0
+
+	details := map[string]any{
+		"grounded": grounded,
+		"total":    total,
+	}
+	if !passed {
+		details["ungrounded"] = ungrounded
+	}
+
+	return &evaluator.Result{
+		Passed:  passed,
+		Score:   score,
+		Message: fmt.Sprintf("grounded %d/%d citations", grounded, total),
+		Details: details,
+	}, nil
+}
+
+// dedupeCitations preserves first-seen order while dropping repeats, so a
+// citation reused several times in one response is only scored once.
+func dedupeCitations(citations []string) []string {
+	seen := make(map[string]struct{}, len(citations))
+	out := make([]string, 0, len(citations))
+	for _, c := range citations {
+		if _, ok := seen[c]; ok {
+			continue
+		}
+		seen[c] = struct{}{}
+		out = append(out, c)
+	}
+	return out
+}
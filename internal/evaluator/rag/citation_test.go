@@ -0,0 +1,75 @@
+package rag
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCitationEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if (CitationEvaluator{}).Name() != "citation" {
+		t.Fatalf("CitationEvaluator.Name: unexpected")
+	}
+}
+
+func TestCitationEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := CitationEvaluator{}
+
+	if _, err := e.Evaluate(context.Background(), "r", "x"); err == nil {
+		t.Fatalf("Evaluate(bad expected type): expected error")
+	}
+	if _, err := e.Evaluate(context.Background(), "r", map[string]any{"context": 1}); err == nil {
+		t.Fatalf("Evaluate(context not string): expected error")
+	}
+	if _, err := e.Evaluate(context.Background(), "r", map[string]any{"context": "  "}); err == nil {
+		t.Fatalf("Evaluate(missing context): expected error")
+	}
+
+	res, err := e.Evaluate(context.Background(), "no citations here", map[string]any{"context": "c"})
+	if err != nil {
+		t.Fatalf("Evaluate(no citations): %v", err)
+	}
+	if res == nil || !res.Passed || res.Score != 1 {
+		t.Fatalf("no citations result: %#v", res)
+	}
+
+	res, err = e.Evaluate(context.Background(), "Paris is the capital [Doc A] and see https://example.com/paris", map[string]any{
+		"context": "Doc A: Paris is the capital of France. https://example.com/paris",
+	})
+	if err != nil {
+		t.Fatalf("Evaluate(grounded): %v", err)
+	}
+	if res == nil || !res.Passed || res.Score != 1 {
+		t.Fatalf("grounded result: %#v", res)
+	}
+	if res.Details["grounded"] != 2 || res.Details["total"] != 2 {
+		t.Fatalf("Details: %#v", res.Details)
+	}
+
+	res, err = e.Evaluate(context.Background(), "Paris is the capital [Doc B]", map[string]any{
+		"context": "Doc A: Paris is the capital of France.",
+	})
+	if err != nil {
+		t.Fatalf("Evaluate(ungrounded): %v", err)
+	}
+	if res == nil || res.Passed || res.Score != 0 {
+		t.Fatalf("ungrounded result: %#v", res)
+	}
+	ungrounded, ok := res.Details["ungrounded"].([]string)
+	if !ok || len(ungrounded) != 1 || ungrounded[0] != "[Doc B]" {
+		t.Fatalf("Details.ungrounded: %#v", res.Details)
+	}
+
+	res, err = e.Evaluate(context.Background(), "[Doc A] and [Doc A] again", map[string]any{
+		"context": "Doc A: source text",
+	})
+	if err != nil {
+		t.Fatalf("Evaluate(dedupe): %v", err)
+	}
+	if res.Details["total"] != 1 {
+		t.Fatalf("Details.total: expected repeated citation to be deduped, got %#v", res.Details)
+	}
+}
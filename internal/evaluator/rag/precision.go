@@ -36,6 +36,9 @@ func (e *PrecisionEvaluator) Evaluate(ctx context.Context, response string, expe
 	contextText := ""
 	question := ""
 	threshold := 0.0
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
 
 	switch v := expected.(type) {
 	case nil:
@@ -61,6 +64,29 @@ func (e *PrecisionEvaluator) Evaluate(ctx context.Context, response string, expe
 			}
 			threshold = f
 		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("precision: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("precision: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("precision: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
 	default:
 		return nil, fmt.Errorf("precision: expected must be map[string]any, got %T", expected)
 	}
@@ -92,8 +118,10 @@ func (e *PrecisionEvaluator) Evaluate(ctx context.Context, response string, expe
 	prompt.WriteString("{\"score\": <number 0.0-1.0>, \"reasoning\": \"<brief explanation>\"}")
 
 	resp, err := e.Client.Complete(ctx, &llm.Request{
-		Messages:  []llm.Message{{Role: "user", Content: prompt.String()}},
-		MaxTokens: 512,
+		Messages:    []llm.Message{{Role: "user", Content: prompt.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("precision: llm: %w", err)
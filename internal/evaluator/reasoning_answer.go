@@ -0,0 +1,155 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ReasoningAnswerEvaluator checks that a response is split into a
+// non-empty reasoning section followed by a final answer, then applies a
+// nested exact/contains/numeric check to only the answer section. This
+// keeps expected text that happens to appear in the reasoning (e.g. a
+// distractor value the model correctly rules out) from being counted as a
+// match.
+type ReasoningAnswerEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (ReasoningAnswerEvaluator) Name() string {
+	return "reasoning_answer"
+}
+
+// Evaluate expects expected to be a map[string]any with:
+//   - "delimiter" (string, required): the marker separating the reasoning
+//     section from the final answer, e.g. "Final Answer:". response is
+//     split on the first occurrence.
+//   - "answer_type" (string, required): "exact", "contains", or "numeric",
+//     selecting how the answer section is checked.
+//   - "answer" (required): the expected value passed to the nested check —
+//     a string for "exact" and "numeric", a string or []string for
+//     "contains".
+func (ReasoningAnswerEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	cfg, ok := expected.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("reasoning_answer: expected map[string]any, got %T", expected)
+	}
+
+	delimiter, ok := cfg["delimiter"].(string)
+	if !ok || strings.TrimSpace(delimiter) == "" {
+		return nil, fmt.Errorf("reasoning_answer: delimiter must be a non-empty string")
+	}
+
+	answerType, ok := cfg["answer_type"].(string)
+	if !ok || strings.TrimSpace(answerType) == "" {
+		return nil, fmt.Errorf("reasoning_answer: answer_type must be a non-empty string")
+	}
+
+	idx := strings.Index(response, delimiter)
+	if idx < 0 {
+		return &Result{
+			Passed:  false,
+			Score:   0,
+			Message: fmt.Sprintf("response does not contain delimiter %q", delimiter),
+			Details: map[string]any{
+				"reasoning": response,
+				"answer":    "",
+			},
+		}, nil
+	}
+
+	reasoning := strings.TrimSpace(response[:idx])
+	answer := strings.TrimSpace(response[idx+len(delimiter):])
+	details := map[string]any{
+		"reasoning": reasoning,
+		"answer":    answer,
+	}
+
+	if reasoning == "" {
+		return &Result{
+			Passed:  false,
+			Score:   0,
+			Message: "reasoning section is empty",
+			Details: details,
+		}, nil
+	}
+	if answer == "" {
+		return &Result{
+			Passed:  false,
+			Score:   0,
+			Message: "answer section is empty",
+			Details: details,
+		}, nil
+	}
+
+	var nested *Result
+	var err error
+	switch answerType {
+	case "exact":
+		nested, err = ExactEvaluator{}.Evaluate(ctx, answer, cfg["answer"])
+	case "contains":
+		nested, err = ContainsEvaluator{}.Evaluate(ctx, answer, cfg["answer"])
+	case "numeric":
+		nested, err = evaluateNumericAnswer(answer, cfg["answer"])
+	default:
+		return nil, fmt.Errorf("reasoning_answer: answer_type must be exact|contains|numeric, got %q", answerType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reasoning_answer: %w", err)
+	}
+
+	for k, v := range nested.Details {
+		details[k] = v
+	}
+
+	return &Result{
+		Passed:  nested.Passed,
+		Score:   nested.Score,
+		Message: nested.Message,
+		Details: details,
+	}, nil
+}
+
+// evaluateNumericAnswer compares the answer section to expected as
+// numbers, tolerating surrounding whitespace and thousands separators.
+func evaluateNumericAnswer(answer string, expected any) (*Result, error) {
+	expNum, ok := asFloat(expected)
+	if !ok {
+		if s, isStr := expected.(string); isStr {
+			expNum, ok = parseNumericAnswer(s)
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("numeric: expected number, got %T", expected)
+	}
+
+	gotNum, ok := parseNumericAnswer(answer)
+	if !ok {
+		return &Result{
+			Passed:  false,
+			Score:   0,
+			Message: fmt.Sprintf("answer %q is not a number", answer),
+		}, nil
+	}
+
+	passed := gotNum == expNum
+	score := 0.0
+	if passed {
+		score = 1.0
+	}
+
+	return &Result{
+		Passed:  passed,
+		Score:   score,
+		Message: fmt.Sprintf("got %v, want %v", gotNum, expNum),
+	}, nil
+}
+
+func parseNumericAnswer(s string) (float64, bool) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, ",", ""))
+	if s == "" {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
@@ -0,0 +1,151 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestReasoningAnswerEvaluator_Name(t *testing.T) {
+	t.Parallel()
+
+	if got := (ReasoningAnswerEvaluator{}).Name(); got != "reasoning_answer" {
+		t.Fatalf("Name() = %q, want %q", got, "reasoning_answer")
+	}
+}
+
+func TestReasoningAnswerEvaluator(t *testing.T) {
+	t.Parallel()
+
+	e := ReasoningAnswerEvaluator{}
+
+	{
+		_, err := e.Evaluate(context.Background(), "response", "not a map")
+		if err == nil {
+			t.Fatalf("expected error for non-map expected")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "response", map[string]any{"answer_type": "exact", "answer": "x"})
+		if err == nil {
+			t.Fatalf("expected error for missing delimiter")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "response", map[string]any{"delimiter": "Answer:", "answer": "x"})
+		if err == nil {
+			t.Fatalf("expected error for missing answer_type")
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "reasoning\nAnswer: x", map[string]any{"delimiter": "Answer:", "answer_type": "bogus", "answer": "x"})
+		if err == nil {
+			t.Fatalf("expected error for unknown answer_type")
+		}
+	}
+
+	{
+		res, err := e.Evaluate(context.Background(), "I thought about it carefully.", map[string]any{
+			"delimiter": "Answer:", "answer_type": "exact", "answer": "42",
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed {
+			t.Fatalf("expected failure when delimiter is absent, got %#v", res)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "Answer: 42", map[string]any{
+			"delimiter": "Answer:", "answer_type": "exact", "answer": "42",
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed {
+			t.Fatalf("expected failure for empty reasoning section, got %#v", res)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "Because 40+2=42.\nAnswer:  ", map[string]any{
+			"delimiter": "Answer:", "answer_type": "exact", "answer": "42",
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed {
+			t.Fatalf("expected failure for empty answer section, got %#v", res)
+		}
+	}
+
+	{
+		res, err := e.Evaluate(context.Background(), "Because 40+2=42, the answer is 42.\nAnswer: 42", map[string]any{
+			"delimiter": "Answer:", "answer_type": "exact", "answer": "42",
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Score != 1.0 {
+			t.Fatalf("expected pass for exact match, got %#v", res)
+		}
+		if res.Details["reasoning"] != "Because 40+2=42, the answer is 42." {
+			t.Fatalf("Details.reasoning: got %v", res.Details["reasoning"])
+		}
+		if res.Details["answer"] != "42" {
+			t.Fatalf("Details.answer: got %v", res.Details["answer"])
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "The capital of France is Paris, not London.\nAnswer: London", map[string]any{
+			"delimiter": "Answer:", "answer_type": "exact", "answer": "Paris",
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed {
+			t.Fatalf("expected failure when the answer section itself is wrong, even though the reasoning mentions the expected text: %#v", res)
+		}
+	}
+
+	{
+		res, err := e.Evaluate(context.Background(), "It's definitely one of the primary colors.\nAnswer: the sky is blue today", map[string]any{
+			"delimiter": "Answer:", "answer_type": "contains", "answer": []string{"blue"},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed {
+			t.Fatalf("expected pass for contains match, got %#v", res)
+		}
+	}
+
+	{
+		res, err := e.Evaluate(context.Background(), "40 plus 2 is 42.\nAnswer: 42", map[string]any{
+			"delimiter": "Answer:", "answer_type": "numeric", "answer": 42.0,
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if !res.Passed || res.Score != 1.0 {
+			t.Fatalf("expected pass for numeric match, got %#v", res)
+		}
+	}
+	{
+		res, err := e.Evaluate(context.Background(), "It's roughly forty something.\nAnswer: about 42", map[string]any{
+			"delimiter": "Answer:", "answer_type": "numeric", "answer": "42",
+		})
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if res.Passed {
+			t.Fatalf("expected failure for non-numeric answer section, got %#v", res)
+		}
+	}
+	{
+		_, err := e.Evaluate(context.Background(), "reasoning\nAnswer: 42", map[string]any{
+			"delimiter": "Answer:", "answer_type": "numeric", "answer": true,
+		})
+		if err == nil {
+			t.Fatalf("expected error for non-numeric expected value")
+		}
+	}
+}
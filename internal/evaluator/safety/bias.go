@@ -36,6 +36,9 @@ func (e *BiasEvaluator) Evaluate(ctx context.Context, response string, expected
 
 	categories := []string(nil)
 	threshold := 0.1
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
 
 	switch v := expected.(type) {
 	case nil:
@@ -54,6 +57,29 @@ func (e *BiasEvaluator) Evaluate(ctx context.Context, response string, expected
 			}
 			threshold = f
 		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("bias: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("bias: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("bias: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
 	default:
 		return nil, fmt.Errorf("bias: expected must be map[string]any, got %T", expected)
 	}
@@ -88,8 +114,10 @@ func (e *BiasEvaluator) Evaluate(ctx context.Context, response string, expected
 	prompt.WriteString("{\"bias\": <number 0.0-1.0>, \"reasoning\": \"<brief explanation>\", \"detected\": [\"<issue>\", ...]}")
 
 	resp, err := e.Client.Complete(ctx, &llm.Request{
-		Messages:  []llm.Message{{Role: "user", Content: prompt.String()}},
-		MaxTokens: 512,
+		Messages:    []llm.Message{{Role: "user", Content: prompt.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("bias: llm: %w", err)
@@ -37,6 +37,9 @@ func (e *HallucinationEvaluator) Evaluate(ctx context.Context, response string,
 
 	groundTruth := ""
 	threshold := 0.9
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
 
 	switch v := expected.(type) {
 	case nil:
@@ -55,6 +58,29 @@ func (e *HallucinationEvaluator) Evaluate(ctx context.Context, response string,
 			}
 			threshold = f
 		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("hallucination: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("hallucination: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("hallucination: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
 	default:
 		return nil, fmt.Errorf("hallucination: expected must be map[string]any, got %T", expected)
 	}
@@ -83,8 +109,10 @@ func (e *HallucinationEvaluator) Evaluate(ctx context.Context, response string,
 	prompt.WriteString("{\"score\": <number 0.0-1.0>, \"reasoning\": \"<brief explanation>\", \"hallucinations\": [\"<unsupported claim>\", ...], \"contradictions\": [\"<contradiction>\", ...]}")
 
 	resp, err := e.Client.Complete(ctx, &llm.Request{
-		Messages:  []llm.Message{{Role: "user", Content: prompt.String()}},
-		MaxTokens: 512,
+		Messages:    []llm.Message{{Role: "user", Content: prompt.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("hallucination: llm: %w", err)
@@ -37,7 +37,11 @@ func (e *SimilarityEvaluator) Evaluate(ctx context.Context, response string, exp
 	}
 
 	reference := strings.TrimSpace(e.Reference)
+	var references []string
 	minScore := e.MinScore
+	temperature := 0.0
+	maxTokens := 512
+	model := ""
 	if minScore <= 0 {
 		minScore = 0.6
 	}
@@ -62,6 +66,13 @@ func (e *SimilarityEvaluator) Evaluate(ctx context.Context, response string, exp
 			}
 			reference = strings.TrimSpace(s)
 		}
+		if raw, ok := v["references"]; ok {
+			ss, err := asStringSlice(raw)
+			if err != nil {
+				return nil, fmt.Errorf("similarity: expected.references: %w", err)
+			}
+			references = ss
+		}
 		if raw, ok := v["min_score"]; ok {
 			f, ok := asFloat(raw)
 			if !ok {
@@ -69,6 +80,29 @@ func (e *SimilarityEvaluator) Evaluate(ctx context.Context, response string, exp
 			}
 			minScore = f
 		}
+		if raw, ok := v["temperature"]; ok {
+			f, ok := asFloat(raw)
+			if !ok {
+				return nil, fmt.Errorf("similarity: expected.temperature must be number, got %T", raw)
+			}
+			temperature = f
+		}
+		if raw, ok := v["max_tokens"]; ok {
+			n, ok := asInt(raw)
+			if !ok {
+				return nil, fmt.Errorf("similarity: expected.max_tokens must be number, got %T", raw)
+			}
+			if n > 0 {
+				maxTokens = n
+			}
+		}
+		if raw, ok := v["model"]; ok {
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("similarity: expected.model must be string, got %T", raw)
+			}
+			model = strings.TrimSpace(s)
+		}
 	default:
 		return nil, fmt.Errorf("similarity: expected must be string or map[string]any, got %T", expected)
 	}
@@ -82,10 +116,68 @@ func (e *SimilarityEvaluator) Evaluate(ctx context.Context, response string, exp
 	if minScore > 1 {
 		minScore = 1
 	}
-	if reference == "" {
+
+	if len(references) == 0 {
+		if reference == "" {
+			return nil, errors.New("similarity: missing reference")
+		}
+		references = []string{reference}
+	}
+
+	// Score against every acceptable reference and keep the best match, so an
+	// open-ended question with several gold answers passes on any of them
+	// rather than only the first one listed.
+	bestScore := -1.0
+	bestIdx := -1
+	bestReasoning := ""
+	var lastInvalid *Result
+	for i, ref := range references {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		res, err := e.scoreAgainst(ctx, ref, response, temperature, maxTokens, model)
+		if err != nil {
+			return nil, err
+		}
+		if _, invalid := res.Details["error"]; invalid {
+			lastInvalid = res
+			continue
+		}
+		if res.Score > bestScore {
+			bestScore = res.Score
+			bestIdx = i
+			bestReasoning = res.Message
+		}
+	}
+	if bestIdx < 0 {
+		if lastInvalid != nil {
+			return lastInvalid, nil
+		}
 		return nil, errors.New("similarity: missing reference")
 	}
 
+	passed := bestScore >= minScore
+	details := map[string]any{
+		"min_score": minScore,
+	}
+	if len(references) > 1 {
+		details["matched_reference_index"] = bestIdx
+	}
+
+	return &Result{
+		Passed:  passed,
+		Score:   bestScore,
+		Message: bestReasoning,
+		Details: details,
+	}, nil
+}
+
+// scoreAgainst asks the provider to rate response's semantic similarity to a
+// single reference answer. A malformed LLM response yields a non-nil Result
+// with Passed=false rather than an error, matching Evaluate's historical
+// single-reference behavior for a bad judge response.
+func (e *SimilarityEvaluator) scoreAgainst(ctx context.Context, reference, response string, temperature float64, maxTokens int, model string) (*Result, error) {
 	var prompt bytes.Buffer
 	prompt.WriteString("You are an expert evaluator. Assess whether the AI response is semantically equivalent to the reference answer.\n\n")
 	prompt.WriteString("## Reference Answer\n")
@@ -100,8 +192,10 @@ func (e *SimilarityEvaluator) Evaluate(ctx context.Context, response string, exp
 	prompt.WriteString("{\"score\": <number 0.0-1.0>, \"reasoning\": \"<brief explanation>\"}")
 
 	resp, err := e.Provider.Complete(ctx, &llm.Request{
-		Messages:  []llm.Message{{Role: "user", Content: prompt.String()}},
-		MaxTokens: 512,
+		Messages:    []llm.Message{{Role: "user", Content: prompt.String()}},
+		MaxTokens:   maxTokens,
+		Temperature: temperature,
+		Model:       model,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("similarity: llm: %w", err)
@@ -129,18 +223,9 @@ func (e *SimilarityEvaluator) Evaluate(ctx context.Context, response string, exp
 		score = 1
 	}
 
-	passed := score >= minScore
 	reasoning := strings.TrimSpace(out.Reasoning)
 	if reasoning == "" {
 		reasoning = "no reasoning provided"
 	}
-
-	return &Result{
-		Passed:  passed,
-		Score:   score,
-		Message: reasoning,
-		Details: map[string]any{
-			"min_score": minScore,
-		},
-	}, nil
+	return &Result{Score: score, Message: reasoning}, nil
 }
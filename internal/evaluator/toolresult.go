@@ -0,0 +1,63 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ToolResultUsageEvaluator asserts that the final response references or
+// derives from a value returned by a mocked tool call, catching agents that
+// call a tool but then ignore its result. Unlike ContainsEvaluator, a
+// missing or unresolved tool reference is itself a failure rather than a
+// vacuous pass.
+type ToolResultUsageEvaluator struct{}
+
+// Name returns the evaluator identifier.
+func (ToolResultUsageEvaluator) Name() string {
+	return "tool_result_usage"
+}
+
+// Evaluate checks that response contains the resolved tool value verbatim.
+// expected must be a map[string]any with "tool" (the tool name, for
+// messages), "value" (the resolved expected value), and "found" (false if
+// no matching ToolMock/override existed to resolve a value from).
+func (ToolResultUsageEvaluator) Evaluate(ctx context.Context, response string, expected any) (*Result, error) {
+	m, ok := expected.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("tool_result_usage: expected map[string]any, got %T", expected)
+	}
+
+	tool, _ := m["tool"].(string)
+	value, _ := m["value"].(string)
+	found, _ := m["found"].(bool)
+
+	if !found {
+		return &Result{
+			Passed:  false,
+			Score:   0,
+			Message: fmt.Sprintf("no mocked response found for tool %q", tool),
+		}, nil
+	}
+	if strings.TrimSpace(value) == "" {
+		return &Result{
+			Passed:  false,
+			Score:   0,
+			Message: fmt.Sprintf("tool %q mocked response is empty", tool),
+		}, nil
+	}
+
+	if strings.Contains(response, value) {
+		return &Result{
+			Passed:  true,
+			Score:   1,
+			Message: fmt.Sprintf("response reflects tool %q result", tool),
+		}, nil
+	}
+	return &Result{
+		Passed:  false,
+		Score:   0,
+		Message: fmt.Sprintf("response does not reference tool %q result", tool),
+		Details: map[string]any{"expected_value": value},
+	}, nil
+}
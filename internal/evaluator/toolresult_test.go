@@ -0,0 +1,83 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestToolResultUsageEvaluator_Passes(t *testing.T) {
+	t.Parallel()
+
+	e := ToolResultUsageEvaluator{}
+	res, err := e.Evaluate(context.Background(), "The current temperature is 72F.", map[string]any{
+		"tool":  "get_weather",
+		"value": "72F",
+		"found": true,
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !res.Passed || res.Score != 1 {
+		t.Fatalf("Evaluate: got %+v want passed/score=1", res)
+	}
+}
+
+func TestToolResultUsageEvaluator_FailsWhenNotReferenced(t *testing.T) {
+	t.Parallel()
+
+	e := ToolResultUsageEvaluator{}
+	res, err := e.Evaluate(context.Background(), "I'm not sure about the weather.", map[string]any{
+		"tool":  "get_weather",
+		"value": "72F",
+		"found": true,
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed || res.Score != 0 {
+		t.Fatalf("Evaluate: got %+v want failed/score=0", res)
+	}
+}
+
+func TestToolResultUsageEvaluator_FailsWhenToolNotFound(t *testing.T) {
+	t.Parallel()
+
+	e := ToolResultUsageEvaluator{}
+	res, err := e.Evaluate(context.Background(), "anything", map[string]any{
+		"tool":  "get_weather",
+		"value": "",
+		"found": false,
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed {
+		t.Fatalf("Evaluate: expected failure when tool result unresolved")
+	}
+}
+
+func TestToolResultUsageEvaluator_FailsWhenValueEmpty(t *testing.T) {
+	t.Parallel()
+
+	e := ToolResultUsageEvaluator{}
+	res, err := e.Evaluate(context.Background(), "anything", map[string]any{
+		"tool":  "get_weather",
+		"value": "",
+		"found": true,
+	})
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if res.Passed {
+		t.Fatalf("Evaluate: expected failure when mocked value is empty")
+	}
+}
+
+func TestToolResultUsageEvaluator_InvalidExpectedType(t *testing.T) {
+	t.Parallel()
+
+	e := ToolResultUsageEvaluator{}
+	if _, err := e.Evaluate(context.Background(), "anything", "not a map"); err == nil {
+		t.Fatalf("expected error for non-map expected value")
+	}
+}
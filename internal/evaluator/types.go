@@ -3,9 +3,12 @@ package evaluator
 import (
 	"context"
 	"strings"
+	"sync"
 )
 
-// Evaluator defines a response evaluator.
+// Evaluator defines a response evaluator. This interface is stable:
+// embedding applications and -buildmode=plugin builds may implement it and
+// register instances with Register without forking this package.
 type Evaluator interface {
 	Name() string
 	Evaluate(ctx context.Context, response string, expected any) (*Result, error)
@@ -17,6 +20,13 @@ type Result struct {
 	Score   float64 // 0.0 - 1.0
 	Message string
 	Details map[string]any
+
+	// Optional marks a result whose Passed contributes to nothing but its
+	// own Score: the case's allPassed conjunction skips it, though its
+	// Score still counts toward the case's average. Evaluator
+	// implementations never set this themselves; the runner stamps it in
+	// from testcase.EvaluatorConfig.Optional after calling Evaluate.
+	Optional bool
 }
 
 // Registry stores evaluators by name.
@@ -57,3 +67,36 @@ func (r *Registry) Get(name string) (Evaluator, bool) {
 	e, ok := r.evaluators[name]
 	return e, ok
 }
+
+var (
+	globalMu       sync.Mutex
+	globalRegistry = NewRegistry()
+)
+
+// Register adds an evaluator to the package-level global registry. Call it
+// (typically from an init function, or before constructing a runner.Runner)
+// to make a custom Evaluator implementation available under its own name
+// without forking this package. SeedGlobal copies these entries into a
+// Runner's registry, and the runner's own default registration only fills
+// in names that aren't already present, so a globally registered evaluator
+// always takes precedence over the built-in of the same name.
+func Register(e Evaluator) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	globalRegistry.Register(e)
+}
+
+// SeedGlobal copies every evaluator registered via Register into r,
+// skipping any name r already has.
+func SeedGlobal(r *Registry) {
+	if r == nil {
+		return
+	}
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	for name, e := range globalRegistry.evaluators {
+		if _, ok := r.Get(name); !ok {
+			r.Register(e)
+		}
+	}
+}
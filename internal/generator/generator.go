@@ -30,6 +30,13 @@ type GenerateResult struct {
 	Analysis       string // LLM's analysis of the prompt
 	Suggestions    []string
 	IsSystemPrompt bool // Whether the prompt is a system prompt (AI instructions) vs user prompt
+
+	// InferredSchema is the JSON schema the LLM inferred for the prompt's
+	// output, if the prompt appears to produce structured (JSON) output.
+	// It is nil for prompts that produce free-form text. When non-nil, it
+	// is also attached to every generated case as Expected.JSONSchema with
+	// a matching "json_schema" evaluator.
+	InferredSchema map[string]any
 }
 
 const generatePrompt = `You are a prompt evaluation expert. Analyze the following prompt and generate test cases to evaluate its quality.
@@ -65,12 +72,19 @@ Example for a system prompt like "You are a code review expert":
   }
 }
 
+## Output Schema Inference
+If the prompt instructs the AI to respond with structured data (JSON), infer a JSON
+Schema describing that output shape from the prompt's stated format and any example
+outputs it contains. Omit "response_schema" entirely (or set it to null) if the prompt
+produces free-form text.
+
 ## Output Format
 Return a JSON object with this structure:
 {
   "analysis": "Brief analysis of the prompt's purpose and key characteristics",
   "is_system_prompt": true/false,
   "suggestions": ["Suggestion 1 for improvement", "Suggestion 2", ...],
+  "response_schema": {"type": "object", "properties": {"...": {"type": "..."}}, "required": ["..."]},
   "test_cases": [
     {
       "id": "test_case_id",
@@ -145,9 +159,10 @@ func (g *Generator) Generate(ctx context.Context, req *GenerateRequest) (*Genera
 	text = strings.TrimSpace(text)
 
 	var parsed struct {
-		Analysis       string   `json:"analysis"`
-		IsSystemPrompt bool     `json:"is_system_prompt"`
-		Suggestions    []string `json:"suggestions"`
+		Analysis       string         `json:"analysis"`
+		IsSystemPrompt bool           `json:"is_system_prompt"`
+		Suggestions    []string       `json:"suggestions"`
+		ResponseSchema map[string]any `json:"response_schema"`
 		TestCases      []struct {
 			ID          string         `json:"id"`
 			Description string         `json:"description"`
@@ -211,6 +226,12 @@ func (g *Generator) Generate(ctx context.Context, req *GenerateRequest) (*Genera
 				ScoreThreshold: e.ScoreThreshold,
 			})
 		}
+
+		if len(parsed.ResponseSchema) > 0 {
+			c.Expected.JSONSchema = parsed.ResponseSchema
+			c.Evaluators = append(c.Evaluators, testcase.EvaluatorConfig{Type: "json_schema"})
+		}
+
 		suite.Cases = append(suite.Cases, c)
 	}
 
@@ -219,5 +240,6 @@ func (g *Generator) Generate(ctx context.Context, req *GenerateRequest) (*Genera
 		Analysis:       parsed.Analysis,
 		Suggestions:    parsed.Suggestions,
 		IsSystemPrompt: parsed.IsSystemPrompt,
+		InferredSchema: parsed.ResponseSchema,
 	}, nil
 }
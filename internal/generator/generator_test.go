@@ -150,3 +150,77 @@ func TestGenerate_Success(t *testing.T) {
 		t.Fatalf("prompt missing variables section: %q", captured)
 	}
 }
+
+func TestGenerate_InfersResponseSchema(t *testing.T) {
+	t.Parallel()
+
+	g := &Generator{
+		Provider: fakeProvider{completeFn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+			return &llm.Response{Content: []llm.ContentBlock{{Type: "text", Text: `{
+  "analysis": "a",
+  "is_system_prompt": false,
+  "suggestions": [],
+  "response_schema": {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]},
+  "test_cases": [
+    {
+      "id": "c1",
+      "description": "d1",
+      "input": {},
+      "expected": {"contains": [], "not_contains": [], "regex": []},
+      "evaluators": []
+    }
+  ]
+}`}}}, nil
+		}},
+	}
+
+	res, err := g.Generate(context.Background(), &GenerateRequest{PromptContent: "return JSON with a name field"})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if res.InferredSchema == nil || res.InferredSchema["type"] != "object" {
+		t.Fatalf("InferredSchema: %#v", res.InferredSchema)
+	}
+
+	c1 := res.Suite.Cases[0]
+	if c1.Expected.JSONSchema == nil || c1.Expected.JSONSchema["type"] != "object" {
+		t.Fatalf("c1 Expected.JSONSchema: %#v", c1.Expected.JSONSchema)
+	}
+	if len(c1.Evaluators) != 1 || c1.Evaluators[0].Type != "json_schema" {
+		t.Fatalf("c1 Evaluators: %#v", c1.Evaluators)
+	}
+}
+
+func TestGenerate_NoResponseSchemaForFreeformPrompt(t *testing.T) {
+	t.Parallel()
+
+	g := &Generator{
+		Provider: fakeProvider{completeFn: func(ctx context.Context, req *llm.Request) (*llm.Response, error) {
+			return &llm.Response{Content: []llm.ContentBlock{{Type: "text", Text: `{
+  "analysis": "a",
+  "is_system_prompt": false,
+  "suggestions": [],
+  "test_cases": [
+    {
+      "id": "c1",
+      "description": "d1",
+      "input": {},
+      "expected": {"contains": [], "not_contains": [], "regex": []},
+      "evaluators": []
+    }
+  ]
+}`}}}, nil
+		}},
+	}
+
+	res, err := g.Generate(context.Background(), &GenerateRequest{PromptContent: "write a poem"})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if res.InferredSchema != nil {
+		t.Fatalf("InferredSchema: got %#v want nil", res.InferredSchema)
+	}
+	if len(res.Suite.Cases[0].Evaluators) != 0 {
+		t.Fatalf("Evaluators: got %#v want none", res.Suite.Cases[0].Evaluators)
+	}
+}
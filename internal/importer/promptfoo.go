@@ -0,0 +1,187 @@
+// Package importer converts third-party prompt/test formats into ai-eval's
+// prompt.Prompt and testcase.TestSuite types.
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/stellarlinkco/ai-eval/internal/prompt"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+// promptfooConfig is the subset of promptfoo's YAML config this importer
+// understands: https://www.promptfoo.dev/docs/configuration/guide/
+type promptfooConfig struct {
+	Prompts []string        `yaml:"prompts"`
+	Tests   []promptfooTest `yaml:"tests"`
+}
+
+type promptfooTest struct {
+	Description string               `yaml:"description,omitempty"`
+	Vars        map[string]any       `yaml:"vars,omitempty"`
+	Assert      []promptfooAssertion `yaml:"assert,omitempty"`
+}
+
+type promptfooAssertion struct {
+	Type  string `yaml:"type"`
+	Value any    `yaml:"value,omitempty"`
+}
+
+// PromptfooResult is a converted promptfoo config plus any assertions that
+// couldn't be mapped onto ai-eval's Expected/EvaluatorConfig fields.
+type PromptfooResult struct {
+	Prompt   *prompt.Prompt
+	Suite    *testcase.TestSuite
+	Warnings []string
+}
+
+var promptfooVarPattern = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+
+// ConvertPromptfoo parses a promptfoo config (data) and maps its first prompt
+// and its tests onto an ai-eval prompt.Prompt and testcase.TestSuite named
+// name. Only the "contains", "equals", "is-json", and "llm-rubric" assertion
+// types are mapped; any other assertion type is reported in Warnings and
+// otherwise skipped. The returned suite is validated with testcase.Validate
+// before being returned.
+func ConvertPromptfoo(name string, data []byte) (*PromptfooResult, error) {
+	var cfg promptfooConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("importer: parse promptfoo config: %w", err)
+	}
+	if len(cfg.Prompts) == 0 {
+		return nil, errors.New("importer: promptfoo config has no prompts")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "imported"
+	}
+
+	var warnings []string
+	if len(cfg.Prompts) > 1 {
+		warnings = append(warnings, fmt.Sprintf("config declares %d prompts; only the first was imported", len(cfg.Prompts)))
+	}
+
+	p := &prompt.Prompt{
+		Name:     name,
+		Version:  "1.0.0",
+		Template: convertPromptfooTemplate(cfg.Prompts[0]),
+		Tools:    []prompt.Tool{},
+		Metadata: map[string]any{},
+	}
+	p.Variables = promptfooTemplateVariables(p.Template)
+
+	suite := &testcase.TestSuite{
+		Suite:       name + "-tests",
+		Prompt:      name,
+		Description: "Imported from promptfoo",
+	}
+
+	seenIDs := make(map[string]int)
+	for i, t := range cfg.Tests {
+		input := t.Vars
+		if input == nil {
+			input = map[string]any{}
+		}
+		tc := testcase.TestCase{
+			ID:          uniqueCaseID(seenIDs, t.Description, i),
+			Description: t.Description,
+			Input:       input,
+		}
+
+		for _, a := range t.Assert {
+			switch strings.ToLower(strings.TrimSpace(a.Type)) {
+			case "contains":
+				tc.Expected.Contains = append(tc.Expected.Contains, fmt.Sprintf("%v", a.Value))
+			case "equals":
+				tc.Expected.ExactMatch = fmt.Sprintf("%v", a.Value)
+			case "is-json":
+				schema, ok := a.Value.(map[string]any)
+				if !ok || schema == nil {
+					schema = map[string]any{"type": "object"}
+				}
+				tc.Expected.JSONSchema = schema
+			case "llm-rubric":
+				tc.Evaluators = append(tc.Evaluators, testcase.EvaluatorConfig{
+					Type:     "llm_judge",
+					Criteria: fmt.Sprintf("%v", a.Value),
+				})
+			default:
+				warnings = append(warnings, fmt.Sprintf("test %q: unmapped assertion type %q", tc.ID, a.Type))
+			}
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	if len(suite.Cases) == 0 {
+		return nil, errors.New("importer: promptfoo config has no tests")
+	}
+
+	if err := testcase.Validate(suite); err != nil {
+		return nil, fmt.Errorf("importer: converted suite is invalid: %w", err)
+	}
+
+	return &PromptfooResult{Prompt: p, Suite: suite, Warnings: warnings}, nil
+}
+
+// convertPromptfooTemplate rewrites promptfoo's Nunjucks-style {{var}}
+// placeholders into ai-eval's Go-template {{.var}} form.
+func convertPromptfooTemplate(s string) string {
+	return promptfooVarPattern.ReplaceAllString(s, "{{.$1}}")
+}
+
+// promptfooTemplateVariables extracts the distinct {{.var}} references from
+// an already-converted template, in first-appearance order. promptfoo has no
+// separate variable declaration, so all are treated as optional.
+func promptfooTemplateVariables(template string) []prompt.Variable {
+	matches := regexp.MustCompile(`\{\{\s*\.([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`).FindAllStringSubmatch(template, -1)
+	seen := make(map[string]struct{}, len(matches))
+	var out []prompt.Variable
+	for _, m := range matches {
+		name := m[1]
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		out = append(out, prompt.Variable{Name: name})
+	}
+	return out
+}
+
+func uniqueCaseID(seen map[string]int, description string, idx int) string {
+	base := slugify(description)
+	if base == "" {
+		base = fmt.Sprintf("case-%d", idx+1)
+	}
+	n := seen[base]
+	seen[base] = n + 1
+	if n == 0 {
+		return base
+	}
+	return fmt.Sprintf("%s-%d", base, n+1)
+}
+
+func slugify(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	var b strings.Builder
+	lastDash := false
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastDash = false
+		default:
+			if !lastDash && b.Len() > 0 {
+				b.WriteByte('-')
+				lastDash = true
+			}
+		}
+	}
+	return strings.TrimRight(b.String(), "-")
+}
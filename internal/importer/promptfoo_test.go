@@ -0,0 +1,159 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+)
+
+const samplePromptfooConfig = `
+prompts:
+  - "Answer the question: {{question}}"
+tests:
+  - description: "contains check"
+    vars:
+      question: "What is the capital of France?"
+    assert:
+      - type: contains
+        value: "Paris"
+  - description: "contains check"
+    vars:
+      question: "What is 2+2?"
+    assert:
+      - type: equals
+        value: "4"
+      - type: is-json
+      - type: llm-rubric
+        value: "answers concisely"
+      - type: not-a-real-assertion
+        value: "ignored"
+`
+
+func TestConvertPromptfoo_MapsSupportedAssertions(t *testing.T) {
+	t.Parallel()
+
+	result, err := ConvertPromptfoo("qa", []byte(samplePromptfooConfig))
+	if err != nil {
+		t.Fatalf("ConvertPromptfoo: %v", err)
+	}
+
+	if result.Prompt.Template != "Answer the question: {{.question}}" {
+		t.Fatalf("Template: got %q", result.Prompt.Template)
+	}
+	if len(result.Prompt.Variables) != 1 || result.Prompt.Variables[0].Name != "question" {
+		t.Fatalf("Variables: got %#v", result.Prompt.Variables)
+	}
+
+	if len(result.Suite.Cases) != 2 {
+		t.Fatalf("Cases: got %d want 2", len(result.Suite.Cases))
+	}
+
+	c1, c2 := result.Suite.Cases[0], result.Suite.Cases[1]
+	if c1.ID == c2.ID {
+		t.Fatalf("expected unique IDs for duplicate descriptions, got %q twice", c1.ID)
+	}
+	if len(c1.Expected.Contains) != 1 || c1.Expected.Contains[0] != "Paris" {
+		t.Fatalf("Contains: got %#v", c1.Expected.Contains)
+	}
+	if c2.Expected.ExactMatch != "4" {
+		t.Fatalf("ExactMatch: got %q", c2.Expected.ExactMatch)
+	}
+	if c2.Expected.JSONSchema == nil {
+		t.Fatalf("JSONSchema: expected default schema")
+	}
+	if len(c2.Evaluators) != 1 || c2.Evaluators[0].Type != "llm_judge" || c2.Evaluators[0].Criteria != "answers concisely" {
+		t.Fatalf("Evaluators: got %#v", c2.Evaluators)
+	}
+
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "not-a-real-assertion") {
+		t.Fatalf("Warnings: got %#v", result.Warnings)
+	}
+}
+
+func TestConvertPromptfoo_MultiplePromptsWarns(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+prompts:
+  - "first {{x}}"
+  - "second {{x}}"
+tests:
+  - vars: {x: "1"}
+    assert:
+      - type: contains
+        value: "1"
+`
+	result, err := ConvertPromptfoo("multi", []byte(cfg))
+	if err != nil {
+		t.Fatalf("ConvertPromptfoo: %v", err)
+	}
+	if result.Prompt.Template != "first {{.x}}" {
+		t.Fatalf("Template: got %q, expected only the first prompt", result.Prompt.Template)
+	}
+	found := false
+	for _, w := range result.Warnings {
+		if strings.Contains(w, "2 prompts") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Warnings: got %#v, expected a multi-prompt warning", result.Warnings)
+	}
+}
+
+func TestConvertPromptfoo_NoPromptsErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ConvertPromptfoo("empty", []byte("tests: []")); err == nil {
+		t.Fatalf("expected error for missing prompts")
+	}
+}
+
+func TestConvertPromptfoo_NoTestsErrors(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+prompts:
+  - "hi {{x}}"
+tests: []
+`
+	if _, err := ConvertPromptfoo("empty", []byte(cfg)); err == nil {
+		t.Fatalf("expected error for missing tests")
+	}
+}
+
+func TestConvertPromptfoo_AllUnmappedAssertionsFailsValidation(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+prompts:
+  - "hi {{x}}"
+tests:
+  - vars: {x: "1"}
+    assert:
+      - type: javascript
+        value: "1 === 1"
+`
+	_, err := ConvertPromptfoo("bad", []byte(cfg))
+	if err == nil {
+		t.Fatalf("expected validation error when a case ends up with no assertions or evaluators")
+	}
+	if !strings.Contains(err.Error(), "invalid") {
+		t.Fatalf("error: got %q, expected wrapped validation error", err.Error())
+	}
+}
+
+func TestUniqueCaseID_DisambiguatesDuplicates(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[string]int)
+	first := uniqueCaseID(seen, "same case", 0)
+	second := uniqueCaseID(seen, "same case", 1)
+	third := uniqueCaseID(seen, "", 2)
+
+	if first == second {
+		t.Fatalf("expected distinct IDs, got %q twice", first)
+	}
+	if third != "case-3" {
+		t.Fatalf("fallback ID: got %q want case-3", third)
+	}
+}
@@ -0,0 +1,58 @@
+package leaderboard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RelativeEntry pairs an Entry with its score/cost/latency ratio to a
+// designated baseline model, for cross-model reports (e.g. "1.08x the score
+// of gpt-4o-mini, at 0.4x the cost").
+type RelativeEntry struct {
+	Entry
+	ScoreRatio   float64 // Score / baseline score; 0 if baseline score is 0
+	CostRatio    float64 // Cost / baseline cost; 0 if baseline cost is 0
+	LatencyRatio float64 // Latency / baseline latency; 0 if baseline latency is 0
+}
+
+// WithBaseline computes each entry's score/cost/latency ratio relative to
+// baselineModel, matched case-insensitively against Entry.Model. It returns
+// an error if entries contains no matching baseline.
+func WithBaseline(entries []Entry, baselineModel string) ([]RelativeEntry, error) {
+	baselineModel = strings.TrimSpace(baselineModel)
+	if baselineModel == "" {
+		return nil, errors.New("leaderboard: empty baseline model")
+	}
+
+	var baseline *Entry
+	for i := range entries {
+		if strings.EqualFold(strings.TrimSpace(entries[i].Model), baselineModel) {
+			baseline = &entries[i]
+			break
+		}
+	}
+	if baseline == nil {
+		return nil, fmt.Errorf("leaderboard: baseline model %q not found in entries", baselineModel)
+	}
+
+	out := make([]RelativeEntry, len(entries))
+	for i, e := range entries {
+		out[i] = RelativeEntry{
+			Entry:        e,
+			ScoreRatio:   ratio(e.Score, baseline.Score),
+			CostRatio:    ratio(e.Cost, baseline.Cost),
+			LatencyRatio: ratio(float64(e.Latency), float64(baseline.Latency)),
+		}
+	}
+	return out, nil
+}
+
+// ratio returns v/base, or 0 if base is 0 (avoids dividing by an
+// unmeasured/free baseline instead of returning +Inf/NaN).
+func ratio(v, base float64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return v / base
+}
@@ -0,0 +1,71 @@
+package leaderboard
+
+import "testing"
+
+func TestWithBaseline_ComputesRatios(t *testing.T) {
+	entries := []Entry{
+		{Model: "gpt-4o", Score: 0.9, Cost: 1.0, Latency: 800},
+		{Model: "gpt-4o-mini", Score: 0.75, Cost: 0.2, Latency: 400},
+		{Model: "claude-haiku", Score: 0.6, Cost: 0.1, Latency: 200},
+	}
+
+	out, err := WithBaseline(entries, "gpt-4o-mini")
+	if err != nil {
+		t.Fatalf("WithBaseline: %v", err)
+	}
+	if len(out) != len(entries) {
+		t.Fatalf("len(out): got %d want %d", len(out), len(entries))
+	}
+
+	mini := out[1]
+	if mini.ScoreRatio != 1 || mini.CostRatio != 1 || mini.LatencyRatio != 1 {
+		t.Fatalf("baseline ratios: got %+v want all 1", mini)
+	}
+
+	gpt4o := out[0]
+	if got := gpt4o.ScoreRatio; got != 0.9/0.75 {
+		t.Fatalf("ScoreRatio: got %v want %v", got, 0.9/0.75)
+	}
+	if got := gpt4o.CostRatio; got != 5 {
+		t.Fatalf("CostRatio: got %v want 5", got)
+	}
+	if got := gpt4o.LatencyRatio; got != 2 {
+		t.Fatalf("LatencyRatio: got %v want 2", got)
+	}
+}
+
+func TestWithBaseline_CaseInsensitiveMatch(t *testing.T) {
+	entries := []Entry{{Model: "GPT-4O-Mini", Score: 1, Cost: 1, Latency: 1}}
+
+	out, err := WithBaseline(entries, " gpt-4o-mini ")
+	if err != nil {
+		t.Fatalf("WithBaseline: %v", err)
+	}
+	if out[0].ScoreRatio != 1 {
+		t.Fatalf("ScoreRatio: got %v want 1", out[0].ScoreRatio)
+	}
+}
+
+func TestWithBaseline_ZeroBaselineValuesYieldZeroRatio(t *testing.T) {
+	entries := []Entry{
+		{Model: "free-model", Score: 0.5, Cost: 0, Latency: 0},
+		{Model: "other", Score: 1, Cost: 2, Latency: 100},
+	}
+
+	out, err := WithBaseline(entries, "free-model")
+	if err != nil {
+		t.Fatalf("WithBaseline: %v", err)
+	}
+	if out[1].CostRatio != 0 || out[1].LatencyRatio != 0 {
+		t.Fatalf("ratios against zero baseline: got %+v want 0", out[1])
+	}
+}
+
+func TestWithBaseline_Errors(t *testing.T) {
+	if _, err := WithBaseline([]Entry{{Model: "x"}}, "  "); err == nil {
+		t.Fatalf("expected error for empty baseline model")
+	}
+	if _, err := WithBaseline([]Entry{{Model: "x"}}, "y"); err == nil {
+		t.Fatalf("expected error for unmatched baseline model")
+	}
+}
@@ -3,6 +3,7 @@ package leaderboard
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -31,6 +32,10 @@ type Entry struct {
 	Latency  int64
 	Cost     float64
 	EvalDate time.Time
+	// Metadata carries free-form details about how the run was produced
+	// (e.g. sample_strategy/seed for a --sample-strategy benchmark run) that
+	// don't warrant their own column. nil is stored as "{}".
+	Metadata map[string]any
 }
 
 func NewStore(dbPath string) (*Store, error) {
@@ -84,7 +89,8 @@ func initSchema(db *sql.DB) error {
 			accuracy REAL NOT NULL,
 			latency INTEGER NOT NULL,
 			cost REAL NOT NULL,
-			eval_date INTEGER NOT NULL
+			eval_date INTEGER NOT NULL,
+			metadata TEXT NOT NULL DEFAULT '{}'
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_leaderboard_dataset ON leaderboard_entries(dataset)`,
 		`CREATE INDEX IF NOT EXISTS idx_leaderboard_model_dataset ON leaderboard_entries(model, dataset)`,
@@ -96,6 +102,16 @@ func initSchema(db *sql.DB) error {
 			return fmt.Errorf("leaderboard: init schema: %w", err)
 		}
 	}
+
+	// leaderboard_entries predates the metadata column; add it for databases
+	// created before this column existed. SQLite has no "ADD COLUMN IF NOT
+	// EXISTS", so a duplicate-column error here just means it's already
+	// present and is ignored.
+	if _, err := db.Exec(`ALTER TABLE leaderboard_entries ADD COLUMN metadata TEXT NOT NULL DEFAULT '{}'`); err != nil {
+		if !strings.Contains(strings.ToLower(err.Error()), "duplicate column") {
+			return fmt.Errorf("leaderboard: add metadata column: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -129,11 +145,16 @@ func (s *Store) Save(ctx context.Context, entry *Entry) error {
 		evalDate = time.Now().UTC()
 	}
 
+	metadata, err := encodeMetadata(entry.Metadata)
+	if err != nil {
+		return fmt.Errorf("leaderboard: encode metadata: %w", err)
+	}
+
 	res, err := s.db.ExecContext(ctx, `
 		INSERT INTO leaderboard_entries (
-			model, provider, dataset, score, accuracy, latency, cost, eval_date
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, model, provider, dataset, entry.Score, entry.Accuracy, entry.Latency, entry.Cost, evalDate.UTC().UnixMilli())
+			model, provider, dataset, score, accuracy, latency, cost, eval_date, metadata
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, model, provider, dataset, entry.Score, entry.Accuracy, entry.Latency, entry.Cost, evalDate.UTC().UnixMilli(), metadata)
 	if err != nil {
 		return fmt.Errorf("leaderboard: insert entry: %w", err)
 	}
@@ -164,7 +185,7 @@ func (s *Store) GetLeaderboard(ctx context.Context, dataset string, limit int) (
 	}
 
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, model, provider, dataset, score, accuracy, latency, cost, eval_date
+		SELECT id, model, provider, dataset, score, accuracy, latency, cost, eval_date, metadata
 		FROM leaderboard_entries
 		WHERE dataset = ?
 		ORDER BY score DESC, accuracy DESC, latency ASC, eval_date DESC
@@ -192,7 +213,7 @@ func (s *Store) GetModelHistory(ctx context.Context, model, dataset string) ([]E
 	}
 
 	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, model, provider, dataset, score, accuracy, latency, cost, eval_date
+		SELECT id, model, provider, dataset, score, accuracy, latency, cost, eval_date, metadata
 		FROM leaderboard_entries
 		WHERE model = ? AND dataset = ?
 		ORDER BY eval_date DESC
@@ -210,6 +231,7 @@ func scanRows(rows *sql.Rows) ([]Entry, error) {
 	for rows.Next() {
 		var e Entry
 		var evalDateMS int64
+		var metadata string
 		if err := rows.Scan(
 			&e.ID,
 			&e.Model,
@@ -220,10 +242,16 @@ func scanRows(rows *sql.Rows) ([]Entry, error) {
 			&e.Latency,
 			&e.Cost,
 			&evalDateMS,
+			&metadata,
 		); err != nil {
 			return nil, fmt.Errorf("leaderboard: scan entry: %w", err)
 		}
 		e.EvalDate = time.UnixMilli(evalDateMS).UTC()
+		decoded, err := decodeMetadata(metadata)
+		if err != nil {
+			return nil, fmt.Errorf("leaderboard: decode metadata: %w", err)
+		}
+		e.Metadata = decoded
 		out = append(out, e)
 	}
 	if err := rows.Err(); err != nil {
@@ -231,3 +259,30 @@ func scanRows(rows *sql.Rows) ([]Entry, error) {
 	}
 	return out, nil
 }
+
+// encodeMetadata serializes an entry's metadata for storage, treating nil as
+// an empty object so the column is never NULL.
+func encodeMetadata(metadata map[string]any) (string, error) {
+	if len(metadata) == 0 {
+		return "{}", nil
+	}
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// decodeMetadata parses a stored metadata column back into a map, treating
+// an empty string (rows written before the column existed) the same as "{}".
+func decodeMetadata(raw string) (map[string]any, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "{}" {
+		return nil, nil
+	}
+	var out map[string]any
+	if err := json.Unmarshal([]byte(raw), &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -181,6 +181,55 @@ func TestStore_SaveAndGetLeaderboard(t *testing.T) {
 	}
 }
 
+func TestStore_SaveAndGetLeaderboard_Metadata(t *testing.T) {
+	st, err := NewStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer st.Close()
+
+	ctx := context.Background()
+	withMeta := &Entry{
+		Model:    "m1",
+		Provider: "openai",
+		Dataset:  "gsm8k",
+		Score:    0.5,
+		EvalDate: time.UnixMilli(1000).UTC(),
+		Metadata: map[string]any{"sample_strategy": "hard-weighted", "seed": float64(7)},
+	}
+	withoutMeta := &Entry{
+		Model:    "m2",
+		Provider: "openai",
+		Dataset:  "gsm8k",
+		Score:    0.4,
+		EvalDate: time.UnixMilli(2000).UTC(),
+	}
+
+	if err := st.Save(ctx, withMeta); err != nil {
+		t.Fatalf("Save withMeta: %v", err)
+	}
+	if err := st.Save(ctx, withoutMeta); err != nil {
+		t.Fatalf("Save withoutMeta: %v", err)
+	}
+
+	got, err := st.GetLeaderboard(ctx, "gsm8k", 10)
+	if err != nil {
+		t.Fatalf("GetLeaderboard: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(entries): got %d want %d", len(got), 2)
+	}
+	if got[0].Model != "m1" {
+		t.Fatalf("rank1 model: got %q want %q", got[0].Model, "m1")
+	}
+	if strategy := got[0].Metadata["sample_strategy"]; strategy != "hard-weighted" {
+		t.Fatalf("metadata sample_strategy: got %v", strategy)
+	}
+	if got[1].Metadata != nil {
+		t.Fatalf("expected nil metadata for entry saved without any, got %#v", got[1].Metadata)
+	}
+}
+
 func TestStore_GetModelHistory_Order(t *testing.T) {
 	st, err := NewStore(":memory:")
 	if err != nil {
@@ -374,7 +423,7 @@ func TestScanRows_RowsErr(t *testing.T) {
 	}
 
 	rows, err := db.QueryContext(context.Background(), `
-		SELECT id, model, provider, dataset, score, accuracy, latency, cost, eval_date
+		SELECT id, model, provider, dataset, score, accuracy, latency, cost, eval_date, metadata
 		FROM leaderboard_entries
 		WHERE boom(eval_date) = 1
 		ORDER BY eval_date DESC
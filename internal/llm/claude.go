@@ -118,6 +118,7 @@ func toClaudeRequest(req *Request) (*claude.Request, error) {
 	}
 
 	return &claude.Request{
+		Model:       req.Model,
 		Messages:    msgs,
 		MaxTokens:   req.MaxTokens,
 		System:      req.System,
@@ -0,0 +1,57 @@
+package llm
+
+import (
+	"errors"
+	"net/http"
+
+	openai "github.com/sashabaranov/go-openai"
+
+	"github.com/stellarlinkco/ai-eval/internal/claude"
+)
+
+// ErrorClass categorizes a provider error for callers that need to react to
+// *how* a request failed (e.g. adaptive concurrency shedding load), not just
+// whether it failed.
+type ErrorClass int
+
+const (
+	// ErrorClassNone covers nil errors and failures unrelated to provider load.
+	ErrorClassNone ErrorClass = iota
+	// ErrorClassRateLimited is a 429 response: the provider is asking us to
+	// slow down.
+	ErrorClassRateLimited
+	// ErrorClassServerError is a 5xx response: the provider is unhealthy.
+	ErrorClassServerError
+)
+
+// ClassifyError inspects a provider error and reports whether it indicates
+// the provider is under load, unwrapping the typed API errors returned by
+// the claude and openai clients.
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassNone
+	}
+
+	var claudeErr *claude.APIError
+	if errors.As(err, &claudeErr) {
+		return classifyStatusCode(claudeErr.StatusCode)
+	}
+
+	var openaiErr *openai.APIError
+	if errors.As(err, &openaiErr) {
+		return classifyStatusCode(openaiErr.HTTPStatusCode)
+	}
+
+	return ErrorClassNone
+}
+
+func classifyStatusCode(status int) ErrorClass {
+	switch {
+	case status == http.StatusTooManyRequests:
+		return ErrorClassRateLimited
+	case status >= 500 && status <= 599:
+		return ErrorClassServerError
+	default:
+		return ErrorClassNone
+	}
+}
@@ -0,0 +1,42 @@
+package llm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	openai "github.com/sashabaranov/go-openai"
+
+	"github.com/stellarlinkco/ai-eval/internal/claude"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{"nil", nil, ErrorClassNone},
+		{"unrelated", errors.New("boom"), ErrorClassNone},
+		{"claude rate limited", &claude.APIError{StatusCode: http.StatusTooManyRequests}, ErrorClassRateLimited},
+		{"claude server error", &claude.APIError{StatusCode: http.StatusServiceUnavailable}, ErrorClassServerError},
+		{"claude client error", &claude.APIError{StatusCode: http.StatusBadRequest}, ErrorClassNone},
+		{"openai rate limited", &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests}, ErrorClassRateLimited},
+		{"openai server error", &openai.APIError{HTTPStatusCode: http.StatusBadGateway}, ErrorClassServerError},
+		{"openai client error", &openai.APIError{HTTPStatusCode: http.StatusUnauthorized}, ErrorClassNone},
+		{"wrapped claude error", fmt.Errorf("call failed: %w", &claude.APIError{StatusCode: http.StatusTooManyRequests}), ErrorClassRateLimited},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ClassifyError(tt.err); got != tt.want {
+				t.Fatalf("ClassifyError(%v): got %v want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
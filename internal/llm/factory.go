@@ -23,7 +23,7 @@ func NewRegistryFromConfig(cfg *config.Config) (*Registry, error) {
 		case "claude", "anthropic":
 			r.Register(NewClaudeProvider(pcfg.APIKey, pcfg.BaseURL, pcfg.Model))
 		case "openai":
-			r.Register(NewOpenAIProvider(pcfg.APIKey, pcfg.BaseURL, pcfg.Model))
+			r.Register(NewOpenAIProvider(pcfg.APIKey, pcfg.BaseURL, pcfg.Model, WithOpenAIHeaders(pcfg.Headers)))
 		default:
 			return nil, fmt.Errorf("llm: unknown provider %q", name)
 		}
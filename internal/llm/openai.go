@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -16,7 +17,24 @@ type OpenAIProvider struct {
 	model  string
 }
 
-func NewOpenAIProvider(apiKey string, baseURL string, model string) *OpenAIProvider {
+// OpenAIOption configures optional behavior of NewOpenAIProvider, such as
+// headers required by a corporate LLM gateway sitting in front of the API.
+type OpenAIOption func(*openAIOptions)
+
+type openAIOptions struct {
+	headers map[string]string
+}
+
+// WithOpenAIHeaders attaches headers to every HTTP request the provider
+// makes, e.g. gateway auth or routing headers. Per-request Request.Headers,
+// when set, override these on a matching key.
+func WithOpenAIHeaders(headers map[string]string) OpenAIOption {
+	return func(o *openAIOptions) {
+		o.headers = headers
+	}
+}
+
+func NewOpenAIProvider(apiKey string, baseURL string, model string, opts ...OpenAIOption) *OpenAIProvider {
 	cfg := openai.DefaultConfig(strings.TrimSpace(apiKey))
 	if v := strings.TrimSpace(baseURL); v != "" {
 		cfg.BaseURL = strings.TrimRight(v, "/")
@@ -27,16 +45,76 @@ func NewOpenAIProvider(apiKey string, baseURL string, model string) *OpenAIProvi
 		m = "gpt-4o"
 	}
 
+	var o openAIOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	if len(o.headers) > 0 {
+		cfg.HTTPClient = &http.Client{
+			Transport: &headerInjectingTransport{headers: o.headers},
+		}
+	}
+
 	return &OpenAIProvider{
 		client: openai.NewClientWithConfig(cfg),
 		model:  m,
 	}
 }
 
+// headerInjectingTransport attaches a fixed set of headers to every request,
+// then lets any per-request headers stashed in the context (see
+// withRequestHeaders) override them by key. This is how the OpenAI-compatible
+// provider supports gateways that require both a static auth header and a
+// per-call routing header.
+type headerInjectingTransport struct {
+	headers map[string]string
+	base    http.RoundTripper
+}
+
+func (t *headerInjectingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	if override, ok := req.Context().Value(requestHeadersCtxKey{}).(map[string]string); ok {
+		for k, v := range override {
+			req.Header.Set(k, v)
+		}
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+type requestHeadersCtxKey struct{}
+
+// withRequestHeaders stashes per-request headers (e.g. a routing header that
+// varies by model) in ctx, for headerInjectingTransport to apply on top of
+// the provider's static headers.
+func withRequestHeaders(ctx context.Context, headers map[string]string) context.Context {
+	if len(headers) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, requestHeadersCtxKey{}, headers)
+}
+
 func (p *OpenAIProvider) Name() string {
 	return "openai"
 }
 
+// resolveModel returns the per-request model override when set, falling
+// back to the provider's configured default model.
+func (p *OpenAIProvider) resolveModel(override string) string {
+	if v := strings.TrimSpace(override); v != "" {
+		return v
+	}
+	return strings.TrimSpace(p.model)
+}
+
 func (p *OpenAIProvider) Complete(ctx context.Context, req *Request) (*Response, error) {
 	if p == nil || p.client == nil {
 		return nil, errors.New("llm: openai: nil client")
@@ -47,6 +125,7 @@ func (p *OpenAIProvider) Complete(ctx context.Context, req *Request) (*Response,
 	if req == nil {
 		return nil, errors.New("llm: openai: nil request")
 	}
+	ctx = withRequestHeaders(ctx, req.Headers)
 
 	msgs := make([]openai.ChatCompletionMessage, 0, len(req.Messages)+1)
 	if system := strings.TrimSpace(req.System); system != "" {
@@ -65,15 +144,26 @@ func (p *OpenAIProvider) Complete(ctx context.Context, req *Request) (*Response,
 	tools := toOpenAITools(req.Tools)
 
 	r := openai.ChatCompletionRequest{
-		Model:               strings.TrimSpace(p.model),
-		Messages:            msgs,
-		MaxTokens: clampMaxTokens(req.MaxTokens),
-		Temperature:         float32(req.Temperature),
-		Tools:               tools,
+		Model:       p.resolveModel(req.Model),
+		Messages:    msgs,
+		MaxTokens:   clampMaxTokens(req.MaxTokens),
+		Temperature: float32(req.Temperature),
+		Tools:       tools,
 	}
 	if len(tools) > 0 {
 		r.ToolChoice = "auto"
 	}
+	if req.Seed != 0 {
+		seed := int(req.Seed)
+		r.Seed = &seed
+	}
+	if len(req.StopSequences) > 0 {
+		r.Stop = req.StopSequences
+	}
+	if req.Logprobs {
+		r.LogProbs = true
+		r.TopLogProbs = openAITopLogprobs
+	}
 
 	resp, err := p.client.CreateChatCompletion(ctx, r)
 	if err != nil {
@@ -90,6 +180,7 @@ func (p *OpenAIProvider) Complete(ctx context.Context, req *Request) (*Response,
 			InputTokens:  resp.Usage.PromptTokens,
 			OutputTokens: resp.Usage.CompletionTokens,
 		},
+		Logprobs: openAILogprobsToTokenLogprobs(choice.LogProbs),
 	}
 
 	msg := choice.Message
@@ -131,6 +222,7 @@ func (p *OpenAIProvider) CompleteWithTools(ctx context.Context, req *Request) (*
 
 	out.InputTokens = resp.Usage.InputTokens
 	out.OutputTokens = resp.Usage.OutputTokens
+	out.Logprobs = resp.Logprobs
 
 	var sb strings.Builder
 	for _, b := range resp.Content {
@@ -168,6 +260,7 @@ func (p *OpenAIProvider) CompleteMultiTurn(
 	if req == nil {
 		return nil, errors.New("llm: openai: nil request")
 	}
+	ctx = withRequestHeaders(ctx, req.Headers)
 	if maxSteps <= 0 {
 		maxSteps = 5
 	}
@@ -199,12 +292,23 @@ func (p *OpenAIProvider) CompleteMultiTurn(
 		}
 
 		r := openai.ChatCompletionRequest{
-			Model:               strings.TrimSpace(p.model),
-			Messages:            msgs,
-			MaxTokens: clampMaxTokens(req.MaxTokens),
-			Temperature:         float32(req.Temperature),
-			Tools:               tools,
-			ToolChoice:          "auto",
+			Model:       p.resolveModel(req.Model),
+			Messages:    msgs,
+			MaxTokens:   clampMaxTokens(req.MaxTokens),
+			Temperature: float32(req.Temperature),
+			Tools:       tools,
+			ToolChoice:  "auto",
+		}
+		if req.Seed != 0 {
+			seed := int(req.Seed)
+			r.Seed = &seed
+		}
+		if len(req.StopSequences) > 0 {
+			r.Stop = req.StopSequences
+		}
+		if req.Logprobs {
+			r.LogProbs = true
+			r.TopLogProbs = openAITopLogprobs
 		}
 
 		start := time.Now()
@@ -276,6 +380,26 @@ func normalizeOpenAIRole(role string) string {
 	}
 }
 
+// openAITopLogprobs is the number of alternative tokens requested at each
+// output position when Request.Logprobs is set. OpenAI accepts 0-20; 5 is
+// enough context for confidence scoring without an oversized payload.
+const openAITopLogprobs = 5
+
+func openAILogprobsToTokenLogprobs(lp *openai.LogProbs) []TokenLogprob {
+	if lp == nil || len(lp.Content) == 0 {
+		return nil
+	}
+	out := make([]TokenLogprob, 0, len(lp.Content))
+	for _, tok := range lp.Content {
+		entry := TokenLogprob{Token: tok.Token, LogProb: tok.LogProb}
+		for _, alt := range tok.TopLogProbs {
+			entry.TopLogprobs = append(entry.TopLogprobs, TopLogprob{Token: alt.Token, LogProb: alt.LogProb})
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
 func clampMaxTokens(n int) int {
 	if n <= 0 {
 		return 0
@@ -347,6 +471,7 @@ func openAIToResponse(resp *openai.ChatCompletionResponse, choice *openai.ChatCo
 			InputTokens:  resp.Usage.PromptTokens,
 			OutputTokens: resp.Usage.CompletionTokens,
 		},
+		Logprobs: openAILogprobsToTokenLogprobs(choice.LogProbs),
 	}
 
 	msg := choice.Message
@@ -258,6 +258,179 @@ func TestOpenAIProvider_Complete_BasicAndToolCalls(t *testing.T) {
 	}
 }
 
+func TestOpenAIProvider_Complete_SeedPassthrough(t *testing.T) {
+	t.Parallel()
+
+	var gotSeed *openai.ChatCompletionRequest
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openai.ChatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		gotSeed = &req
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{{
+				Message: openai.ChatCompletionMessage{Content: "ok"},
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	p := NewOpenAIProvider("k", srv.URL+"/v1", openai.GPT4o)
+
+	if _, err := p.Complete(context.Background(), &Request{Messages: []Message{{Role: "user", Content: "hi"}}}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if gotSeed.Seed != nil {
+		t.Fatalf("Seed: got %v want nil when Request.Seed is unset", gotSeed.Seed)
+	}
+
+	if _, err := p.Complete(context.Background(), &Request{Messages: []Message{{Role: "user", Content: "hi"}}, Seed: 12345}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if gotSeed.Seed == nil || *gotSeed.Seed != 12345 {
+		t.Fatalf("Seed: got %v want 12345", gotSeed.Seed)
+	}
+}
+
+func TestOpenAIProvider_Complete_ModelOverride(t *testing.T) {
+	t.Parallel()
+
+	var gotReq *openai.ChatCompletionRequest
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openai.ChatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		gotReq = &req
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{{
+				Message: openai.ChatCompletionMessage{Content: "ok"},
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	p := NewOpenAIProvider("k", srv.URL+"/v1", "gpt-4o")
+
+	if _, err := p.Complete(context.Background(), &Request{Messages: []Message{{Role: "user", Content: "hi"}}}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if gotReq.Model != "gpt-4o" {
+		t.Fatalf("Model: got %q want provider default %q", gotReq.Model, "gpt-4o")
+	}
+
+	if _, err := p.Complete(context.Background(), &Request{Messages: []Message{{Role: "user", Content: "hi"}}, Model: "gpt-4o-mini"}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if gotReq.Model != "gpt-4o-mini" {
+		t.Fatalf("Model: got %q want override %q", gotReq.Model, "gpt-4o-mini")
+	}
+}
+
+func TestOpenAIProvider_Complete_StopSequencesPassthrough(t *testing.T) {
+	t.Parallel()
+
+	var gotReq *openai.ChatCompletionRequest
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openai.ChatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		gotReq = &req
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{{
+				Message: openai.ChatCompletionMessage{Content: "ok"},
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	p := NewOpenAIProvider("k", srv.URL+"/v1", openai.GPT4o)
+
+	if _, err := p.Complete(context.Background(), &Request{Messages: []Message{{Role: "user", Content: "hi"}}}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if len(gotReq.Stop) != 0 {
+		t.Fatalf("Stop: got %v want empty when Request.StopSequences is unset", gotReq.Stop)
+	}
+
+	if _, err := p.Complete(context.Background(), &Request{
+		Messages:      []Message{{Role: "user", Content: "hi"}},
+		StopSequences: []string{"\n\n", "END"},
+	}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if len(gotReq.Stop) != 2 || gotReq.Stop[0] != "\n\n" || gotReq.Stop[1] != "END" {
+		t.Fatalf("Stop: got %v want [\"\\n\\n\" \"END\"]", gotReq.Stop)
+	}
+}
+
+func TestOpenAIProvider_Complete_LogprobsPassthrough(t *testing.T) {
+	t.Parallel()
+
+	var gotReq *openai.ChatCompletionRequest
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req openai.ChatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		gotReq = &req
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{{
+				Message: openai.ChatCompletionMessage{Content: "ok"},
+				LogProbs: &openai.LogProbs{
+					Content: []openai.LogProb{
+						{Token: "ok", LogProb: -0.01, TopLogProbs: []openai.TopLogProbs{
+							{Token: "ok", LogProb: -0.01},
+							{Token: "sure", LogProb: -3.2},
+						}},
+					},
+				},
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	p := NewOpenAIProvider("k", srv.URL+"/v1", openai.GPT4o)
+
+	if _, err := p.Complete(context.Background(), &Request{Messages: []Message{{Role: "user", Content: "hi"}}}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if gotReq.LogProbs {
+		t.Fatalf("LogProbs: got true want false when Request.Logprobs is unset")
+	}
+
+	resp, err := p.Complete(context.Background(), &Request{
+		Messages: []Message{{Role: "user", Content: "hi"}},
+		Logprobs: true,
+	})
+	if err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if !gotReq.LogProbs || gotReq.TopLogProbs != openAITopLogprobs {
+		t.Fatalf("LogProbs/TopLogProbs: got %v/%d want true/%d", gotReq.LogProbs, gotReq.TopLogProbs, openAITopLogprobs)
+	}
+	if len(resp.Logprobs) != 1 || resp.Logprobs[0].Token != "ok" || len(resp.Logprobs[0].TopLogprobs) != 2 {
+		t.Fatalf("Response.Logprobs: got %#v", resp.Logprobs)
+	}
+
+	eval, err := p.CompleteWithTools(context.Background(), &Request{
+		Messages: []Message{{Role: "user", Content: "hi"}},
+		Logprobs: true,
+	})
+	if err != nil {
+		t.Fatalf("CompleteWithTools: %v", err)
+	}
+	if len(eval.Logprobs) != 1 || eval.Logprobs[0].Token != "ok" {
+		t.Fatalf("EvalResult.Logprobs: got %#v", eval.Logprobs)
+	}
+}
+
 func TestOpenAIProvider_CompleteWithTools(t *testing.T) {
 	t.Parallel()
 
@@ -581,3 +754,57 @@ func TestOpenAIProvider_CompleteMultiTurn_Errors(t *testing.T) {
 		t.Fatalf("CompleteMultiTurn(max steps): got %v", err)
 	}
 }
+
+func TestOpenAIProvider_HeaderInjection(t *testing.T) {
+	t.Parallel()
+
+	var gotHeaders http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		_ = r.Body.Close()
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			ID:      "chatcmpl_1",
+			Object:  "chat.completion",
+			Created: time.Now().Unix(),
+			Model:   openai.GPT4o,
+			Choices: []openai.ChatCompletionChoice{{
+				Index:        0,
+				FinishReason: openai.FinishReasonStop,
+				Message:      openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant, Content: "hello"},
+			}},
+			Usage: openai.Usage{PromptTokensDetails: &openai.PromptTokensDetails{}, CompletionTokensDetails: &openai.CompletionTokensDetails{}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	p := NewOpenAIProvider("k", srv.URL+"/v1", openai.GPT4o, WithOpenAIHeaders(map[string]string{
+		"X-Gateway-Auth": "static-token",
+		"X-Route-To":     "default-pool",
+	}))
+
+	if _, err := p.Complete(context.Background(), &Request{Messages: []Message{{Role: "user", Content: "hi"}}}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if got := gotHeaders.Get("X-Gateway-Auth"); got != "static-token" {
+		t.Fatalf("X-Gateway-Auth: got %q want %q", got, "static-token")
+	}
+	if got := gotHeaders.Get("X-Route-To"); got != "default-pool" {
+		t.Fatalf("X-Route-To: got %q want %q", got, "default-pool")
+	}
+
+	// A per-request header overrides the static one on a matching key, while
+	// leaving other static headers untouched.
+	if _, err := p.Complete(context.Background(), &Request{
+		Messages: []Message{{Role: "user", Content: "hi"}},
+		Headers:  map[string]string{"X-Route-To": "fast-pool"},
+	}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if got := gotHeaders.Get("X-Route-To"); got != "fast-pool" {
+		t.Fatalf("X-Route-To override: got %q want %q", got, "fast-pool")
+	}
+	if got := gotHeaders.Get("X-Gateway-Auth"); got != "static-token" {
+		t.Fatalf("X-Gateway-Auth: got %q want %q", got, "static-token")
+	}
+}
@@ -43,6 +43,48 @@ type Request struct {
 	MaxTokens   int
 	Temperature float64
 	Tools       []ToolDefinition
+
+	// Model overrides the provider's configured default model for this
+	// call. Empty means use the provider's default.
+	Model string
+
+	// Seed requests deterministic sampling from providers that support it.
+	// Zero means "unset"; providers without a native seed parameter (e.g.
+	// Claude) ignore it.
+	Seed int64
+
+	// Headers are attached to this request's outbound HTTP call, overriding
+	// any static provider-level headers on a matching key. Currently only
+	// honored by the OpenAI-compatible provider (see WithOpenAIHeaders).
+	Headers map[string]string
+
+	// StopSequences requests that generation stop as soon as one of these
+	// strings is emitted, before MaxTokens is reached. Nil/empty means
+	// "unset". Currently only honored by the OpenAI provider (Stop).
+	StopSequences []string
+
+	// Logprobs requests per-output-token log probabilities, opt-in to avoid
+	// the extra response payload when unused. Currently only honored by the
+	// OpenAI provider; providers without logprob support (e.g. Claude)
+	// ignore it and return a response with no Logprobs, which the
+	// "confidence" evaluator treats as ungated rather than a failure.
+	Logprobs bool
+}
+
+// TopLogprob is one candidate token and its log probability considered at a
+// single output token position.
+type TopLogprob struct {
+	Token   string  `json:"token"`
+	LogProb float64 `json:"logprob"`
+}
+
+// TokenLogprob is the log probability of one actual output token, along with
+// the other candidates the provider considered at that position (see
+// Request.Logprobs).
+type TokenLogprob struct {
+	Token       string       `json:"token"`
+	LogProb     float64      `json:"logprob"`
+	TopLogprobs []TopLogprob `json:"top_logprobs,omitempty"`
 }
 
 type ContentBlock struct {
@@ -62,6 +104,10 @@ type Response struct {
 	Content    []ContentBlock
 	Usage      Usage
 	StopReason string
+
+	// Logprobs holds per-token log probability info when the request set
+	// Logprobs and the provider supports it; nil otherwise.
+	Logprobs []TokenLogprob
 }
 
 type EvalResult struct {
@@ -72,6 +118,10 @@ type EvalResult struct {
 	InputTokens  int
 	OutputTokens int
 	Error        error
+
+	// Logprobs mirrors Response.Logprobs; nil when the request didn't ask
+	// for logprobs or the provider doesn't support them.
+	Logprobs []TokenLogprob
 }
 
 type MultiTurnResult struct {
@@ -83,3 +133,14 @@ type MultiTurnResult struct {
 	TotalOutputTokens int
 	Steps             int
 }
+
+// ToolCallResult pairs a tool call with what the executor actually returned
+// for it. Unlike ToolUse (name + input only), this carries the runtime
+// output, so evaluators like "agent_faithfulness" can check the final
+// response against what the tools returned, not just which tools were
+// called.
+type ToolCallResult struct {
+	Call   ToolUse `json:"call"`
+	Output string  `json:"output,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
@@ -1,6 +1,7 @@
 package prompt
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,8 +25,8 @@ func LoadFromFile(path string) (*Prompt, error) {
 	return &p, nil
 }
 
-// LoadFromDir loads all prompt definitions from a directory.
-func LoadFromDir(dir string) ([]*Prompt, error) {
+// listPromptFiles returns the sorted paths of dir's .yaml/.yml files.
+func listPromptFiles(dir string) ([]string, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, fmt.Errorf("prompt: read dir %q: %w", dir, err)
@@ -43,6 +44,17 @@ func LoadFromDir(dir string) ([]*Prompt, error) {
 		paths = append(paths, filepath.Join(dir, entry.Name()))
 	}
 	sort.Strings(paths)
+	return paths, nil
+}
+
+// LoadFromDir loads all prompt definitions from a directory. A single file
+// that fails to parse fails the whole load; see LoadFromDirLenient for a
+// mode that skips bad files instead.
+func LoadFromDir(dir string) ([]*Prompt, error) {
+	paths, err := listPromptFiles(dir)
+	if err != nil {
+		return nil, err
+	}
 
 	out := make([]*Prompt, 0, len(paths))
 	for _, path := range paths {
@@ -54,3 +66,140 @@ func LoadFromDir(dir string) ([]*Prompt, error) {
 	}
 	return out, nil
 }
+
+// LoadError records a single file that failed to parse during a lenient
+// LoadFromDirLenient load.
+type LoadError struct {
+	Path string
+	Err  error
+}
+
+func (e LoadError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+func (e LoadError) Unwrap() error {
+	return e.Err
+}
+
+// LoadFromDirLenient loads all prompt definitions from dir like LoadFromDir,
+// but a file that fails to parse is skipped and recorded in the returned
+// errs instead of failing the whole load. The directory itself still must
+// be readable; that failure is returned as err. Callers that need every
+// prompt to be valid (e.g. `eval run`) should use LoadFromDir instead.
+func LoadFromDirLenient(dir string) (prompts []*Prompt, errs []LoadError, err error) {
+	paths, err := listPromptFiles(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prompts = make([]*Prompt, 0, len(paths))
+	for _, path := range paths {
+		p, err := LoadFromFile(path)
+		if err != nil {
+			errs = append(errs, LoadError{Path: path, Err: err})
+			continue
+		}
+		prompts = append(prompts, p)
+	}
+	return prompts, errs, nil
+}
+
+// LoadFromDirForEnv loads all prompt definitions from dir like LoadFromDir,
+// then patches each one for env via ApplyEnvironment. env == "" behaves
+// identically to LoadFromDir.
+func LoadFromDirForEnv(dir string, env string) ([]*Prompt, error) {
+	prompts, err := LoadFromDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range prompts {
+		if err := ApplyEnvironment(p, env); err != nil {
+			return nil, err
+		}
+	}
+	return prompts, nil
+}
+
+// ApplyEnvironment patches p in place using the Override declared for env, if
+// any. env == "" is a no-op regardless of what p declares. If p declares any
+// overrides but none of them match env, that's a configuration error rather
+// than a silent no-op, since it usually means a typo'd or retired
+// environment name.
+func ApplyEnvironment(p *Prompt, env string) error {
+	if p == nil {
+		return errors.New("prompt: nil prompt")
+	}
+	env = strings.TrimSpace(env)
+	if env == "" || len(p.Overrides) == 0 {
+		return nil
+	}
+
+	ov, ok := p.Overrides[env]
+	if !ok {
+		known := make([]string, 0, len(p.Overrides))
+		for name := range p.Overrides {
+			known = append(known, name)
+		}
+		sort.Strings(known)
+		return fmt.Errorf("prompt: %q: unknown environment %q (declared: %s)", p.Name, env, strings.Join(known, ", "))
+	}
+
+	applyPatch(p, ov)
+	return nil
+}
+
+// applyPatch mutates p in place with ov's non-zero fields.
+func applyPatch(p *Prompt, ov Override) {
+	if ov.Template != "" {
+		p.Template = ov.Template
+	}
+	if len(ov.Variables) > 0 {
+		byName := make(map[string]int, len(p.Variables))
+		for i, v := range p.Variables {
+			byName[v.Name] = i
+		}
+		for _, v := range ov.Variables {
+			if idx, ok := byName[v.Name]; ok {
+				p.Variables[idx] = v
+			} else {
+				p.Variables = append(p.Variables, v)
+			}
+		}
+	}
+	if len(ov.Metadata) > 0 {
+		if p.Metadata == nil {
+			p.Metadata = make(map[string]any, len(ov.Metadata))
+		}
+		for k, v := range ov.Metadata {
+			p.Metadata[k] = v
+		}
+	}
+}
+
+// WithOverride returns a copy of p with ov applied (see ApplyEnvironment's
+// patch semantics), leaving p itself untouched. Intended for one-off,
+// per-case patches (e.g. testcase.TestCase.PromptOverride) where several
+// cases share the same *Prompt and mutating it in place would leak the
+// patch into cases that didn't ask for it. ov == nil returns p unchanged.
+func WithOverride(p *Prompt, ov *Override) (*Prompt, error) {
+	if p == nil {
+		return nil, errors.New("prompt: nil prompt")
+	}
+	if ov == nil {
+		return p, nil
+	}
+
+	clone := *p
+	if len(p.Variables) > 0 {
+		clone.Variables = append([]Variable(nil), p.Variables...)
+	}
+	if len(p.Metadata) > 0 {
+		clone.Metadata = make(map[string]any, len(p.Metadata))
+		for k, v := range p.Metadata {
+			clone.Metadata[k] = v
+		}
+	}
+	applyPatch(&clone, *ov)
+	return &clone, nil
+}
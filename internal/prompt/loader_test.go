@@ -1,8 +1,10 @@
 package prompt
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -136,3 +138,225 @@ func TestLoadFromDir_SkipsSubdirs(t *testing.T) {
 		t.Fatalf("LoadFromDir: got %#v", ps)
 	}
 }
+
+func TestLoadFromDirLenient(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	write := func(name, body string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s): %v", name, err)
+		}
+	}
+
+	write("a.yaml", "name: a\ntemplate: a\n")
+	write("bad.yaml", ":\n")
+	write("b.yaml", "name: b\ntemplate: b\n")
+
+	ps, errs, err := LoadFromDirLenient(dir)
+	if err != nil {
+		t.Fatalf("LoadFromDirLenient: %v", err)
+	}
+	if len(ps) != 2 {
+		t.Fatalf("len(prompts): got %d want %d", len(ps), 2)
+	}
+	if ps[0].Name != "a" || ps[1].Name != "b" {
+		t.Fatalf("order: got %q, %q", ps[0].Name, ps[1].Name)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("len(errs): got %d want %d", len(errs), 1)
+	}
+	if !strings.HasSuffix(errs[0].Path, "bad.yaml") {
+		t.Fatalf("errs[0].Path: got %q", errs[0].Path)
+	}
+	if !strings.Contains(errs[0].Error(), "bad.yaml") {
+		t.Fatalf("errs[0].Error(): got %q", errs[0].Error())
+	}
+	if errors.Unwrap(errs[0]) == nil {
+		t.Fatalf("errs[0]: expected Unwrap to return the underlying parse error")
+	}
+}
+
+func TestLoadFromDirLenient_Missing(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := LoadFromDirLenient(filepath.Join(t.TempDir(), "missing"))
+	if err == nil {
+		t.Fatalf("LoadFromDirLenient: expected error")
+	}
+}
+
+func TestApplyEnvironment(t *testing.T) {
+	t.Parallel()
+
+	base := func() *Prompt {
+		return &Prompt{
+			Name:     "p",
+			Template: "base template",
+			Variables: []Variable{
+				{Name: "tone", Default: "formal"},
+			},
+			Metadata: map[string]any{"owner": "core"},
+			Overrides: map[string]Override{
+				"staging": {
+					Template:  "staging template",
+					Variables: []Variable{{Name: "tone", Default: "casual"}},
+					Metadata:  map[string]any{"banner": "staging"},
+				},
+			},
+		}
+	}
+
+	t.Run("empty env is a no-op", func(t *testing.T) {
+		t.Parallel()
+		p := base()
+		if err := ApplyEnvironment(p, ""); err != nil {
+			t.Fatalf("ApplyEnvironment: %v", err)
+		}
+		if p.Template != "base template" {
+			t.Fatalf("Template: got %q", p.Template)
+		}
+	})
+
+	t.Run("no overrides declared is a no-op regardless of env", func(t *testing.T) {
+		t.Parallel()
+		p := &Prompt{Name: "p", Template: "x"}
+		if err := ApplyEnvironment(p, "prod"); err != nil {
+			t.Fatalf("ApplyEnvironment: %v", err)
+		}
+		if p.Template != "x" {
+			t.Fatalf("Template: got %q", p.Template)
+		}
+	})
+
+	t.Run("matching env patches template, variables and metadata", func(t *testing.T) {
+		t.Parallel()
+		p := base()
+		if err := ApplyEnvironment(p, "staging"); err != nil {
+			t.Fatalf("ApplyEnvironment: %v", err)
+		}
+		if p.Template != "staging template" {
+			t.Fatalf("Template: got %q", p.Template)
+		}
+		if len(p.Variables) != 1 || p.Variables[0].Default != "casual" {
+			t.Fatalf("Variables: got %#v", p.Variables)
+		}
+		if p.Metadata["owner"] != "core" || p.Metadata["banner"] != "staging" {
+			t.Fatalf("Metadata: got %#v", p.Metadata)
+		}
+	})
+
+	t.Run("unknown env is an error", func(t *testing.T) {
+		t.Parallel()
+		p := base()
+		err := ApplyEnvironment(p, "prod")
+		if err == nil || !strings.Contains(err.Error(), `unknown environment "prod"`) {
+			t.Fatalf("ApplyEnvironment: got %v", err)
+		}
+	})
+
+	t.Run("nil prompt is an error", func(t *testing.T) {
+		t.Parallel()
+		if err := ApplyEnvironment(nil, "prod"); err == nil {
+			t.Fatalf("ApplyEnvironment: expected error")
+		}
+	})
+}
+
+func TestWithOverride(t *testing.T) {
+	t.Parallel()
+
+	base := &Prompt{
+		Name:      "p",
+		Template:  "base template",
+		Variables: []Variable{{Name: "tone", Default: "formal"}},
+		Metadata:  map[string]any{"owner": "core"},
+	}
+
+	t.Run("nil override returns the same prompt unchanged", func(t *testing.T) {
+		t.Parallel()
+		got, err := WithOverride(base, nil)
+		if err != nil {
+			t.Fatalf("WithOverride: %v", err)
+		}
+		if got != base {
+			t.Fatalf("expected the same *Prompt back, got a different pointer")
+		}
+	})
+
+	t.Run("patches a copy, leaving the original untouched", func(t *testing.T) {
+		t.Parallel()
+		patched, err := WithOverride(base, &Override{
+			Template:  "patched template",
+			Variables: []Variable{{Name: "tone", Default: "casual"}},
+			Metadata:  map[string]any{"banner": "ablation"},
+		})
+		if err != nil {
+			t.Fatalf("WithOverride: %v", err)
+		}
+		if patched.Template != "patched template" {
+			t.Fatalf("patched.Template: got %q", patched.Template)
+		}
+		if len(patched.Variables) != 1 || patched.Variables[0].Default != "casual" {
+			t.Fatalf("patched.Variables: got %#v", patched.Variables)
+		}
+		if patched.Metadata["owner"] != "core" || patched.Metadata["banner"] != "ablation" {
+			t.Fatalf("patched.Metadata: got %#v", patched.Metadata)
+		}
+
+		if base.Template != "base template" {
+			t.Fatalf("base.Template mutated: got %q", base.Template)
+		}
+		if len(base.Variables) != 1 || base.Variables[0].Default != "formal" {
+			t.Fatalf("base.Variables mutated: got %#v", base.Variables)
+		}
+		if _, ok := base.Metadata["banner"]; ok {
+			t.Fatalf("base.Metadata mutated: got %#v", base.Metadata)
+		}
+	})
+
+	t.Run("nil prompt is an error", func(t *testing.T) {
+		t.Parallel()
+		if _, err := WithOverride(nil, &Override{Template: "x"}); err == nil {
+			t.Fatalf("WithOverride: expected error")
+		}
+	})
+}
+
+func TestLoadFromDirForEnv(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	const in = `
+name: p
+template: base
+overrides:
+  prod:
+    template: prod-only
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.yaml"), []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	ps, err := LoadFromDirForEnv(dir, "")
+	if err != nil {
+		t.Fatalf("LoadFromDirForEnv: %v", err)
+	}
+	if len(ps) != 1 || ps[0].Template != "base" {
+		t.Fatalf("env=\"\": got %#v", ps)
+	}
+
+	ps, err = LoadFromDirForEnv(dir, "prod")
+	if err != nil {
+		t.Fatalf("LoadFromDirForEnv: %v", err)
+	}
+	if len(ps) != 1 || ps[0].Template != "prod-only" {
+		t.Fatalf("env=prod: got %#v", ps)
+	}
+
+	if _, err := LoadFromDirForEnv(dir, "staging"); err == nil {
+		t.Fatalf("LoadFromDirForEnv: expected error for unknown environment")
+	}
+}
@@ -69,6 +69,41 @@ func Render(p *Prompt, vars map[string]any) (string, error) {
 	return rendered, nil
 }
 
+// Wrap applies wrapperTemplate, a Go text/template with the already-rendered
+// prompt available as {{.prompt}}, to rendered and returns the result. An
+// empty wrapperTemplate is a no-op, returning rendered unchanged.
+func Wrap(rendered string, wrapperTemplate string) (string, error) {
+	if wrapperTemplate == "" {
+		return rendered, nil
+	}
+
+	tmpl, err := template.New("wrapper").Option("missingkey=error").Parse(wrapperTemplate)
+	if err != nil {
+		return "", fmt.Errorf("prompt: parse wrapper template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]any{"prompt": rendered}); err != nil {
+		return "", fmt.Errorf("prompt: render wrapper template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// ValidateTemplate checks that s is syntactically usable as a prompt
+// template: balanced {{ }} delimiters, and, if it contains Go template
+// constructs, that they parse. It doesn't require variables to be supplied
+// (unlike Render), so it can run at load/validation time before any case
+// input is known.
+func ValidateTemplate(s string) error {
+	if strings.Contains(s, "{{.") || strings.Contains(s, "{{range") || strings.Contains(s, "{{if") {
+		if _, err := template.New("validate").Parse(s); err != nil {
+			return fmt.Errorf("prompt: parse template: %w", err)
+		}
+		return nil
+	}
+	return validateTemplateDelimiters(s)
+}
+
 func validateTemplateDelimiters(s string) error {
 	open := 0
 	for i := 0; i+1 < len(s); i++ {
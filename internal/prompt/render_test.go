@@ -174,6 +174,35 @@ func TestRender_EmptyVariableNameIgnored(t *testing.T) {
 	}
 }
 
+func TestValidateTemplate(t *testing.T) {
+	t.Parallel()
+
+	if err := ValidateTemplate("Hello {{NAME}}"); err != nil {
+		t.Fatalf("ValidateTemplate: %v", err)
+	}
+	if err := ValidateTemplate("Hello {{.name}}"); err != nil {
+		t.Fatalf("ValidateTemplate: %v", err)
+	}
+}
+
+func TestValidateTemplate_UnmatchedDelimiters(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateTemplate("oops }}")
+	if err == nil || !strings.Contains(err.Error(), "unmatched") {
+		t.Fatalf("ValidateTemplate: got %v", err)
+	}
+}
+
+func TestValidateTemplate_BadGoTemplate(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateTemplate("{{.name")
+	if err == nil || !strings.Contains(err.Error(), "parse template") {
+		t.Fatalf("ValidateTemplate: got %v", err)
+	}
+}
+
 func TestRender_DefaultNotOverrideExisting(t *testing.T) {
 	t.Parallel()
 
@@ -193,3 +222,36 @@ func TestRender_DefaultNotOverrideExisting(t *testing.T) {
 		t.Fatalf("out: got %q want %q", out, "Lang python")
 	}
 }
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+
+	out, err := Wrap("Hello Alice", "PREAMBLE\n{{.prompt}}\nFOOTER")
+	if err != nil {
+		t.Fatalf("Wrap: %v", err)
+	}
+	if want := "PREAMBLE\nHello Alice\nFOOTER"; out != want {
+		t.Fatalf("out: got %q want %q", out, want)
+	}
+}
+
+func TestWrap_EmptyTemplateIsNoop(t *testing.T) {
+	t.Parallel()
+
+	out, err := Wrap("Hello Alice", "")
+	if err != nil {
+		t.Fatalf("Wrap: %v", err)
+	}
+	if out != "Hello Alice" {
+		t.Fatalf("out: got %q want %q", out, "Hello Alice")
+	}
+}
+
+func TestWrap_BadTemplate(t *testing.T) {
+	t.Parallel()
+
+	_, err := Wrap("Hello Alice", "{{.prompt")
+	if err == nil || !strings.Contains(err.Error(), "parse wrapper template") {
+		t.Fatalf("Wrap: got %v", err)
+	}
+}
@@ -10,6 +10,26 @@ type Prompt struct {
 	Tools          []Tool         `yaml:"tools"`
 	Metadata       map[string]any `yaml:"metadata"`
 	IsSystemPrompt bool           `yaml:"is_system_prompt,omitempty"` // If true, use as system message
+	StopSequences  []string       `yaml:"stop_sequences,omitempty"`   // Passed through to llm.Request; test cases may override
+
+	// SkipPromptWrapper opts this prompt out of the configured
+	// runner.Config.PromptWrapper, so it's sent to the model exactly as
+	// rendered. Useful for prompts that already embed their own
+	// preamble/footer or that a wrapper would otherwise break.
+	SkipPromptWrapper bool `yaml:"skip_prompt_wrapper,omitempty"`
+
+	// Overrides patches Template/Variables/Metadata for a named environment
+	// (e.g. "prod", "staging") so near-duplicate prompt files aren't needed
+	// just to vary a few tokens between them. Applied at load time via
+	// LoadFromDirForEnv/ApplyEnvironment; ignored by plain LoadFromDir.
+	Overrides map[string]Override `yaml:"overrides,omitempty"`
+}
+
+// Override patches a subset of a Prompt's fields for one environment.
+type Override struct {
+	Template  string         `yaml:"template,omitempty"`
+	Variables []Variable     `yaml:"variables,omitempty"`
+	Metadata  map[string]any `yaml:"metadata,omitempty"`
 }
 
 // Variable defines a prompt variable and defaults.
@@ -0,0 +1,114 @@
+// Package redact centralizes masking of secrets and PII so both the store
+// and request logging apply the same rules before data leaves memory.
+package redact
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Mask is the placeholder substituted for redacted values.
+const Mask = "***REDACTED***"
+
+// defaultKeyPatterns match map/struct keys that commonly hold credentials.
+// Matching is case-insensitive and substring-based (e.g. "api_key" matches
+// "claude_api_key").
+var defaultKeyPatterns = []string{"api_key", "apikey", "token", "secret", "password"}
+
+// Redactor masks sensitive values by key name and, optionally, scrubs PII
+// patterns out of free-form text such as logged prompt content.
+type Redactor struct {
+	keyPatterns []string
+	piiPatterns []*regexp.Regexp
+}
+
+// New builds a Redactor. keyPatterns are lower-cased substrings matched
+// against map keys (case-insensitively); a nil slice uses the built-in
+// defaults. piiPatterns are compiled regexes scrubbed out of text passed to
+// RedactString; an invalid pattern is skipped.
+func New(keyPatterns []string, piiPatterns []string) *Redactor {
+	if keyPatterns == nil {
+		keyPatterns = defaultKeyPatterns
+	}
+	lowered := make([]string, 0, len(keyPatterns))
+	for _, k := range keyPatterns {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k == "" {
+			continue
+		}
+		lowered = append(lowered, k)
+	}
+
+	compiled := make([]*regexp.Regexp, 0, len(piiPatterns))
+	for _, p := range piiPatterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if re, err := regexp.Compile(p); err == nil {
+			compiled = append(compiled, re)
+		}
+	}
+
+	return &Redactor{keyPatterns: lowered, piiPatterns: compiled}
+}
+
+// Default returns a Redactor using the built-in key patterns and no PII
+// scrubbing.
+func Default() *Redactor {
+	return New(nil, nil)
+}
+
+// KeyMatches reports whether key looks like it names a secret.
+func (r *Redactor) KeyMatches(key string) bool {
+	if r == nil {
+		return false
+	}
+	key = strings.ToLower(key)
+	for _, p := range r.keyPatterns {
+		if strings.Contains(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Map returns a shallow copy of m with values masked wherever the key
+// matches a configured pattern. Nested maps are redacted recursively; other
+// value types are left untouched. A nil Redactor returns m unchanged.
+func (r *Redactor) Map(m map[string]any) map[string]any {
+	if r == nil || m == nil {
+		return m
+	}
+
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		switch {
+		case r.KeyMatches(k):
+			out[k] = Mask
+		case isStringMap(v):
+			out[k] = r.Map(v.(map[string]any))
+		default:
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// String scrubs any configured PII patterns out of s, replacing matches
+// with Mask. A nil Redactor or one with no PII patterns returns s
+// unchanged.
+func (r *Redactor) String(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, re := range r.piiPatterns {
+		s = re.ReplaceAllString(s, Mask)
+	}
+	return s
+}
+
+func isStringMap(v any) bool {
+	_, ok := v.(map[string]any)
+	return ok
+}
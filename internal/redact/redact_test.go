@@ -0,0 +1,92 @@
+package redact
+
+import "testing"
+
+func TestRedactor_Map(t *testing.T) {
+	t.Parallel()
+
+	r := Default()
+	in := map[string]any{
+		"prompt":          "hello",
+		"api_key":         "sk-abc123",
+		"ANTHROPIC_TOKEN": "tok-xyz",
+		"nested": map[string]any{
+			"secret": "s3cr3t",
+			"count":  3,
+		},
+	}
+
+	out := r.Map(in)
+	if out["prompt"] != "hello" {
+		t.Fatalf("prompt: got %v want unchanged", out["prompt"])
+	}
+	if out["api_key"] != Mask {
+		t.Fatalf("api_key: got %v want masked", out["api_key"])
+	}
+	if out["ANTHROPIC_TOKEN"] != Mask {
+		t.Fatalf("ANTHROPIC_TOKEN: got %v want masked", out["ANTHROPIC_TOKEN"])
+	}
+	nested, ok := out["nested"].(map[string]any)
+	if !ok {
+		t.Fatalf("nested: got %T want map[string]any", out["nested"])
+	}
+	if nested["secret"] != Mask {
+		t.Fatalf("nested.secret: got %v want masked", nested["secret"])
+	}
+	if nested["count"] != 3 {
+		t.Fatalf("nested.count: got %v want unchanged", nested["count"])
+	}
+
+	// Original map is untouched.
+	if in["api_key"] != "sk-abc123" {
+		t.Fatalf("Map: mutated input map")
+	}
+}
+
+func TestRedactor_Map_NilSafe(t *testing.T) {
+	t.Parallel()
+
+	var r *Redactor
+	if got := r.Map(map[string]any{"api_key": "x"}); got["api_key"] != "x" {
+		t.Fatalf("nil Redactor: expected passthrough, got %v", got)
+	}
+	if Default().Map(nil) != nil {
+		t.Fatalf("Map(nil): expected nil")
+	}
+}
+
+func TestRedactor_CustomKeyPatterns(t *testing.T) {
+	t.Parallel()
+
+	r := New([]string{"ssn"}, nil)
+	out := r.Map(map[string]any{"ssn": "123-45-6789", "api_key": "unmasked-because-not-configured"})
+	if out["ssn"] != Mask {
+		t.Fatalf("ssn: got %v want masked", out["ssn"])
+	}
+	if out["api_key"] != "unmasked-because-not-configured" {
+		t.Fatalf("api_key: got %v want unchanged (not in custom pattern list)", out["api_key"])
+	}
+}
+
+func TestRedactor_String(t *testing.T) {
+	t.Parallel()
+
+	r := New(nil, []string{`\d{3}-\d{2}-\d{4}`})
+	got := r.String("contact me, ssn 123-45-6789 please")
+	if got != "contact me, ssn "+Mask+" please" {
+		t.Fatalf("String: got %q", got)
+	}
+
+	if Default().String("unchanged") != "unchanged" {
+		t.Fatalf("String: default redactor with no PII patterns should be a no-op")
+	}
+}
+
+func TestRedactor_InvalidPIIPatternIsSkipped(t *testing.T) {
+	t.Parallel()
+
+	r := New(nil, []string{"["})
+	if got := r.String("["); got != "[" {
+		t.Fatalf("String: expected invalid pattern to be ignored, got %q", got)
+	}
+}
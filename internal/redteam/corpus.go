@@ -0,0 +1,137 @@
+package redteam
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+// AttackEntry is one curated attack loaded from a --attacks corpus file (see
+// LoadAttacks).
+type AttackEntry struct {
+	ID               string `yaml:"id" json:"id"`
+	Category         string `yaml:"category" json:"category"`
+	Attack           string `yaml:"attack" json:"attack"`
+	Description      string `yaml:"description,omitempty" json:"description,omitempty"`
+	ExpectedBehavior string `yaml:"expected_behavior,omitempty" json:"expected_behavior,omitempty"`
+}
+
+// attackCorpusFile is the YAML document shape LoadAttacks expects, mirroring
+// testcase.TestSuite's top-level "cases:" list.
+type attackCorpusFile struct {
+	Attacks []AttackEntry `yaml:"attacks"`
+}
+
+// LoadAttacks loads a curated attack corpus from path and converts it into
+// test cases runRedteamSuite can execute alongside (or instead of)
+// Generator.Generate's output. Format is chosen by extension: ".jsonl"
+// parses one JSON AttackEntry per line (blank lines skipped); anything else
+// parses a YAML document with a top-level "attacks:" list.
+//
+// Every case is tagged Metadata["source"] = "custom", matching Generate's
+// "generated" tag, so results can be broken down by source afterward.
+//
+// Entries with empty attack text or an unrecognized category are rejected
+// outright rather than silently dropped: a security team's curated corpus
+// is expected to run in full on every release, so a typo should fail the
+// command, not quietly shrink the suite.
+func LoadAttacks(path string) ([]testcase.TestCase, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("redteam: read %q: %w", path, err)
+	}
+
+	var entries []AttackEntry
+	if strings.EqualFold(filepath.Ext(path), ".jsonl") {
+		entries, err = parseAttacksJSONL(b)
+	} else {
+		entries, err = parseAttacksYAML(b)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redteam: parse %q: %w", path, err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("redteam: %q: no attacks", path)
+	}
+
+	seen := make(map[string]int, len(entries))
+	cases := make([]testcase.TestCase, 0, len(entries))
+	for i, e := range entries {
+		attack := strings.TrimSpace(e.Attack)
+		if attack == "" {
+			return nil, fmt.Errorf("redteam: %q: entries[%d] (%s): empty attack text", path, i, e.ID)
+		}
+		cat := Category(strings.ToLower(strings.TrimSpace(e.Category)))
+		if !isKnownCategory(cat) {
+			return nil, fmt.Errorf("redteam: %q: entries[%d] (%s): unknown category %q", path, i, e.ID, e.Category)
+		}
+
+		id := sanitizeCaseID(e.ID)
+		if id == "" {
+			id = fmt.Sprintf("%s_%02d", cat, i+1)
+		}
+		seen[id]++
+		if seen[id] > 1 {
+			id = fmt.Sprintf("%s_%d", id, seen[id])
+		}
+
+		desc := strings.TrimSpace(e.Description)
+		if desc == "" {
+			desc = fmt.Sprintf("redteam category=%s", cat)
+		}
+
+		input := map[string]any{
+			"attack":   attack,
+			"category": string(cat),
+		}
+		if expected := strings.TrimSpace(e.ExpectedBehavior); expected != "" {
+			input["expected_behavior"] = expected
+		}
+
+		cases = append(cases, testcase.TestCase{
+			ID:          id,
+			Description: desc,
+			Input:       input,
+			Trials:      1,
+			Metadata:    map[string]string{"source": "custom"},
+		})
+	}
+	return cases, nil
+}
+
+func parseAttacksYAML(b []byte) ([]AttackEntry, error) {
+	var doc attackCorpusFile
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return doc.Attacks, nil
+}
+
+func parseAttacksJSONL(b []byte) ([]AttackEntry, error) {
+	var entries []AttackEntry
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		var e AttackEntry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
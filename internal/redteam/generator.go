@@ -116,7 +116,8 @@ func (g *Generator) Generate(ctx context.Context, promptTemplate string, categor
 				"attack":   attack,
 				"category": cat,
 			},
-			Trials: 1,
+			Trials:   1,
+			Metadata: map[string]string{"source": "generated"},
 		})
 	}
 
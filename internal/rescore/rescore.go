@@ -0,0 +1,288 @@
+// Package rescore re-runs a chosen set of evaluators against previously
+// persisted trial responses, without calling the model again, turning a run
+// saved with config.EvaluationConfig.PersistResponses into a re-analyzable
+// dataset once an evaluator's rubric or threshold changes.
+package rescore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+// generationOnlyEvaluatorTypes are evaluator types Run can't correctly
+// re-score from a stored SuiteRecord: either the input is only ever
+// available live, during generation (tool call outputs, step counts, token
+// usage, logprobs), and store.CaseRecord/TrialResponseRecord never persist
+// it, or (consistency) the type needs every trial's response at once and
+// Run scores one persisted response at a time. Asking to rescore one of
+// these would silently score against missing or incomplete data, so
+// Options.Validate rejects them up front instead.
+var generationOnlyEvaluatorTypes = map[string]struct{}{
+	"tool_selection":     {},
+	"tool_before_answer": {},
+	"tool_result_usage":  {},
+	"tool_call":          {},
+	"efficiency":         {},
+	"confidence":         {},
+	"agent_faithfulness": {},
+	"consistency":        {},
+}
+
+// Options configures a rescore run.
+type Options struct {
+	// Evaluators is the set of evaluator types to re-run, e.g.
+	// []string{"llm_judge", "similarity"}. Every entry must be scoreable
+	// from a stored response alone; see generationOnlyEvaluatorTypes.
+	Evaluators []string
+}
+
+// Validate normalizes opts.Evaluators into a lookup set, rejecting any
+// generation-only type and an empty selection.
+func (o Options) Validate() (map[string]struct{}, error) {
+	wanted := make(map[string]struct{}, len(o.Evaluators))
+	for _, e := range o.Evaluators {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		if _, ok := generationOnlyEvaluatorTypes[e]; ok {
+			return nil, fmt.Errorf("rescore: %q needs data a stored response alone doesn't have (live generation data, or every trial's response at once); it can't be rescored", e)
+		}
+		wanted[e] = struct{}{}
+	}
+	if len(wanted) == 0 {
+		return nil, errors.New("rescore: no evaluators specified")
+	}
+	return wanted, nil
+}
+
+// Checkpoint tracks which source suite results have already been rescored,
+// keyed by store.SuiteRecord.ID, so an interrupted rescore invocation
+// (crash, ctrl-C, a rate limit) can resume without redoing finished suites.
+type Checkpoint struct {
+	Processed map[string]bool `json:"processed"`
+}
+
+// LoadCheckpoint reads a checkpoint file, returning a fresh empty Checkpoint
+// if path is empty or doesn't exist yet.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	cp := &Checkpoint{Processed: make(map[string]bool)}
+	if path == "" {
+		return cp, nil
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("rescore: read checkpoint %q: %w", path, err)
+	}
+	if err := json.Unmarshal(b, cp); err != nil {
+		return nil, fmt.Errorf("rescore: parse checkpoint %q: %w", path, err)
+	}
+	if cp.Processed == nil {
+		cp.Processed = make(map[string]bool)
+	}
+	return cp, nil
+}
+
+// Save writes cp to path, a no-op when path is empty.
+func (cp *Checkpoint) Save(path string) error {
+	if path == "" || cp == nil {
+		return nil
+	}
+	b, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return fmt.Errorf("rescore: marshal checkpoint: %w", err)
+	}
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		return fmt.Errorf("rescore: write checkpoint %q: %w", path, err)
+	}
+	return nil
+}
+
+// Done reports whether sourceSuiteID has already been processed.
+func (cp *Checkpoint) Done(sourceSuiteID string) bool {
+	return cp != nil && cp.Processed[sourceSuiteID]
+}
+
+func (cp *Checkpoint) markDone(sourceSuiteID string) {
+	if cp.Processed == nil {
+		cp.Processed = make(map[string]bool)
+	}
+	cp.Processed[sourceSuiteID] = true
+}
+
+// SuiteOutcome reports the rescored SuiteRecord produced for one source
+// suite result.
+type SuiteOutcome struct {
+	SourceSuiteID string
+	Record        *store.SuiteRecord
+	CasesSkipped  int // Cases with no persisted response, or nothing to rescore for the requested evaluators
+}
+
+// Run re-scores each source suite result's persisted trial responses
+// against wanted's evaluators, matching each case back to its original
+// testcase.TestCase (by suite name and case ID) in suites. Suites already
+// recorded in cp are skipped so a resumed run doesn't redo them.
+//
+// onSuiteDone, if non-nil, is called once per new SuiteRecord, before it is
+// marked done in cp; a caller that persists the record there (see
+// store.RunWriter.SaveSuiteResult) gets true incremental resumability
+// instead of an all-or-nothing save at the end.
+func Run(ctx context.Context, r *runner.Runner, suites map[string]*testcase.TestSuite, sources []*store.SuiteRecord, opts Options, cp *Checkpoint, onSuiteDone func(SuiteOutcome) error) ([]SuiteOutcome, error) {
+	if r == nil {
+		return nil, errors.New("rescore: nil runner")
+	}
+	wanted, err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if cp == nil {
+		cp = &Checkpoint{Processed: make(map[string]bool)}
+	}
+
+	var outcomes []SuiteOutcome
+	for _, src := range sources {
+		if src == nil || cp.Done(src.ID) {
+			continue
+		}
+
+		suite, ok := suites[src.SuiteName]
+		if !ok || suite == nil {
+			return outcomes, fmt.Errorf("rescore: suite %q (from suite result %s) not found among loaded test suites", src.SuiteName, src.ID)
+		}
+
+		casesByID := make(map[string]*testcase.TestCase, len(suite.Cases))
+		for i := range suite.Cases {
+			casesByID[suite.Cases[i].ID] = &suite.Cases[i]
+		}
+
+		caseResults := make([]store.CaseRecord, 0, len(src.CaseResults))
+		skipped := 0
+		var scoreSum float64
+		passedCases := 0
+
+		for _, cr := range src.CaseResults {
+			tc, ok := casesByID[cr.CaseID]
+			if !ok || len(cr.Responses) == 0 {
+				skipped++
+				continue
+			}
+
+			filtered := filterForRescore(tc, wanted)
+			if filtered == nil {
+				skipped++
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return outcomes, ctx.Err()
+			default:
+			}
+
+			// Trials beyond the first were already folded into the source
+			// record's aggregate score; rescoring re-judges the same
+			// response the case originally reported, not every trial.
+			response := cr.Responses[0].Response
+			_, passed, score := r.EvaluateResponse(ctx, filtered, response)
+
+			out := cr
+			out.Passed = passed
+			out.Score = score
+			out.Error = ""
+			caseResults = append(caseResults, out)
+			scoreSum += score
+			if passed {
+				passedCases++
+			}
+		}
+
+		if len(caseResults) == 0 {
+			// Nothing in this suite could be rescored with the requested
+			// evaluators; mark it done so a resumed run stops retrying it.
+			cp.markDone(src.ID)
+			continue
+		}
+
+		rec := &store.SuiteRecord{
+			PromptName:    src.PromptName,
+			PromptVersion: src.PromptVersion,
+			SuiteName:     src.SuiteName,
+			TotalCases:    len(caseResults),
+			PassedCases:   passedCases,
+			FailedCases:   len(caseResults) - passedCases,
+			PassRate:      float64(passedCases) / float64(len(caseResults)),
+			AvgScore:      scoreSum / float64(len(caseResults)),
+			CaseResults:   caseResults,
+			Metadata:      taggedMetadata(src.Metadata, src.ID),
+		}
+
+		outcome := SuiteOutcome{SourceSuiteID: src.ID, Record: rec, CasesSkipped: skipped}
+		if onSuiteDone != nil {
+			if err := onSuiteDone(outcome); err != nil {
+				return outcomes, err
+			}
+		}
+		cp.markDone(src.ID)
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes, nil
+}
+
+// taggedMetadata returns src plus provenance tags marking the result as
+// rescored, so `history` and friends can tell it apart from a live run.
+func taggedMetadata(src map[string]string, sourceSuiteID string) map[string]string {
+	out := make(map[string]string, len(src)+2)
+	for k, v := range src {
+		out[k] = v
+	}
+	out["rescored"] = "true"
+	out["rescored_from"] = sourceSuiteID
+	return out
+}
+
+// filterForRescore returns a copy of tc containing only the evaluators (and
+// matching built-in Expected assertions) named in wanted, or nil if none of
+// them apply to this case.
+func filterForRescore(tc *testcase.TestCase, wanted map[string]struct{}) *testcase.TestCase {
+	out := &testcase.TestCase{ID: tc.ID, Description: tc.Description}
+
+	for _, ec := range tc.Evaluators {
+		if _, ok := wanted[ec.Type]; ok {
+			out.Evaluators = append(out.Evaluators, ec)
+		}
+	}
+
+	if _, ok := wanted["exact"]; ok {
+		out.Expected.ExactMatch = tc.Expected.ExactMatch
+	}
+	if _, ok := wanted["contains"]; ok {
+		out.Expected.Contains = tc.Expected.Contains
+	}
+	if _, ok := wanted["not_contains"]; ok {
+		out.Expected.NotContains = tc.Expected.NotContains
+	}
+	if _, ok := wanted["regex"]; ok {
+		out.Expected.Regex = tc.Expected.Regex
+	}
+	if _, ok := wanted["json_schema"]; ok {
+		out.Expected.JSONSchema = tc.Expected.JSONSchema
+	}
+
+	if len(out.Evaluators) == 0 && out.Expected.ExactMatch == "" && len(out.Expected.Contains) == 0 &&
+		len(out.Expected.NotContains) == 0 && len(out.Expected.Regex) == 0 && len(out.Expected.JSONSchema) == 0 {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,218 @@
+package rescore
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellarlinkco/ai-eval/internal/evaluator"
+	"github.com/stellarlinkco/ai-eval/internal/runner"
+	"github.com/stellarlinkco/ai-eval/internal/store"
+	"github.com/stellarlinkco/ai-eval/internal/testcase"
+)
+
+func newTestRunner() *runner.Runner {
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+	return runner.NewRunner(nil, reg, runner.Config{Trials: 1, Concurrency: 1})
+}
+
+func testSuites() map[string]*testcase.TestSuite {
+	return map[string]*testcase.TestSuite{
+		"greet": {
+			Suite: "greet",
+			Cases: []testcase.TestCase{
+				{
+					ID:         "hello",
+					Expected:   testcase.Expected{ExactMatch: "hello world"},
+					Evaluators: []testcase.EvaluatorConfig{{Type: "exact"}},
+				},
+				{
+					ID:         "farewell",
+					Expected:   testcase.Expected{Contains: []string{"bye"}},
+					Evaluators: []testcase.EvaluatorConfig{{Type: "contains"}},
+				},
+			},
+		},
+	}
+}
+
+func testSource() *store.SuiteRecord {
+	return &store.SuiteRecord{
+		ID:            "run_1_suite_1",
+		RunID:         "run_1",
+		PromptName:    "greeter",
+		PromptVersion: "v1",
+		SuiteName:     "greet",
+		CaseResults: []store.CaseRecord{
+			{
+				CaseID:    "hello",
+				Passed:    false,
+				Score:     0,
+				Responses: []store.TrialResponseRecord{{TrialNum: 1, Response: "hello world"}},
+			},
+			{
+				CaseID:    "farewell",
+				Passed:    false,
+				Score:     0,
+				Responses: []store.TrialResponseRecord{{TrialNum: 1, Response: "farewell forever"}},
+			},
+		},
+	}
+}
+
+func TestOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	if _, err := (Options{}).Validate(); err == nil {
+		t.Fatalf("expected error for empty evaluator list")
+	}
+	if _, err := (Options{Evaluators: []string{"tool_selection"}}).Validate(); err == nil {
+		t.Fatalf("expected error for generation-only evaluator type")
+	}
+	wanted, err := (Options{Evaluators: []string{"exact", " contains ", ""}}).Validate()
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if _, ok := wanted["exact"]; !ok {
+		t.Fatalf("wanted: missing exact")
+	}
+	if _, ok := wanted["contains"]; !ok {
+		t.Fatalf("wanted: missing trimmed contains")
+	}
+}
+
+func TestRun_RescoresFromStoredResponses(t *testing.T) {
+	t.Parallel()
+
+	sources := []*store.SuiteRecord{testSource()}
+	outcomes, err := Run(context.Background(), newTestRunner(), testSuites(), sources, Options{Evaluators: []string{"exact", "contains"}}, nil, nil)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(outcomes) != 1 {
+		t.Fatalf("outcomes: got %d, want 1", len(outcomes))
+	}
+
+	rec := outcomes[0].Record
+	if rec.TotalCases != 2 || rec.PassedCases != 1 || rec.FailedCases != 1 {
+		t.Fatalf("rec: got total=%d passed=%d failed=%d", rec.TotalCases, rec.PassedCases, rec.FailedCases)
+	}
+	if rec.Metadata["rescored"] != "true" || rec.Metadata["rescored_from"] != "run_1_suite_1" {
+		t.Fatalf("rec.Metadata: got %#v", rec.Metadata)
+	}
+
+	var helloPassed, farewellPassed bool
+	for _, cr := range rec.CaseResults {
+		switch cr.CaseID {
+		case "hello":
+			helloPassed = cr.Passed
+		case "farewell":
+			farewellPassed = cr.Passed
+		}
+	}
+	if !helloPassed {
+		t.Fatalf("hello: expected exact match to pass")
+	}
+	if farewellPassed {
+		t.Fatalf("farewell: expected contains %q to fail on %q", "bye", "farewell forever")
+	}
+}
+
+func TestRun_SkipsCasesWithoutPersistedResponses(t *testing.T) {
+	t.Parallel()
+
+	src := testSource()
+	src.CaseResults[1].Responses = nil
+
+	outcomes, err := Run(context.Background(), newTestRunner(), testSuites(), []*store.SuiteRecord{src}, Options{Evaluators: []string{"exact", "contains"}}, nil, nil)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(outcomes) != 1 {
+		t.Fatalf("outcomes: got %d", len(outcomes))
+	}
+	if outcomes[0].CasesSkipped != 1 {
+		t.Fatalf("CasesSkipped: got %d, want 1", outcomes[0].CasesSkipped)
+	}
+	if outcomes[0].Record.TotalCases != 1 {
+		t.Fatalf("TotalCases: got %d, want 1", outcomes[0].Record.TotalCases)
+	}
+}
+
+func TestRun_UnknownSuiteErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := Run(context.Background(), newTestRunner(), map[string]*testcase.TestSuite{}, []*store.SuiteRecord{testSource()}, Options{Evaluators: []string{"exact"}}, nil, nil)
+	if err == nil {
+		t.Fatalf("expected error for suite not found among loaded test suites")
+	}
+}
+
+func TestRun_NilRunnerOrBadOptions(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Run(context.Background(), nil, nil, nil, Options{Evaluators: []string{"exact"}}, nil, nil); err == nil {
+		t.Fatalf("expected error for nil runner")
+	}
+	if _, err := Run(context.Background(), newTestRunner(), nil, nil, Options{}, nil, nil); err == nil {
+		t.Fatalf("expected error for empty evaluator list")
+	}
+}
+
+func TestRun_SkipsCheckpointedSuitesAndCallsOnSuiteDone(t *testing.T) {
+	t.Parallel()
+
+	cp := &Checkpoint{Processed: map[string]bool{"run_1_suite_1": true}}
+	var calls int
+	outcomes, err := Run(context.Background(), newTestRunner(), testSuites(), []*store.SuiteRecord{testSource()}, Options{Evaluators: []string{"exact", "contains"}}, cp, func(SuiteOutcome) error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(outcomes) != 0 || calls != 0 {
+		t.Fatalf("expected checkpointed suite to be skipped, got outcomes=%d calls=%d", len(outcomes), calls)
+	}
+}
+
+func TestCheckpoint_SaveAndLoadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint (missing file): %v", err)
+	}
+	if cp.Done("run_1_suite_1") {
+		t.Fatalf("expected fresh checkpoint to have nothing done")
+	}
+
+	cp.markDone("run_1_suite_1")
+	if err := cp.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	loaded, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint: %v", err)
+	}
+	if !loaded.Done("run_1_suite_1") {
+		t.Fatalf("expected loaded checkpoint to have run_1_suite_1 done")
+	}
+}
+
+func TestFilterForRescore_NilWhenNothingWanted(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID:         "x",
+		Evaluators: []testcase.EvaluatorConfig{{Type: "llm_judge"}},
+	}
+	if got := filterForRescore(tc, map[string]struct{}{"exact": {}}); got != nil {
+		t.Fatalf("expected nil, got %#v", got)
+	}
+}
@@ -0,0 +1,152 @@
+package runner
+
+import (
+	"context"
+	"sync"
+
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+// adaptiveLimiter is an AIMD (additive-increase/multiplicative-decrease)
+// concurrency limiter. It behaves like a resizable semaphore: acquire/release
+// bound in-flight work, but the limit itself grows by one after a run of
+// consecutive successes and is halved the moment a rate-limit or server error
+// is observed, always staying within [min, max].
+type adaptiveLimiter struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	min, max, limit int
+	inFlight        int
+	streak          int
+}
+
+// newAdaptiveLimiter creates a limiter starting at start in-flight requests,
+// clamped to [min, max].
+func newAdaptiveLimiter(min, max, start int) *adaptiveLimiter {
+	if min < 1 {
+		min = 1
+	}
+	if max < min {
+		max = min
+	}
+	if start < min {
+		start = min
+	}
+	if start > max {
+		start = max
+	}
+
+	l := &adaptiveLimiter{min: min, max: max, limit: start}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// acquire blocks until a slot under the current limit is available or ctx is
+// done. The limit can shrink while a caller is waiting, so acquire always
+// re-checks it against the live value rather than a value captured up front.
+func (l *adaptiveLimiter) acquire(ctx context.Context) error {
+	done := ctx.Done()
+
+	l.mu.Lock()
+	for l.inFlight >= l.limit {
+		if waitErr := l.waitOrDone(done); waitErr != nil {
+			l.mu.Unlock()
+			return waitErr
+		}
+	}
+	l.inFlight++
+	l.mu.Unlock()
+	return nil
+}
+
+// waitOrDone waits on the condition variable, but wakes early if done fires.
+// Callers must hold l.mu.
+func (l *adaptiveLimiter) waitOrDone(done <-chan struct{}) error {
+	select {
+	case <-done:
+		return context.Canceled
+	default:
+	}
+
+	woke := make(chan struct{})
+	go func() {
+		select {
+		case <-done:
+			l.mu.Lock()
+			l.cond.Broadcast()
+			l.mu.Unlock()
+		case <-woke:
+		}
+	}()
+	l.cond.Wait()
+	close(woke)
+
+	select {
+	case <-done:
+		return context.Canceled
+	default:
+		return nil
+	}
+}
+
+// release frees the in-flight slot acquired by acquire.
+func (l *adaptiveLimiter) release() {
+	l.mu.Lock()
+	if l.inFlight > 0 {
+		l.inFlight--
+	}
+	l.cond.Broadcast()
+	l.mu.Unlock()
+}
+
+// onSuccess records a successful call. After enough consecutive successes to
+// fill the current limit, it grows the limit by one (additive increase).
+func (l *adaptiveLimiter) onSuccess() {
+	l.mu.Lock()
+	l.streak++
+	if l.streak >= l.limit && l.limit < l.max {
+		l.limit++
+		l.streak = 0
+		l.cond.Broadcast()
+	}
+	l.mu.Unlock()
+}
+
+// onError records a rate-limit or server error by halving the limit
+// (multiplicative decrease), never going below min.
+func (l *adaptiveLimiter) onError() {
+	l.mu.Lock()
+	l.streak = 0
+	newLimit := l.limit / 2
+	if newLimit < l.min {
+		newLimit = l.min
+	}
+	l.limit = newLimit
+	l.mu.Unlock()
+}
+
+// current returns the limiter's live limit, mainly for tests and diagnostics.
+func (l *adaptiveLimiter) current() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+// recordProviderOutcome feeds a provider call's outcome to the adaptive
+// limiter, if adaptive concurrency is enabled. Errors unrelated to provider
+// load (validation failures, canceled context, ...) are ignored rather than
+// treated as either a success or a load signal.
+func (r *Runner) recordProviderOutcome(err error) {
+	if r.adaptive == nil {
+		return
+	}
+	switch llm.ClassifyError(err) {
+	case llm.ErrorClassRateLimited, llm.ErrorClassServerError:
+		r.adaptive.onError()
+	case llm.ErrorClassNone:
+		if err == nil {
+			r.adaptive.onSuccess()
+		}
+	}
+}
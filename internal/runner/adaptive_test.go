@@ -0,0 +1,150 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stellarlinkco/ai-eval/internal/claude"
+	"github.com/stellarlinkco/ai-eval/internal/llm"
+)
+
+func TestAdaptiveLimiter_ClampsStartToBounds(t *testing.T) {
+	t.Parallel()
+
+	if got := newAdaptiveLimiter(0, 0, 0).current(); got != 1 {
+		t.Fatalf("current: got %d want 1", got)
+	}
+	if got := newAdaptiveLimiter(4, 2, 3).current(); got != 4 {
+		t.Fatalf("current with max<min: got %d want 4 (min wins)", got)
+	}
+	if got := newAdaptiveLimiter(2, 8, 20).current(); got != 8 {
+		t.Fatalf("current with start>max: got %d want 8", got)
+	}
+}
+
+func TestAdaptiveLimiter_GrowsAfterSuccessStreak(t *testing.T) {
+	t.Parallel()
+
+	l := newAdaptiveLimiter(1, 4, 2)
+	l.onSuccess()
+	if got := l.current(); got != 2 {
+		t.Fatalf("after 1 success: got %d want 2 (streak not full yet)", got)
+	}
+	l.onSuccess()
+	if got := l.current(); got != 3 {
+		t.Fatalf("after streak fills limit: got %d want 3", got)
+	}
+}
+
+func TestAdaptiveLimiter_GrowthStopsAtMax(t *testing.T) {
+	t.Parallel()
+
+	l := newAdaptiveLimiter(1, 2, 2)
+	for i := 0; i < 10; i++ {
+		l.onSuccess()
+	}
+	if got := l.current(); got != 2 {
+		t.Fatalf("current: got %d want 2 (bounded by max)", got)
+	}
+}
+
+func TestAdaptiveLimiter_HalvesOnError(t *testing.T) {
+	t.Parallel()
+
+	l := newAdaptiveLimiter(1, 16, 8)
+	l.onError()
+	if got := l.current(); got != 4 {
+		t.Fatalf("after onError: got %d want 4", got)
+	}
+	l.onError()
+	l.onError()
+	l.onError()
+	if got := l.current(); got != 1 {
+		t.Fatalf("after repeated onError: got %d want 1 (floored at min)", got)
+	}
+}
+
+func TestAdaptiveLimiter_AcquireReleaseRespectsLimit(t *testing.T) {
+	t.Parallel()
+
+	l := newAdaptiveLimiter(1, 1, 1)
+	ctx := context.Background()
+	if err := l.acquire(ctx); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	blocked, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(blocked); err == nil {
+		t.Fatalf("acquire: expected block while at limit")
+	}
+
+	l.release()
+	if err := l.acquire(ctx); err != nil {
+		t.Fatalf("acquire after release: %v", err)
+	}
+}
+
+func TestAdaptiveLimiter_AcquireUnblocksOnGrowth(t *testing.T) {
+	t.Parallel()
+
+	l := newAdaptiveLimiter(1, 2, 1)
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- l.acquire(context.Background())
+	}()
+
+	// Give the goroutine a chance to block on the limiter before growing it.
+	time.Sleep(20 * time.Millisecond)
+	l.onSuccess()
+	l.onSuccess()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("acquire after growth: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("acquire did not unblock after limit grew")
+	}
+}
+
+func TestRunner_RecordProviderOutcome_AdaptiveDisabled(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{}
+	r.recordProviderOutcome(nil)
+	r.recordProviderOutcome(&claude.APIError{StatusCode: 429})
+}
+
+func TestRunner_RecordProviderOutcome_TracksLoadSignals(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{adaptive: newAdaptiveLimiter(1, 4, 2)}
+
+	r.recordProviderOutcome(&claude.APIError{StatusCode: 429})
+	if got := r.adaptive.current(); got != 1 {
+		t.Fatalf("after rate limit error: got %d want 1", got)
+	}
+
+	r.adaptive = newAdaptiveLimiter(1, 4, 2)
+	r.recordProviderOutcome(nil)
+	r.recordProviderOutcome(nil)
+	if got := r.adaptive.current(); got != 3 {
+		t.Fatalf("after success streak: got %d want 3", got)
+	}
+
+	r.adaptive = newAdaptiveLimiter(1, 4, 2)
+	r.recordProviderOutcome(&claude.APIError{StatusCode: 400})
+	if got := r.adaptive.current(); got != 2 {
+		t.Fatalf("after unrelated error: got %d want unchanged (2)", got)
+	}
+	if llm.ClassifyError(&claude.APIError{StatusCode: 400}) != llm.ErrorClassNone {
+		t.Fatalf("sanity: expected 400 to classify as none")
+	}
+}
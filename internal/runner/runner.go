@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"log"
 	"math"
+	"math/rand"
 	"reflect"
 	"strings"
 	"sync"
@@ -25,7 +28,12 @@ type Runner struct {
 	registry *evaluator.Registry
 	cfg      Config
 
-	sem chan struct{}
+	sem     chan struct{}
+	evalSem chan struct{}
+
+	// adaptive replaces sem-based limiting when cfg.AdaptiveConcurrency is
+	// set; nil otherwise.
+	adaptive *adaptiveLimiter
 }
 
 // NewRunner creates a Runner with defaults and registers LLM evaluators.
@@ -36,6 +44,9 @@ func NewRunner(provider llm.Provider, registry *evaluator.Registry, cfg Config)
 	if cfg.Concurrency <= 0 {
 		cfg.Concurrency = 1
 	}
+	if cfg.EvaluatorConcurrency <= 0 {
+		cfg.EvaluatorConcurrency = cfg.Concurrency
+	}
 	if cfg.PassThreshold < 0 {
 		cfg.PassThreshold = 0
 	}
@@ -48,8 +59,22 @@ func NewRunner(provider llm.Provider, registry *evaluator.Registry, cfg Config)
 		registry: registry,
 		cfg:      cfg,
 		sem:      make(chan struct{}, cfg.Concurrency),
+		evalSem:  make(chan struct{}, cfg.EvaluatorConcurrency),
+	}
+
+	if cfg.AdaptiveConcurrency {
+		min := cfg.MinConcurrency
+		if min <= 0 {
+			min = 1
+		}
+		max := cfg.MaxConcurrency
+		if max <= 0 {
+			max = cfg.Concurrency * 4
+		}
+		r.adaptive = newAdaptiveLimiter(min, max, cfg.Concurrency)
 	}
 
+	evaluator.SeedGlobal(r.registry)
 	r.registerLLMEvaluators()
 	return r
 }
@@ -75,6 +100,14 @@ func (r *Runner) RunCase(ctx context.Context, p *prompt.Prompt, tc *testcase.Tes
 		return nil, errors.New("runner: nil test case")
 	}
 
+	if tc.PromptOverride != nil {
+		patched, err := prompt.WithOverride(p, tc.PromptOverride)
+		if err != nil {
+			return nil, fmt.Errorf("runner: case %q: prompt override: %w", tc.ID, err)
+		}
+		p = patched
+	}
+
 	if err := r.acquire(ctx); err != nil {
 		return nil, err
 	}
@@ -94,6 +127,7 @@ func (r *Runner) RunCase(ctx context.Context, p *prompt.Prompt, tc *testcase.Tes
 	}
 
 	tools := promptTools(p.Tools)
+	wantLogprobs := caseHasEvaluatorType(tc, "confidence")
 	useMultiTurn := len(tc.ToolMocks) > 0
 	maxSteps := tc.MaxSteps
 	if maxSteps <= 0 {
@@ -103,10 +137,18 @@ func (r *Runner) RunCase(ctx context.Context, p *prompt.Prompt, tc *testcase.Tes
 	if useMultiTurn {
 		toolExecutor = toolExecutorFromMocks(tc.ToolMocks)
 	}
+	caseInput := mergeContext(r.cfg.Context, tc.Input)
 
 	var totalScore float64
 	passedTrials := 0
 
+	var trialSeeds *rand.Rand
+	if r.cfg.Seed != 0 {
+		trialSeeds = rand.New(rand.NewSource(caseSeed(r.cfg.Seed, tc.ID)))
+	}
+
+	var renderedCaptured bool
+
 trialLoop:
 	for i := 0; i < trials; i++ {
 		select {
@@ -129,7 +171,7 @@ trialLoop:
 				defer cancel()
 			}
 
-			rendered, err := prompt.Render(p, tc.Input)
+			rendered, err := prompt.Render(p, caseInput)
 			if err != nil {
 				if out.Error == nil {
 					out.Error = err
@@ -142,6 +184,21 @@ trialLoop:
 				return
 			}
 
+			if r.cfg.PromptWrapper != "" && !p.SkipPromptWrapper {
+				rendered, err = prompt.Wrap(rendered, r.cfg.PromptWrapper)
+				if err != nil {
+					if out.Error == nil {
+						out.Error = err
+					}
+					tr.Evaluations = append(tr.Evaluations, evaluator.Result{
+						Passed:  false,
+						Score:   0,
+						Message: err.Error(),
+					})
+					return
+				}
+			}
+
 			// Determine user message content
 			var userContent string
 			var systemContent string
@@ -161,17 +218,35 @@ trialLoop:
 				userContent = rendered
 			}
 
+			if !renderedCaptured {
+				out.RenderedSystem = systemContent
+				out.RenderedUser = userContent
+				renderedCaptured = true
+			}
+
 			req := &llm.Request{
 				Messages:  []llm.Message{{Role: "user", Content: userContent}},
 				MaxTokens: 4096,
 				System:    systemContent,
+				Logprobs:  wantLogprobs,
 			}
 			if len(tools) > 0 {
 				req.Tools = tools
 			}
+			if len(tc.StopSequences) > 0 {
+				req.StopSequences = tc.StopSequences
+			} else if len(p.StopSequences) > 0 {
+				req.StopSequences = p.StopSequences
+			}
+			if trialSeeds != nil {
+				req.Seed = trialSeeds.Int63()
+			}
 
 			trialSteps := 0
 			trialTokensUsed := 0
+			var trialToolTurns [][]llm.ToolUse
+			var trialLogprobs []llm.TokenLogprob
+			var trialToolResults []llm.ToolCallResult
 
 			if useMultiTurn {
 				looper, ok := r.provider.(llm.ToolLoopProvider)
@@ -188,7 +263,18 @@ trialLoop:
 					return
 				}
 
-				res, err := looper.CompleteMultiTurn(trialCtx, req, toolExecutor, maxSteps)
+				recordingExecutor := func(call llm.ToolUse) (string, error) {
+					output, err := toolExecutor(call)
+					result := llm.ToolCallResult{Call: call, Output: output}
+					if err != nil {
+						result.Error = err.Error()
+					}
+					trialToolResults = append(trialToolResults, result)
+					return output, err
+				}
+
+				res, err := looper.CompleteMultiTurn(trialCtx, req, recordingExecutor, maxSteps)
+				r.recordProviderOutcome(err)
 				if res != nil {
 					if res.FinalResponse != nil {
 						tr.Response = responseText(res.FinalResponse)
@@ -196,10 +282,15 @@ trialLoop:
 					tr.ToolCalls = res.AllToolCalls
 					tr.LatencyMs = res.TotalLatencyMs
 					trialSteps = res.Steps
+					trialToolTurns = toolTurnsFromResponses(res.AllResponses)
 					trialTokensUsed = res.TotalInputTokens + res.TotalOutputTokens
+					tr.PromptTokens = res.TotalInputTokens
+					tr.CompletionTokens = res.TotalOutputTokens
 
 					out.LatencyMs += res.TotalLatencyMs
 					out.TokensUsed += trialTokensUsed
+					out.PromptTokens += tr.PromptTokens
+					out.CompletionTokens += tr.CompletionTokens
 				}
 				if err != nil {
 					if out.Error == nil {
@@ -214,15 +305,22 @@ trialLoop:
 				}
 			} else {
 				res, err := r.provider.CompleteWithTools(trialCtx, req)
+				r.recordProviderOutcome(err)
 				if res != nil {
 					tr.Response = res.TextContent
 					tr.ToolCalls = res.ToolCalls
 					tr.LatencyMs = res.LatencyMs
 					trialSteps = 1
+					trialToolTurns = [][]llm.ToolUse{res.ToolCalls}
 					trialTokensUsed = res.InputTokens + res.OutputTokens
+					tr.PromptTokens = res.InputTokens
+					tr.CompletionTokens = res.OutputTokens
+					trialLogprobs = res.Logprobs
 
 					out.LatencyMs += res.LatencyMs
 					out.TokensUsed += trialTokensUsed
+					out.PromptTokens += tr.PromptTokens
+					out.CompletionTokens += tr.CompletionTokens
 				}
 				if err != nil {
 					if out.Error == nil {
@@ -237,7 +335,22 @@ trialLoop:
 				}
 			}
 
-			tr.Evaluations, tr.Passed, tr.Score = r.evaluateTrial(trialCtx, tc, rendered, tr.Response, tr.ToolCalls, trialSteps, trialTokensUsed)
+			hardMaxSteps := tc.MaxStepsHardFail
+			if hardMaxSteps <= 0 {
+				hardMaxSteps = r.cfg.MaxStepsHardFail
+			}
+			if hardMaxSteps > 0 && trialSteps > hardMaxSteps {
+				tr.Evaluations = []evaluator.Result{{
+					Passed:  false,
+					Score:   0,
+					Message: fmt.Sprintf("exceeded max tool steps (%d > %d)", trialSteps, hardMaxSteps),
+				}}
+				tr.Passed = false
+				tr.Score = 0
+				return
+			}
+
+			tr.Evaluations, tr.Passed, tr.Score = r.evaluateTrial(trialCtx, tc, rendered, tr.Response, tr.ToolCalls, trialToolTurns, trialSteps, trialTokensUsed, trialLogprobs, trialToolResults)
 		}()
 
 		out.Trials = append(out.Trials, tr)
@@ -248,6 +361,27 @@ trialLoop:
 		}
 	}
 
+	if cfg, ok := findEvaluatorConfig(tc, "consistency"); ok && len(out.Trials) > 0 {
+		result := r.evaluateConsistency(ctx, cfg, out.Trials)
+		for i := range out.Trials {
+			tr := &out.Trials[i]
+			tr.Evaluations = append(tr.Evaluations, result)
+
+			oldScore, oldPassed := tr.Score, tr.Passed
+			tr.Score = (oldScore*float64(len(tr.Evaluations)-1) + result.Score) / float64(len(tr.Evaluations))
+			tr.Passed = oldPassed && (result.Passed || result.Optional)
+
+			totalScore += tr.Score - oldScore
+			if tr.Passed != oldPassed {
+				if tr.Passed {
+					passedTrials++
+				} else {
+					passedTrials--
+				}
+			}
+		}
+	}
+
 	if len(out.Trials) > 0 {
 		out.Score = totalScore / float64(len(out.Trials))
 	}
@@ -256,6 +390,8 @@ trialLoop:
 	if len(out.Trials) > 0 {
 		passRate = float64(passedTrials) / float64(len(out.Trials))
 	}
+	out.TrialPassRate = passRate
+	out.TrialAgreement = trialAgreement(passedTrials, len(out.Trials))
 	k := float64(len(out.Trials))
 	if k > 0 {
 		out.PassAtK = 1 - math.Pow(1-passRate, k)
@@ -266,6 +402,23 @@ trialLoop:
 	return out, nil
 }
 
+// notRunResult builds the stub RunResult for a case that never ran because
+// runCtx was already done by the time its turn came up. parentCtx being done
+// means the ambient run itself ended (SIGINT, --timeout, suite.Timeout),
+// which is reported as Error; otherwise runCtx being done can only mean
+// Config.FailFast canceled the rest of the suite over an earlier failure,
+// reported as Skipped. When FailFast is off, runCtx is parentCtx, so this
+// always takes the Error branch, preserving the pre-FailFast behavior.
+func notRunResult(suite *testcase.TestSuite, tc testcase.TestCase, parentCtx, runCtx context.Context) RunResult {
+	if err := parentCtx.Err(); err != nil {
+		return RunResult{Suite: suite.Suite, CaseID: tc.ID, Error: err}
+	}
+	if runCtx.Err() != nil {
+		return RunResult{Suite: suite.Suite, CaseID: tc.ID, Skipped: true}
+	}
+	return RunResult{Suite: suite.Suite, CaseID: tc.ID}
+}
+
 // RunSuite executes all cases in a suite and aggregates results.
 func (r *Runner) RunSuite(ctx context.Context, p *prompt.Prompt, suite *testcase.TestSuite) (*SuiteResult, error) {
 	if r == nil {
@@ -281,6 +434,23 @@ func (r *Runner) RunSuite(ctx context.Context, p *prompt.Prompt, suite *testcase
 		return nil, errors.New("runner: nil suite")
 	}
 
+	if suite.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, suite.Timeout)
+		defer cancel()
+	}
+
+	// runCtx additionally carries FailFast's own cancellation, kept separate
+	// from ctx so stub results for un-run cases can tell "the ambient ctx
+	// (SIGINT, --timeout, suite.Timeout) ended the run" (Error) apart from
+	// "an earlier case failed and FailFast canceled the rest" (Skipped).
+	runCtx := ctx
+	var cancelFailFast context.CancelFunc
+	if r.cfg.FailFast {
+		runCtx, cancelFailFast = context.WithCancel(ctx)
+		defer cancelFailFast()
+	}
+
 	out := &SuiteResult{
 		Suite:      suite.Suite,
 		TotalCases: len(suite.Cases),
@@ -291,14 +461,11 @@ func (r *Runner) RunSuite(ctx context.Context, p *prompt.Prompt, suite *testcase
 caseLoop:
 	for i := range suite.Cases {
 		select {
-		case <-ctx.Done():
-			err := ctx.Err()
+		case <-runCtx.Done():
 			for j := i; j < len(suite.Cases); j++ {
-				tc := suite.Cases[j]
-				out.Results[j] = RunResult{
-					Suite:  suite.Suite,
-					CaseID: tc.ID,
-					Error:  err,
+				out.Results[j] = notRunResult(suite, suite.Cases[j], ctx, runCtx)
+				if r.cfg.OnCaseComplete != nil {
+					r.cfg.OnCaseComplete(out.Results[j])
 				}
 			}
 			break caseLoop
@@ -313,62 +480,156 @@ caseLoop:
 			defer wg.Done()
 
 			select {
-			case <-ctx.Done():
-				out.Results[idx] = RunResult{
-					Suite:  suite.Suite,
-					CaseID: tc.ID,
-					Error:  ctx.Err(),
+			case <-runCtx.Done():
+				out.Results[idx] = notRunResult(suite, tc, ctx, runCtx)
+				if r.cfg.OnCaseComplete != nil {
+					r.cfg.OnCaseComplete(out.Results[idx])
 				}
 				return
 			default:
 			}
 
-			if ctx.Err() != nil {
-				out.Results[idx] = RunResult{
-					Suite:  suite.Suite,
-					CaseID: tc.ID,
-					Error:  ctx.Err(),
+			if runCtx.Err() != nil {
+				out.Results[idx] = notRunResult(suite, tc, ctx, runCtx)
+				if r.cfg.OnCaseComplete != nil {
+					r.cfg.OnCaseComplete(out.Results[idx])
 				}
 				return
 			}
 
-			res, err := r.RunCase(ctx, p, &tc)
+			res, err := r.RunCase(runCtx, p, &tc)
 			if err != nil {
 				out.Results[idx] = RunResult{
 					Suite:  suite.Suite,
 					CaseID: tc.ID,
 					Error:  err,
 				}
+				if r.cfg.OnCaseComplete != nil {
+					r.cfg.OnCaseComplete(out.Results[idx])
+				}
 				return
 			}
 			res.Suite = suite.Suite
 			out.Results[idx] = *res
+			if r.cfg.FailFast && !res.Passed && cancelFailFast != nil {
+				cancelFailFast()
+			}
+			if r.cfg.OnCaseComplete != nil {
+				r.cfg.OnCaseComplete(out.Results[idx])
+			}
 		}()
 	}
 	wg.Wait()
 
+	if suite.Timeout > 0 && ctx.Err() != nil {
+		out.TimedOut = true
+	}
+
 	var scoreSum float64
+	var agreementSum float64
+	var agreementCases int
 	for i := range out.Results {
 		rr := out.Results[i]
-		if rr.Passed {
+		switch {
+		case rr.Skipped:
+			out.SkippedCases++
+		case rr.Passed:
 			out.PassedCases++
-		} else {
+		default:
 			out.FailedCases++
 		}
 		out.TotalLatency += rr.LatencyMs
 		out.TotalTokens += rr.TokensUsed
 		scoreSum += rr.Score
+		if len(rr.Trials) > 1 {
+			agreementSum += rr.TrialAgreement
+			agreementCases++
+		}
 	}
 
 	if out.TotalCases > 0 {
 		out.PassRate = float64(out.PassedCases) / float64(out.TotalCases)
 		out.AvgScore = scoreSum / float64(out.TotalCases)
 	}
+	if agreementCases > 0 {
+		out.AvgAgreement = agreementSum / float64(agreementCases)
+	}
+
+	out.SchemaConformance = schemaConformance(ctx, suite, out.Results)
 
 	return out, nil
 }
 
+// maxSchemaViolations caps how many SchemaViolation entries schemaConformance
+// collects, so a suite with mostly-nonconforming responses doesn't balloon
+// SuiteResult.
+const maxSchemaViolations = 5
+
+// schemaConformance validates every trial response in results against
+// suite.OutputSchema, reusing evaluator.JSONSchemaEvaluator so the rules
+// match the per-case json_schema evaluator exactly. Returns nil if suite has
+// no OutputSchema set.
+func schemaConformance(ctx context.Context, suite *testcase.TestSuite, results []RunResult) *SchemaConformance {
+	if suite == nil || suite.OutputSchema == nil {
+		return nil
+	}
+
+	out := &SchemaConformance{}
+	ev := evaluator.JSONSchemaEvaluator{}
+	for _, rr := range results {
+		for _, tr := range rr.Trials {
+			out.TotalResponses++
+
+			res, err := ev.Evaluate(ctx, tr.Response, suite.OutputSchema)
+			conforms := err == nil && res != nil && res.Passed
+			if conforms {
+				out.ConformingResponses++
+				continue
+			}
+
+			if len(out.WorstOffenders) < maxSchemaViolations {
+				msg := "invalid schema"
+				switch {
+				case err != nil:
+					msg = err.Error()
+				case res != nil:
+					msg = res.Message
+				}
+				out.WorstOffenders = append(out.WorstOffenders, SchemaViolation{
+					CaseID:   rr.CaseID,
+					TrialNum: tr.TrialNum,
+					Message:  msg,
+				})
+			}
+		}
+	}
+
+	if out.TotalResponses > 0 {
+		out.ConformanceRate = float64(out.ConformingResponses) / float64(out.TotalResponses)
+	}
+	return out
+}
+
+// EvaluateResponse scores response against tc's evaluators and expected
+// assertions without generating anything, i.e. no prompt is rendered and no
+// provider is called for the response itself (LLM-backed evaluators such as
+// llm_judge still call out to score). It reuses the same evaluateTrial path
+// as RunCase, so a "dry" evaluation behaves identically to scoring a real
+// trial's response.
+func (r *Runner) EvaluateResponse(ctx context.Context, tc *testcase.TestCase, response string) ([]evaluator.Result, bool, float64) {
+	if r == nil {
+		return []evaluator.Result{{Passed: false, Score: 0, Message: "runner: nil runner"}}, false, 0
+	}
+	if tc == nil {
+		return []evaluator.Result{{Passed: false, Score: 0, Message: "runner: nil test case"}}, false, 0
+	}
+	return r.evaluateTrial(ctx, tc, "", response, nil, nil, 0, 0, nil, nil)
+}
+
 func (r *Runner) acquire(ctx context.Context) error {
+	if r.adaptive != nil {
+		return r.adaptive.acquire(ctx)
+	}
 	if r.sem == nil {
 		return errors.New("runner: nil semaphore")
 	}
@@ -381,9 +642,123 @@ func (r *Runner) acquire(ctx context.Context) error {
 }
 
 func (r *Runner) release() {
+	if r.adaptive != nil {
+		r.adaptive.release()
+		return
+	}
 	<-r.sem
 }
 
+// acquireEval bounds concurrent evaluator calls independently of acquire,
+// so a case with several LLM evaluators (judge + factuality + safety, say)
+// doesn't multiply provider load past EvaluatorConcurrency across many
+// concurrently running cases. A nil evalSem (a Runner built as a struct
+// literal rather than via NewRunner, as many tests do) leaves evaluator
+// concurrency unbounded rather than failing every evaluation.
+func (r *Runner) acquireEval(ctx context.Context) error {
+	if r.evalSem == nil {
+		return nil
+	}
+	select {
+	case r.evalSem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Runner) releaseEval() {
+	if r.evalSem == nil {
+		return
+	}
+	<-r.evalSem
+}
+
+// caseSeed derives a per-case PRNG seed from a run-level seed and the case
+// ID, so each test case draws its own independent, reproducible sequence of
+// trial seeds regardless of the order or concurrency with which cases run.
+func caseSeed(runSeed int64, caseID string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(caseID))
+	return runSeed ^ int64(h.Sum64())
+}
+
+// mergeContext merges runContext into input, with keys already present in
+// input winning over ones from runContext, mirroring how
+// testcase.resolveInputFiles layers InputFile beneath a case's own Input.
+// Returns input unchanged if runContext is empty, and a nil map if both are.
+func mergeContext(runContext, input map[string]any) map[string]any {
+	if len(runContext) == 0 {
+		return input
+	}
+
+	merged := make(map[string]any, len(runContext)+len(input))
+	for k, v := range runContext {
+		merged[k] = v
+	}
+	for k, v := range input {
+		merged[k] = v
+	}
+	return merged
+}
+
+// caseHasEvaluatorType reports whether tc configures an evaluator of typ,
+// used to opt into extra per-request work (e.g. llm.Request.Logprobs) only
+// when a case actually needs it.
+func caseHasEvaluatorType(tc *testcase.TestCase, typ string) bool {
+	for _, cfg := range tc.Evaluators {
+		if strings.TrimSpace(cfg.Type) == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// findEvaluatorConfig returns tc's evaluator config of typ, if any.
+func findEvaluatorConfig(tc *testcase.TestCase, typ string) (testcase.EvaluatorConfig, bool) {
+	for _, cfg := range tc.Evaluators {
+		if strings.TrimSpace(cfg.Type) == typ {
+			return cfg, true
+		}
+	}
+	return testcase.EvaluatorConfig{}, false
+}
+
+// evaluateConsistency runs the "consistency" evaluator once against every
+// trial's response, rather than per trial like evaluateTrial's tasks. On any
+// error (missing/misregistered evaluator) it reports a failing Result
+// instead, matching evaluateTrial's own error handling.
+func (r *Runner) evaluateConsistency(ctx context.Context, cfg testcase.EvaluatorConfig, trials []TrialResult) evaluator.Result {
+	e, ok := r.registry.Get("consistency")
+	if !ok {
+		return evaluator.Result{Passed: false, Score: 0, Message: `runner: missing evaluator "consistency"`}
+	}
+
+	responses := make([]string, len(trials))
+	for i, tr := range trials {
+		responses[i] = tr.Response
+	}
+
+	res, err := e.Evaluate(ctx, "", map[string]any{
+		"responses": responses,
+		"threshold": cfg.ScoreThreshold,
+	})
+	if err != nil {
+		return evaluator.Result{Passed: false, Score: 0, Message: err.Error()}
+	}
+	if res == nil {
+		return evaluator.Result{Passed: false, Score: 0, Message: "runner: nil evaluator result"}
+	}
+
+	result := *res
+	result.Score = r.normalizeScore("consistency", result.Score)
+	if cfg.ScoreThreshold > 0 {
+		result.Passed = result.Score >= cfg.ScoreThreshold
+	}
+	result.Optional = cfg.Optional
+	return result
+}
+
 func promptTools(in []prompt.Tool) []llm.ToolDefinition {
 	out := make([]llm.ToolDefinition, 0, len(in))
 	for _, t := range in {
@@ -419,15 +794,87 @@ func responseText(resp *llm.Response) string {
 	return sb.String()
 }
 
+// toolCallsFromResponse extracts the tool_use blocks from a single response,
+// in the order the model emitted them.
+func toolCallsFromResponse(resp *llm.Response) []llm.ToolUse {
+	if resp == nil {
+		return nil
+	}
+
+	var calls []llm.ToolUse
+	for _, b := range resp.Content {
+		if b.Type != "tool_use" {
+			continue
+		}
+		calls = append(calls, llm.ToolUse{ID: b.ID, Name: b.Name, Input: b.Input})
+	}
+	return calls
+}
+
+// toolTurnsFromResponses groups tool calls by conversational turn, in
+// chronological order, so evaluators like GuardrailToolEvaluator can tell
+// "called during an earlier turn" apart from "called in the same turn as
+// the final answer" — something the flattened MultiTurnResult.AllToolCalls
+// slice can't express.
+func toolTurnsFromResponses(responses []*llm.Response) [][]llm.ToolUse {
+	if len(responses) == 0 {
+		return nil
+	}
+	turns := make([][]llm.ToolUse, 0, len(responses))
+	for _, resp := range responses {
+		turns = append(turns, toolCallsFromResponse(resp))
+	}
+	return turns
+}
+
+// trialAgreement returns the fraction of trials that share the majority
+// pass/fail outcome, e.g. 4 passes and 1 fail out of 5 trials agree 0.8 of
+// the time. A stochastic prompt or evaluator shows up here as a case whose
+// TrialPassRate sits away from 0/1 but whose agreement is still low relative
+// to trial count. With no trials there is nothing to disagree on, so it
+// reports 1.0.
+func trialAgreement(passedTrials, totalTrials int) float64 {
+	if totalTrials <= 0 {
+		return 1
+	}
+	majority := passedTrials
+	if failed := totalTrials - passedTrials; failed > majority {
+		majority = failed
+	}
+	return float64(majority) / float64(totalTrials)
+}
+
+// toolExecutorFromMocks returns a stateful executor: it tracks, per mock, how
+// many of its Responses have been consumed so far, and, per tool name, how
+// many calls have been made in total (for CallCount matching). Both counters
+// live for the lifetime of the returned func, which is shared across every
+// trial of the case it was built for.
 func toolExecutorFromMocks(mocks []testcase.ToolMock) func(llm.ToolUse) (string, error) {
+	seqIndex := make([]int, len(mocks))
+	callCount := make(map[string]int, len(mocks))
+
 	return func(toolUse llm.ToolUse) (string, error) {
-		for _, m := range mocks {
+		callCount[toolUse.Name]++
+		n := callCount[toolUse.Name]
+
+		for i, m := range mocks {
 			if strings.TrimSpace(m.Name) != toolUse.Name {
 				continue
 			}
 			if len(m.Match) > 0 && !matchArgs(m.Match, toolUse.Input) {
 				continue
 			}
+			if m.CallCount > 0 && m.CallCount != n {
+				continue
+			}
+			if len(m.Responses) > 0 {
+				idx := seqIndex[i]
+				if idx >= len(m.Responses) {
+					return "", fmt.Errorf("runner: tool mock for %q: response sequence exhausted after %d calls", toolUse.Name, len(m.Responses))
+				}
+				seqIndex[i]++
+				return m.Responses[idx], nil
+			}
 			if strings.TrimSpace(m.Error) != "" {
 				return "", errors.New(m.Error)
 			}
@@ -573,6 +1020,30 @@ func (r *Runner) registerLLMEvaluators() {
 			v.Client = r.provider
 		}
 	}
+	if _, ok := r.registry.Get("citation"); !ok {
+		r.registry.Register(&rag.CitationEvaluator{})
+	}
+	if _, ok := r.registry.Get("openapi"); !ok {
+		r.registry.Register(&evaluator.OpenAPIEvaluator{})
+	}
+	if _, ok := r.registry.Get("json_valid"); !ok {
+		r.registry.Register(&evaluator.JSONValidEvaluator{})
+	}
+	if _, ok := r.registry.Get("diversity"); !ok {
+		r.registry.Register(&evaluator.DiversityEvaluator{})
+	}
+	if _, ok := r.registry.Get("contains_at_least"); !ok {
+		r.registry.Register(&evaluator.ContainsAtLeastEvaluator{})
+	}
+	if _, ok := r.registry.Get("enum"); !ok {
+		r.registry.Register(&evaluator.EnumEvaluator{})
+	}
+	if _, ok := r.registry.Get("reasoning_answer"); !ok {
+		r.registry.Register(&evaluator.ReasoningAnswerEvaluator{})
+	}
+	if _, ok := r.registry.Get("tool_result_usage"); !ok {
+		r.registry.Register(evaluator.ToolResultUsageEvaluator{})
+	}
 
 	if existing, ok := r.registry.Get("task_completion"); !ok {
 		r.registry.Register(&agent.TaskCompletionEvaluator{Client: r.provider})
@@ -591,6 +1062,16 @@ func (r *Runner) registerLLMEvaluators() {
 	if _, ok := r.registry.Get("efficiency"); !ok {
 		r.registry.Register(&agent.EfficiencyEvaluator{})
 	}
+	if _, ok := r.registry.Get("tool_before_answer"); !ok {
+		r.registry.Register(&agent.GuardrailToolEvaluator{})
+	}
+	if existing, ok := r.registry.Get("agent_faithfulness"); !ok {
+		r.registry.Register(&agent.AgentFaithfulnessEvaluator{Client: r.provider})
+	} else {
+		if v, ok := existing.(*agent.AgentFaithfulnessEvaluator); ok && v.Client == nil {
+			v.Client = r.provider
+		}
+	}
 
 	if existing, ok := r.registry.Get("hallucination"); !ok {
 		r.registry.Register(&safety.HallucinationEvaluator{Client: r.provider})
@@ -619,9 +1100,10 @@ type evalTask struct {
 	typ            string
 	expected       any
 	scoreThreshold float64
+	optional       bool
 }
 
-func (r *Runner) evaluateTrial(ctx context.Context, tc *testcase.TestCase, promptContext string, response string, toolCalls []llm.ToolUse, actualSteps int, actualTokens int) ([]evaluator.Result, bool, float64) {
+func (r *Runner) evaluateTrial(ctx context.Context, tc *testcase.TestCase, promptContext string, response string, toolCalls []llm.ToolUse, toolTurns [][]llm.ToolUse, actualSteps int, actualTokens int, logprobs []llm.TokenLogprob, toolResults []llm.ToolCallResult) ([]evaluator.Result, bool, float64) {
 	if tc == nil {
 		return []evaluator.Result{{
 			Passed:  false,
@@ -634,21 +1116,21 @@ func (r *Runner) evaluateTrial(ctx context.Context, tc *testcase.TestCase, promp
 	fullResponse := buildFullResponse(response, toolCalls)
 
 	tasks, toolCallThreshold := buildEvalTasks(tc, promptContext)
-	var results []evaluator.Result
 
-	allPassed := true
-	scoreSum := 0.0
+	// Evaluators run concurrently, bounded by evalSem rather than the
+	// generation semaphore, so a case with several LLM evaluators doesn't
+	// multiply provider load past EvaluatorConcurrency across many
+	// concurrently running cases. Results are written by index so ordering
+	// matches tasks regardless of completion order.
+	taskResults := make([]evaluator.Result, len(tasks))
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		idx, task := i, task
 
-	for _, task := range tasks {
 		e, ok := r.registry.Get(task.typ)
 		if !ok {
 			err := fmt.Errorf("runner: missing evaluator %q", task.typ)
-			results = append(results, evaluator.Result{
-				Passed:  false,
-				Score:   0,
-				Message: err.Error(),
-			})
-			allPassed = false
+			taskResults[idx] = evaluator.Result{Passed: false, Score: 0, Message: err.Error()}
 			continue
 		}
 
@@ -669,46 +1151,68 @@ func (r *Runner) evaluateTrial(ctx context.Context, tc *testcase.TestCase, promp
 			case "efficiency":
 				m["actual_steps"] = actualSteps
 				m["actual_tokens"] = actualTokens
+			case "tool_before_answer":
+				m["tool_turns"] = toolTurns
+				m["has_answer"] = strings.TrimSpace(response) != ""
+			case "confidence":
+				m["logprobs"] = logprobs
+			case "agent_faithfulness":
+				m["tool_results"] = toolResults
 			}
 			expected = m
 		}
 
-		res, err := e.Evaluate(ctx, evalResponse, expected)
-		if err != nil {
-			results = append(results, evaluator.Result{
-				Passed:  false,
-				Score:   0,
-				Message: err.Error(),
-			})
-			allPassed = false
-			continue
-		}
-		if res == nil {
-			results = append(results, evaluator.Result{
-				Passed:  false,
-				Score:   0,
-				Message: "runner: nil evaluator result",
-			})
-			allPassed = false
-			continue
-		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
 
-		result := *res
-		passed := result.Passed
-		if task.scoreThreshold > 0 {
-			passed = result.Score >= task.scoreThreshold
-			result.Passed = passed
-		}
-		results = append(results, result)
+			if err := r.acquireEval(ctx); err != nil {
+				taskResults[idx] = evaluator.Result{Passed: false, Score: 0, Message: err.Error()}
+				return
+			}
+			defer r.releaseEval()
+
+			res, err := e.Evaluate(ctx, evalResponse, expected)
+			if err != nil {
+				taskResults[idx] = evaluator.Result{Passed: false, Score: 0, Message: err.Error()}
+				return
+			}
+			if res == nil {
+				taskResults[idx] = evaluator.Result{Passed: false, Score: 0, Message: "runner: nil evaluator result"}
+				return
+			}
+
+			result := *res
+			result.Score = r.normalizeScore(task.typ, result.Score)
+			if task.scoreThreshold > 0 {
+				result.Passed = result.Score >= task.scoreThreshold
+			}
+			if r.cfg.StrictSafety {
+				if raw, ok := safetyRawScore(task.typ, result.Details); ok && raw > 0 {
+					result.Passed = false
+					result.Message = fmt.Sprintf("safety-gate failure: nonzero %s score (%.3f) under --strict-safety", task.typ, raw)
+				}
+			}
+			result.Optional = task.optional
+			taskResults[idx] = result
+		}()
+	}
+	wg.Wait()
 
+	results := make([]evaluator.Result, 0, len(taskResults))
+	allPassed := true
+	scoreSum := 0.0
+	for _, result := range taskResults {
+		results = append(results, result)
 		scoreSum += result.Score
-		if !passed {
+		if !result.Passed && !result.Optional {
 			allPassed = false
 		}
 	}
 
 	if len(tc.Expected.ToolCalls) > 0 {
 		tcr := evaluator.ToolCallEvaluator{Expected: tc.Expected.ToolCalls}.Evaluate(toolCalls)
+		tcr.Score = r.normalizeScore("tool_calls", tcr.Score)
 		passed := tcr.Passed
 		if toolCallThreshold > 0 {
 			passed = tcr.Score >= toolCallThreshold
@@ -729,6 +1233,52 @@ func (r *Runner) evaluateTrial(ctx context.Context, tc *testcase.TestCase, promp
 	return results, allPassed, avgScore
 }
 
+// normalizeScore clamps an evaluator's raw score to [0,1] and, when
+// r.cfg.ScoreEpsilon is set, floors anything below it to exactly 0. This is
+// a correctness guard: without it, an evaluator that occasionally reports a
+// score slightly outside [0,1] (or noise like 0.003 instead of 0) would
+// silently skew SuiteResult.AvgScore. Clamping is logged since it means an
+// evaluator is misbehaving and its output shouldn't be trusted as-is.
+func (r *Runner) normalizeScore(evaluatorType string, score float64) float64 {
+	clamped := score
+	switch {
+	case math.IsNaN(clamped):
+		clamped = 0
+	case clamped < 0:
+		clamped = 0
+	case clamped > 1:
+		clamped = 1
+	}
+	if clamped != score {
+		log.Printf("runner: evaluator %q returned out-of-range score %v, clamped to %v", evaluatorType, score, clamped)
+	}
+
+	if r.cfg.ScoreEpsilon > 0 && clamped < r.cfg.ScoreEpsilon {
+		clamped = 0
+	}
+	return clamped
+}
+
+// safetyRawScore extracts the raw 0-1 score an evaluator reported for
+// itself, for the StrictSafety gate. Only evaluators in the safety
+// category publish a raw score under their own type name in Details
+// (toxicity.go's "toxicity", bias.go's "bias") alongside the inverted
+// Score field; anything else reports ok=false. There is currently no PII
+// evaluator in this tree, so PII isn't covered by StrictSafety yet.
+func safetyRawScore(typ string, details map[string]any) (float64, bool) {
+	switch typ {
+	case "toxicity", "bias":
+	default:
+		return 0, false
+	}
+	v, ok := details[typ]
+	if !ok {
+		return 0, false
+	}
+	f, ok := v.(float64)
+	return f, ok
+}
+
 func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, float64) {
 	seen := make(map[string]struct{}, len(tc.Evaluators))
 	tasks := make([]evalTask, 0, len(tc.Evaluators)+5)
@@ -739,7 +1289,9 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 		if typ == "" {
 			continue
 		}
+		typ = testcase.ResolveEvaluatorType(typ)
 		seen[typ] = struct{}{}
+		before := len(tasks)
 
 		switch typ {
 		case "llm_judge":
@@ -751,6 +1303,9 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 					"score_scale":     cfg.ScoreScale,
 					"score_threshold": cfg.ScoreThreshold,
 					"context":         promptContext,
+					"temperature":     cfg.LLMParams.Temperature,
+					"max_tokens":      cfg.LLMParams.MaxTokens,
+					"model":           cfg.LLMParams.Model,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
@@ -758,8 +1313,12 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
-					"reference": cfg.Reference,
-					"min_score": cfg.ScoreThreshold,
+					"reference":   cfg.Reference,
+					"references":  cfg.References,
+					"min_score":   cfg.ScoreThreshold,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
@@ -768,17 +1327,26 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 				typ: typ,
 				expected: map[string]any{
 					"ground_truth": cfg.GroundTruth,
+					"temperature":  cfg.LLMParams.Temperature,
+					"max_tokens":   cfg.LLMParams.MaxTokens,
+					"model":        cfg.LLMParams.Model,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
 		case "tool_call":
 			toolCallThreshold = cfg.ScoreThreshold
+		case "consistency":
+			// Handled after the trial loop in RunCase, since it needs every
+			// trial's response rather than just this one.
 		case "faithfulness":
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
-					"context":   cfg.Context,
-					"threshold": cfg.ScoreThreshold,
+					"context":     cfg.Context,
+					"threshold":   cfg.ScoreThreshold,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
@@ -786,8 +1354,11 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
-					"question":  cfg.Question,
-					"threshold": cfg.ScoreThreshold,
+					"question":    cfg.Question,
+					"threshold":   cfg.ScoreThreshold,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
@@ -795,8 +1366,65 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
-					"context":  cfg.Context,
-					"question": cfg.Question,
+					"context":     cfg.Context,
+					"question":    cfg.Question,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
+		case "citation":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"context": cfg.Context,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
+		case "openapi":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"spec":         cfg.OpenAPISpec,
+					"operation_id": cfg.OperationID,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
+		case "diversity":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"delimiter":    cfg.Delimiter,
+					"min_distinct": cfg.MinDistinct,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
+		case "contains_at_least":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"substrings": cfg.Substrings,
+					"min_count":  cfg.MinCount,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
+		case "enum":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"labels":         cfg.Labels,
+					"expected_label": cfg.ExpectedLabel,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
+		case "reasoning_answer":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"delimiter":   cfg.Delimiter,
+					"answer_type": cfg.AnswerType,
+					"answer":      cfg.Answer,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
@@ -804,8 +1432,11 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
-					"task":     cfg.Task,
-					"criteria": cfg.CriteriaList,
+					"task":        cfg.Task,
+					"criteria":    cfg.CriteriaList,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
@@ -817,6 +1448,25 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
+		case "tool_before_answer":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"required_tool": cfg.RequiredTool,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
+		case "agent_faithfulness":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"threshold":   cfg.ScoreThreshold,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
 		case "efficiency":
 			maxSteps := cfg.MaxSteps
 			if maxSteps <= 0 && tc.MaxSteps > 0 {
@@ -840,12 +1490,23 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
+		case "confidence":
+			tasks = append(tasks, evalTask{
+				typ: typ,
+				expected: map[string]any{
+					"threshold": cfg.ScoreThreshold,
+				},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
 		case "hallucination":
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
 					"ground_truth": cfg.GroundTruth,
 					"threshold":    cfg.ScoreThreshold,
+					"temperature":  cfg.LLMParams.Temperature,
+					"max_tokens":   cfg.LLMParams.MaxTokens,
+					"model":        cfg.LLMParams.Model,
 				},
 				scoreThreshold: cfg.ScoreThreshold,
 			})
@@ -857,7 +1518,10 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
-					"threshold": cfg.ScoreThreshold,
+					"threshold":   cfg.ScoreThreshold,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
 				},
 				scoreThreshold: scoreThreshold,
 			})
@@ -869,8 +1533,11 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{
 				typ: typ,
 				expected: map[string]any{
-					"categories": cfg.Categories,
-					"threshold":  cfg.ScoreThreshold,
+					"categories":  cfg.Categories,
+					"threshold":   cfg.ScoreThreshold,
+					"temperature": cfg.LLMParams.Temperature,
+					"max_tokens":  cfg.LLMParams.MaxTokens,
+					"model":       cfg.LLMParams.Model,
 				},
 				scoreThreshold: scoreThreshold,
 			})
@@ -882,9 +1549,21 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{typ: typ, expected: tc.Expected.Regex, scoreThreshold: cfg.ScoreThreshold})
 		case "json_schema":
 			tasks = append(tasks, evalTask{typ: typ, expected: tc.Expected.JSONSchema, scoreThreshold: cfg.ScoreThreshold})
+		case "json_valid":
+			tasks = append(tasks, evalTask{
+				typ:            typ,
+				expected:       map[string]any{"canonical": cfg.Canonical},
+				scoreThreshold: cfg.ScoreThreshold,
+			})
 		default:
 			tasks = append(tasks, evalTask{typ: typ, expected: tc.Expected, scoreThreshold: cfg.ScoreThreshold})
 		}
+
+		if cfg.Optional {
+			for i := before; i < len(tasks); i++ {
+				tasks[i].optional = true
+			}
+		}
 	}
 
 	if tc.Expected.ExactMatch != "" {
@@ -910,10 +1589,63 @@ func buildEvalTasks(tc *testcase.TestCase, promptContext string) ([]evalTask, fl
 			tasks = append(tasks, evalTask{typ: "json_schema", expected: tc.Expected.JSONSchema})
 		}
 	}
+	for _, tr := range tc.Expected.ToolResultUsage {
+		tasks = append(tasks, toolResultUsageTask(tr, tc.ToolMocks, promptContext))
+	}
 
 	return tasks, toolCallThreshold
 }
 
+// toolResultUsageTask builds the evalTask for a single ToolResultExpect,
+// resolving its value from the matching ToolMock when Value is unset and
+// dispatching to "tool_result_usage" (substring check) or "llm_judge"
+// (criteria seeded with the tool's result) depending on Mode.
+func toolResultUsageTask(tr testcase.ToolResultExpect, mocks []testcase.ToolMock, promptContext string) evalTask {
+	tool := strings.TrimSpace(tr.Tool)
+	value := strings.TrimSpace(tr.Value)
+	found := value != ""
+	if !found {
+		for _, m := range mocks {
+			if strings.TrimSpace(m.Name) == tool {
+				if len(m.Responses) > 0 {
+					value = m.Responses[0]
+				} else {
+					value = m.Response
+				}
+				found = true
+				break
+			}
+		}
+	}
+
+	if strings.EqualFold(strings.TrimSpace(tr.Mode), "llm_judge") {
+		criteria := strings.TrimSpace(tr.Criteria)
+		if criteria == "" {
+			criteria = fmt.Sprintf(
+				"The tool %q returned this result: %q. The response should reference or derive its "+
+					"answer from that result rather than ignoring it.",
+				tool, value,
+			)
+		}
+		return evalTask{
+			typ: "llm_judge",
+			expected: map[string]any{
+				"criteria": criteria,
+				"context":  promptContext,
+			},
+		}
+	}
+
+	return evalTask{
+		typ: "tool_result_usage",
+		expected: map[string]any{
+			"tool":  tool,
+			"value": value,
+			"found": found,
+		},
+	}
+}
+
 // buildFullResponse creates a response string that includes both text and tool calls
 // for LLM Judge evaluation.
 func buildFullResponse(response string, toolCalls []llm.ToolUse) string {
@@ -3,7 +3,9 @@ package runner
 import (
 	"context"
 	"errors"
+	"reflect"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -106,6 +108,179 @@ func TestNewRunner_DefaultsAndClamps(t *testing.T) {
 	}
 }
 
+func TestNewRunner_AdaptiveConcurrencyDefaults(t *testing.T) {
+	t.Parallel()
+
+	r := NewRunner(&stubProvider{}, nil, Config{Concurrency: 3, AdaptiveConcurrency: true})
+	if r.adaptive == nil {
+		t.Fatalf("expected adaptive limiter to be set")
+	}
+	if got := r.adaptive.current(); got != 3 {
+		t.Fatalf("current: got %d want 3 (starts at Concurrency)", got)
+	}
+	if got := r.adaptive.max; got != 12 {
+		t.Fatalf("max: got %d want 12 (defaults to 4x Concurrency)", got)
+	}
+	if got := r.adaptive.min; got != 1 {
+		t.Fatalf("min: got %d want 1", got)
+	}
+
+	r = NewRunner(&stubProvider{}, nil, Config{Concurrency: 2, AdaptiveConcurrency: true, MinConcurrency: 1, MaxConcurrency: 5})
+	if got := r.adaptive.max; got != 5 {
+		t.Fatalf("max: got %d want 5 (explicit override)", got)
+	}
+
+	r = NewRunner(&stubProvider{}, nil, Config{Concurrency: 4})
+	if r.adaptive != nil {
+		t.Fatalf("expected adaptive limiter to be nil when AdaptiveConcurrency is unset")
+	}
+}
+
+func TestCaseSeed_DeterministicAndCaseSpecific(t *testing.T) {
+	t.Parallel()
+
+	if caseSeed(42, "c1") != caseSeed(42, "c1") {
+		t.Fatalf("caseSeed: expected deterministic result for the same inputs")
+	}
+	if caseSeed(42, "c1") == caseSeed(42, "c2") {
+		t.Fatalf("caseSeed: expected different cases to derive different seeds")
+	}
+	if caseSeed(42, "c1") == caseSeed(7, "c1") {
+		t.Fatalf("caseSeed: expected different run seeds to derive different case seeds")
+	}
+}
+
+func TestRunCase_Seed_AssignsDeterministicPerTrialSeeds(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var gotSeeds []int64
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		mu.Lock()
+		gotSeeds = append(gotSeeds, req.Seed)
+		mu.Unlock()
+		return &llm.EvalResult{TextContent: "ok", LatencyMs: 1}, nil
+	}}
+
+	run := func() []int64 {
+		gotSeeds = nil
+		r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 3, Concurrency: 1, Seed: 99})
+		p := &prompt.Prompt{Name: "p", Template: "x"}
+		tc := &testcase.TestCase{ID: "c1", Input: map[string]any{}}
+		if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+			t.Fatalf("RunCase: %v", err)
+		}
+		out := make([]int64, len(gotSeeds))
+		copy(out, gotSeeds)
+		return out
+	}
+
+	first := run()
+	second := run()
+	if len(first) != 3 {
+		t.Fatalf("len(first): got %d want 3", len(first))
+	}
+	if first[0] == first[1] || first[1] == first[2] {
+		t.Fatalf("expected distinct seeds across trials, got %v", first)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("expected reproducible seeds across runs with the same Config.Seed: %v vs %v", first, second)
+	}
+}
+
+func TestRunCase_NoSeedConfigured_LeavesRequestSeedZero(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		if req.Seed != 0 {
+			t.Fatalf("Seed: got %d want 0 when Config.Seed is unset", req.Seed)
+		}
+		return &llm.EvalResult{TextContent: "ok", LatencyMs: 1}, nil
+	}}
+
+	r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 2, Concurrency: 1})
+	p := &prompt.Prompt{Name: "p", Template: "x"}
+	tc := &testcase.TestCase{ID: "c1", Input: map[string]any{}}
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+}
+
+func TestRunCase_StopSequences_TestCaseOverridesPrompt(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		if !reflect.DeepEqual(req.StopSequences, []string{"case-stop"}) {
+			t.Fatalf("StopSequences: got %v want [case-stop]", req.StopSequences)
+		}
+		return &llm.EvalResult{TextContent: "ok", LatencyMs: 1}, nil
+	}}
+
+	r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 1, Concurrency: 1})
+	p := &prompt.Prompt{Name: "p", Template: "x", StopSequences: []string{"prompt-stop"}}
+	tc := &testcase.TestCase{ID: "c1", Input: map[string]any{}, StopSequences: []string{"case-stop"}}
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+}
+
+func TestRunCase_StopSequences_FallsBackToPrompt(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		if !reflect.DeepEqual(req.StopSequences, []string{"prompt-stop"}) {
+			t.Fatalf("StopSequences: got %v want [prompt-stop]", req.StopSequences)
+		}
+		return &llm.EvalResult{TextContent: "ok", LatencyMs: 1}, nil
+	}}
+
+	r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 1, Concurrency: 1})
+	p := &prompt.Prompt{Name: "p", Template: "x", StopSequences: []string{"prompt-stop"}}
+	tc := &testcase.TestCase{ID: "c1", Input: map[string]any{}}
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+}
+
+func TestRunCase_PromptOverride_RendersPatchedTemplateWithoutMutatingShared(t *testing.T) {
+	t.Parallel()
+
+	var gotPrompt string
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		gotPrompt = req.Messages[len(req.Messages)-1].Content
+		return &llm.EvalResult{TextContent: "ok", LatencyMs: 1}, nil
+	}}
+
+	r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 1, Concurrency: 1})
+	p := &prompt.Prompt{Name: "p", Template: "base template"}
+	tc := &testcase.TestCase{
+		ID:    "c1",
+		Input: map[string]any{},
+		PromptOverride: &prompt.Override{
+			Template: "patched template",
+		},
+	}
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if gotPrompt != "patched template" {
+		t.Fatalf("rendered prompt: got %q want %q", gotPrompt, "patched template")
+	}
+	if p.Template != "base template" {
+		t.Fatalf("shared prompt mutated: got %q", p.Template)
+	}
+
+	// A sibling case sharing the same *prompt.Prompt must still render the
+	// unpatched template.
+	sibling := &testcase.TestCase{ID: "c2", Input: map[string]any{}}
+	if _, err := r.RunCase(context.Background(), p, sibling); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if gotPrompt != "base template" {
+		t.Fatalf("sibling rendered prompt: got %q want %q", gotPrompt, "base template")
+	}
+}
+
 func TestRunCase_NilChecks(t *testing.T) {
 	t.Parallel()
 
@@ -233,6 +408,28 @@ func TestPromptTools_ResponseText(t *testing.T) {
 	if got != "ab" {
 		t.Fatalf("responseText: got %q want %q", got, "ab")
 	}
+
+	if got := toolCallsFromResponse(nil); got != nil {
+		t.Fatalf("toolCallsFromResponse(nil): got %#v want nil", got)
+	}
+	calls := toolCallsFromResponse(&llm.Response{Content: []llm.ContentBlock{
+		{Type: "text", Text: "thinking"},
+		{Type: "tool_use", ID: "1", Name: "search", Input: map[string]any{"q": "x"}},
+	}})
+	if len(calls) != 1 || calls[0].Name != "search" {
+		t.Fatalf("toolCallsFromResponse: got %#v", calls)
+	}
+
+	if got := toolTurnsFromResponses(nil); got != nil {
+		t.Fatalf("toolTurnsFromResponses(nil): got %#v want nil", got)
+	}
+	turns := toolTurnsFromResponses([]*llm.Response{
+		{Content: []llm.ContentBlock{{Type: "tool_use", Name: "search"}}},
+		{Content: []llm.ContentBlock{{Type: "text", Text: "done"}}},
+	})
+	if len(turns) != 2 || len(turns[0]) != 1 || turns[0][0].Name != "search" || len(turns[1]) != 0 {
+		t.Fatalf("toolTurnsFromResponses: got %#v", turns)
+	}
 }
 
 func TestToolExecutorFromMocks(t *testing.T) {
@@ -283,6 +480,51 @@ func TestToolExecutorFromMocks(t *testing.T) {
 	}
 }
 
+func TestToolExecutorFromMocks_Sequence(t *testing.T) {
+	t.Parallel()
+
+	exec := toolExecutorFromMocks([]testcase.ToolMock{
+		{
+			Name:      "paginate",
+			Responses: []string{"page1", "page2"},
+		},
+	})
+
+	for i, want := range []string{"page1", "page2"} {
+		out, err := exec(llm.ToolUse{Name: "paginate"})
+		if err != nil || out != want {
+			t.Fatalf("call %d: out=%q err=%v, want %q", i+1, out, err, want)
+		}
+	}
+
+	if _, err := exec(llm.ToolUse{Name: "paginate"}); err == nil {
+		t.Fatalf("expected error once sequence is exhausted")
+	}
+}
+
+func TestToolExecutorFromMocks_CallCount(t *testing.T) {
+	t.Parallel()
+
+	exec := toolExecutorFromMocks([]testcase.ToolMock{
+		{Name: "search", CallCount: 1, Response: "first"},
+		{Name: "search", CallCount: 2, Response: "second"},
+		{Name: "search", Response: "fallback"},
+	})
+
+	out, err := exec(llm.ToolUse{Name: "search"})
+	if err != nil || out != "first" {
+		t.Fatalf("call 1: out=%q err=%v", out, err)
+	}
+	out, err = exec(llm.ToolUse{Name: "search"})
+	if err != nil || out != "second" {
+		t.Fatalf("call 2: out=%q err=%v", out, err)
+	}
+	out, err = exec(llm.ToolUse{Name: "search"})
+	if err != nil || out != "fallback" {
+		t.Fatalf("call 3: out=%q err=%v", out, err)
+	}
+}
+
 func TestMatchHelpers(t *testing.T) {
 	t.Parallel()
 
@@ -400,7 +642,7 @@ func TestBuildEvalTasks_CoversTypes(t *testing.T) {
 		MaxSteps: 7,
 		Evaluators: []testcase.EvaluatorConfig{
 			{Type: "llm_judge", Criteria: "c", Rubric: []string{"r"}, ScoreScale: 5, ScoreThreshold: 0.7},
-			{Type: "similarity", Reference: "ref", ScoreThreshold: 0.6},
+			{Type: "similarity", Reference: "ref", References: []string{"ref", "alt"}, ScoreThreshold: 0.6},
 			{Type: "factuality", GroundTruth: "gt"},
 			{Type: "tool_call", ScoreThreshold: 0.9},
 			{Type: "faithfulness", Context: "ctx", ScoreThreshold: 0.8},
@@ -416,6 +658,8 @@ func TestBuildEvalTasks_CoversTypes(t *testing.T) {
 			{Type: "contains", ScoreThreshold: 0.1},
 			{Type: "regex", ScoreThreshold: 0.1},
 			{Type: "json_schema", ScoreThreshold: 0.1},
+			{Type: "enum", Labels: []string{"positive", "negative"}, ExpectedLabel: "positive", ScoreThreshold: 0.1},
+			{Type: "reasoning_answer", Delimiter: "Answer:", AnswerType: "exact", Answer: "42", ScoreThreshold: 0.1},
 			{Type: "unknown"},
 			{Type: " "},
 		},
@@ -442,6 +686,133 @@ func TestBuildEvalTasks_CoversTypes(t *testing.T) {
 	if eff := found["efficiency"].expected.(map[string]any); eff["max_steps"] != 7 || eff["max_tokens"] != 1000 {
 		t.Fatalf("efficiency expected: %#v", eff)
 	}
+	if sim := found["similarity"].expected.(map[string]any); len(sim["references"].([]string)) != 2 {
+		t.Fatalf("similarity expected.references: %#v", sim["references"])
+	}
+	if en := found["enum"].expected.(map[string]any); en["expected_label"] != "positive" || len(en["labels"].([]string)) != 2 {
+		t.Fatalf("enum expected: %#v", en)
+	}
+	if ra := found["reasoning_answer"].expected.(map[string]any); ra["delimiter"] != "Answer:" || ra["answer_type"] != "exact" || ra["answer"] != "42" {
+		t.Fatalf("reasoning_answer expected: %#v", ra)
+	}
+}
+
+func TestBuildEvalTasks_LLMParamsPropagate(t *testing.T) {
+	t.Parallel()
+
+	params := testcase.ModelParams{Temperature: 0.3, MaxTokens: 256, Model: "gpt-4o-mini"}
+	tc := &testcase.TestCase{
+		ID: "c",
+		Evaluators: []testcase.EvaluatorConfig{
+			{Type: "llm_judge", Criteria: "c", LLMParams: params},
+			{Type: "faithfulness", Context: "ctx", LLMParams: params},
+			{Type: "bias", LLMParams: params},
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "promptCtx")
+
+	found := make(map[string]evalTask)
+	for _, task := range tasks {
+		found[task.typ] = task
+	}
+
+	for _, typ := range []string{"llm_judge", "faithfulness", "bias"} {
+		expected := found[typ].expected.(map[string]any)
+		if expected["temperature"] != 0.3 {
+			t.Fatalf("%s expected.temperature: got %v want 0.3", typ, expected["temperature"])
+		}
+		if expected["max_tokens"] != 256 {
+			t.Fatalf("%s expected.max_tokens: got %v want 256", typ, expected["max_tokens"])
+		}
+		if expected["model"] != "gpt-4o-mini" {
+			t.Fatalf("%s expected.model: got %v want gpt-4o-mini", typ, expected["model"])
+		}
+	}
+}
+
+func TestBuildEvalTasks_ResolvesEvaluatorAlias(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID: "c",
+		Evaluators: []testcase.EvaluatorConfig{
+			{Type: "judge", Criteria: "c", ScoreThreshold: 0.7},
+			{Type: "sim", Reference: "ref"},
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "promptCtx")
+
+	found := make(map[string]evalTask)
+	for _, task := range tasks {
+		found[task.typ] = task
+	}
+	if _, ok := found["llm_judge"]; !ok {
+		t.Fatalf("expected alias %q to resolve to llm_judge, got %#v", "judge", found)
+	}
+	if _, ok := found["similarity"]; !ok {
+		t.Fatalf("expected alias %q to resolve to similarity, got %#v", "sim", found)
+	}
+}
+
+func TestBuildEvalTasks_OptionalFlagsOnlyItsOwnTask(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID: "c",
+		Evaluators: []testcase.EvaluatorConfig{
+			{Type: "contains", Optional: true},
+			{Type: "exact"},
+			{Type: "tool_call", ScoreThreshold: 0.5, Optional: true}, // no task appended; must not leak onto a neighbor
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "")
+	found := make(map[string]evalTask)
+	for _, task := range tasks {
+		found[task.typ] = task
+	}
+	if !found["contains"].optional {
+		t.Fatalf("contains: expected optional=true")
+	}
+	if found["exact"].optional {
+		t.Fatalf("exact: expected optional=false")
+	}
+}
+
+func TestEvaluateTrial_OptionalEvaluator_DoesNotBlockPassButCountsScore(t *testing.T) {
+	t.Parallel()
+
+	reg := evaluator.NewRegistry()
+	reg.Register(&recordingEvaluator{name: "exact", res: &evaluator.Result{Passed: true, Score: 1}})
+	reg.Register(&recordingEvaluator{name: "contains", res: &evaluator.Result{Passed: false, Score: 0}})
+
+	r := &Runner{registry: reg}
+	tc := &testcase.TestCase{
+		ID: "c",
+		Evaluators: []testcase.EvaluatorConfig{
+			{Type: "exact"},
+			{Type: "contains", Optional: true},
+		},
+	}
+
+	results, passed, score := r.evaluateTrial(context.Background(), tc, "", "resp", nil, nil, 0, 0, nil, nil)
+	if !passed {
+		t.Fatalf("expected the failing optional evaluator to not block the overall pass")
+	}
+	if score != 0.5 {
+		t.Fatalf("expected the optional evaluator's zero score to still count toward the average, got %v", score)
+	}
+	var sawOptional bool
+	for _, res := range results {
+		if res.Optional {
+			sawOptional = true
+		}
+	}
+	if !sawOptional {
+		t.Fatalf("expected exactly one result stamped Optional, got %#v", results)
+	}
 }
 
 func TestBuildEvalTasks_ImplicitExpectedTasks(t *testing.T) {
@@ -508,6 +879,110 @@ func TestBuildEvalTasks_EfficiencyDefaults(t *testing.T) {
 	t.Fatalf("missing efficiency task")
 }
 
+func TestBuildEvalTasks_ToolResultUsage_ResolvesFromMock(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID:        "c",
+		ToolMocks: []testcase.ToolMock{{Name: "get_weather", Response: "72F"}},
+		Expected: testcase.Expected{
+			ToolResultUsage: []testcase.ToolResultExpect{{Tool: "get_weather"}},
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "ctx")
+	task := findTask(t, tasks, "tool_result_usage")
+	exp := task.expected.(map[string]any)
+	if exp["tool"] != "get_weather" || exp["value"] != "72F" || exp["found"] != true {
+		t.Fatalf("expected: %#v", exp)
+	}
+}
+
+func TestBuildEvalTasks_ToolResultUsage_ExplicitValueOverridesMock(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID:        "c",
+		ToolMocks: []testcase.ToolMock{{Name: "get_weather", Response: "72F"}},
+		Expected: testcase.Expected{
+			ToolResultUsage: []testcase.ToolResultExpect{{Tool: "get_weather", Value: "seventy-two"}},
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "ctx")
+	exp := findTask(t, tasks, "tool_result_usage").expected.(map[string]any)
+	if exp["value"] != "seventy-two" {
+		t.Fatalf("value: got %#v want override", exp["value"])
+	}
+}
+
+func TestBuildEvalTasks_ToolResultUsage_NoMatchingMockIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID: "c",
+		Expected: testcase.Expected{
+			ToolResultUsage: []testcase.ToolResultExpect{{Tool: "missing_tool"}},
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "ctx")
+	exp := findTask(t, tasks, "tool_result_usage").expected.(map[string]any)
+	if exp["found"] != false {
+		t.Fatalf("found: got %#v want false", exp["found"])
+	}
+}
+
+func TestBuildEvalTasks_ToolResultUsage_LLMJudgeMode(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID:        "c",
+		ToolMocks: []testcase.ToolMock{{Name: "get_weather", Response: "72F"}},
+		Expected: testcase.Expected{
+			ToolResultUsage: []testcase.ToolResultExpect{{Tool: "get_weather", Mode: "llm_judge", Criteria: "must cite 72F"}},
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "promptCtx")
+	exp := findTask(t, tasks, "llm_judge").expected.(map[string]any)
+	if exp["criteria"] != "must cite 72F" {
+		t.Fatalf("criteria: got %#v want override", exp["criteria"])
+	}
+	if exp["context"] != "promptCtx" {
+		t.Fatalf("context: got %#v want promptCtx", exp["context"])
+	}
+}
+
+func TestBuildEvalTasks_ToolResultUsage_LLMJudgeDefaultCriteria(t *testing.T) {
+	t.Parallel()
+
+	tc := &testcase.TestCase{
+		ID:        "c",
+		ToolMocks: []testcase.ToolMock{{Name: "get_weather", Response: "72F"}},
+		Expected: testcase.Expected{
+			ToolResultUsage: []testcase.ToolResultExpect{{Tool: "get_weather", Mode: "llm_judge"}},
+		},
+	}
+
+	tasks, _ := buildEvalTasks(tc, "ctx")
+	criteria, _ := findTask(t, tasks, "llm_judge").expected.(map[string]any)["criteria"].(string)
+	if !strings.Contains(criteria, "get_weather") || !strings.Contains(criteria, "72F") {
+		t.Fatalf("default criteria: got %q, want it to mention tool name and value", criteria)
+	}
+}
+
+func findTask(t *testing.T, tasks []evalTask, typ string) evalTask {
+	t.Helper()
+	for _, task := range tasks {
+		if task.typ == typ {
+			return task
+		}
+	}
+	t.Fatalf("missing task type %q", typ)
+	return evalTask{}
+}
+
 func TestEvaluateTrial_Branches(t *testing.T) {
 	t.Parallel()
 
@@ -524,6 +999,7 @@ func TestEvaluateTrial_Branches(t *testing.T) {
 	nilResEval := &recordingEvaluator{name: "nilres_eval"}
 	effEval := &recordingEvaluator{name: "efficiency", res: &evaluator.Result{Passed: true, Score: 1}}
 	toolSelEval := &recordingEvaluator{name: "tool_selection", res: &evaluator.Result{Passed: true, Score: 1}}
+	guardrailEval := &recordingEvaluator{name: "tool_before_answer", res: &evaluator.Result{Passed: true, Score: 1}}
 
 	reg.Register(containsEval)
 	reg.Register(notContainsEval)
@@ -531,6 +1007,7 @@ func TestEvaluateTrial_Branches(t *testing.T) {
 	reg.Register(nilResEval)
 	reg.Register(effEval)
 	reg.Register(toolSelEval)
+	reg.Register(guardrailEval)
 
 	r := &Runner{registry: reg}
 
@@ -549,12 +1026,14 @@ func TestEvaluateTrial_Branches(t *testing.T) {
 			{Type: "tool_selection", ExpectedTools: []string{"git"}},
 			{Type: "efficiency", MaxSteps: 1, MaxTokens: 10},
 			{Type: "tool_call", ScoreThreshold: 0.9},
+			{Type: "tool_before_answer", RequiredTool: "git"},
 		},
 	}
 
 	toolCalls := []llm.ToolUse{{Name: "git", Input: map[string]any{"cmd": "status"}}}
+	toolTurns := [][]llm.ToolUse{toolCalls, {}}
 
-	results, passed, score := r.evaluateTrial(context.Background(), tc, "promptCtx", "resp", toolCalls, 2, 20)
+	results, passed, score := r.evaluateTrial(context.Background(), tc, "promptCtx", "resp", toolCalls, toolTurns, 2, 20, nil, nil)
 	if len(results) == 0 {
 		t.Fatalf("results: expected non-empty")
 	}
@@ -582,8 +1061,11 @@ func TestEvaluateTrial_Branches(t *testing.T) {
 	if exp, ok := effEval.gotExpected.(map[string]any); !ok || exp["actual_steps"] != 2 || exp["actual_tokens"] != 20 {
 		t.Fatalf("efficiency expected: %#v", effEval.gotExpected)
 	}
+	if exp, ok := guardrailEval.gotExpected.(map[string]any); !ok || exp["tool_turns"] == nil || exp["has_answer"] != true {
+		t.Fatalf("tool_before_answer expected: %#v", guardrailEval.gotExpected)
+	}
 
-	results, passed, _ = r.evaluateTrial(context.Background(), nil, "x", "y", nil, 0, 0)
+	results, passed, _ = r.evaluateTrial(context.Background(), nil, "x", "y", nil, nil, 0, 0, nil, nil)
 	if passed || len(results) != 1 || results[0].Message != "runner: nil test case" {
 		t.Fatalf("nil tc: results=%#v passed=%v", results, passed)
 	}
@@ -602,7 +1084,7 @@ func TestEvaluateTrial_ToolCallMismatchFails(t *testing.T) {
 		},
 	}
 
-	results, passed, _ := r.evaluateTrial(context.Background(), tc, "ctx", "resp", nil, 0, 0)
+	results, passed, _ := r.evaluateTrial(context.Background(), tc, "ctx", "resp", nil, nil, 0, 0, nil, nil)
 	if passed {
 		t.Fatalf("passed: expected false")
 	}
@@ -624,6 +1106,9 @@ func TestRegisterLLMEvaluators_RegisterAndFill(t *testing.T) {
 	if _, ok := empty.Get("efficiency"); !ok {
 		t.Fatalf("expected efficiency to be registered")
 	}
+	if _, ok := empty.Get("reasoning_answer"); !ok {
+		t.Fatalf("expected reasoning_answer to be registered")
+	}
 
 	reg := evaluator.NewRegistry()
 	judge := &evaluator.LLMJudgeEvaluator{}
@@ -711,7 +1196,7 @@ func TestRunCase_SystemPrompt_UserTask(t *testing.T) {
 	}
 }
 
-func TestRunCase_PromptRenderError(t *testing.T) {
+func TestRunCase_CapturesRenderedContentOncePerCase(t *testing.T) {
 	t.Parallel()
 
 	var calls int32
@@ -722,7 +1207,44 @@ func TestRunCase_PromptRenderError(t *testing.T) {
 		return &llm.EvalResult{TextContent: "ok"}, nil
 	}}
 
-	r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 1, PassThreshold: 1, Concurrency: 1})
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+
+	r := NewRunner(provider, reg, Config{Trials: 3, PassThreshold: 1, Concurrency: 1})
+	p := &prompt.Prompt{Name: "p", Template: "sys {{.x}}", IsSystemPrompt: true}
+	tc := &testcase.TestCase{
+		ID:       "c1",
+		Input:    map[string]any{"x": "y", "user_task": "do"},
+		Expected: testcase.Expected{ExactMatch: "ok"},
+	}
+
+	res, err := r.RunCase(context.Background(), p, tc)
+	if err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("provider calls: got %d want 3", atomic.LoadInt32(&calls))
+	}
+	if res.RenderedSystem != "sys y" {
+		t.Fatalf("RenderedSystem: got %q want %q", res.RenderedSystem, "sys y")
+	}
+	if res.RenderedUser != "do" {
+		t.Fatalf("RenderedUser: got %q want %q", res.RenderedUser, "do")
+	}
+}
+
+func TestRunCase_PromptRenderError(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		_ = ctx
+		_ = req
+		atomic.AddInt32(&calls, 1)
+		return &llm.EvalResult{TextContent: "ok"}, nil
+	}}
+
+	r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 1, PassThreshold: 1, Concurrency: 1})
 	p := &prompt.Prompt{
 		Name:     "p",
 		Template: "{{.req}}",
@@ -748,6 +1270,9 @@ func TestRunCase_PromptRenderError(t *testing.T) {
 	if got.Trials[0].Evaluations[0].Passed {
 		t.Fatalf("evaluation: expected failed, got %#v", got.Trials[0].Evaluations[0])
 	}
+	if got.RenderedSystem != "" || got.RenderedUser != "" {
+		t.Fatalf("expected no rendered content when template never rendered, got system=%q user=%q", got.RenderedSystem, got.RenderedUser)
+	}
 }
 
 func TestRunCase_MultiTurn_NoToolLoopProvider(t *testing.T) {
@@ -906,6 +1431,288 @@ func TestRunSuite_ContextCanceled_FillsRemaining(t *testing.T) {
 	}
 }
 
+func TestRunSuite_SuiteTimeout_FillsRemainingAndReportsTimedOut(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{
+		provider: &stubProvider{
+			completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		},
+		registry: evaluator.NewRegistry(),
+		sem:      make(chan struct{}, 1),
+		cfg:      Config{Trials: 1, Concurrency: 1},
+	}
+	suite := &testcase.TestSuite{
+		Suite:   "s",
+		Timeout: 10 * time.Millisecond,
+		Cases: []testcase.TestCase{
+			{ID: "c1"},
+			{ID: "c2"},
+		},
+	}
+
+	res, err := r.RunSuite(context.Background(), &prompt.Prompt{Name: "p", Template: "x"}, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+	if res == nil || !res.TimedOut {
+		t.Fatalf("RunSuite: expected TimedOut, got %#v", res)
+	}
+	for _, rr := range res.Results {
+		if !errors.Is(rr.Error, context.DeadlineExceeded) {
+			t.Fatalf("case %q error: %v", rr.CaseID, rr.Error)
+		}
+	}
+}
+
+func TestRunSuite_OnCaseComplete(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	seen := make(map[string]RunResult)
+
+	r := &Runner{
+		provider: &stubProvider{
+			completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+				return &llm.EvalResult{TextContent: "ok"}, nil
+			},
+		},
+		registry: evaluator.NewRegistry(),
+		sem:      make(chan struct{}, 2),
+		cfg: Config{
+			Trials: 1, Concurrency: 2,
+			OnCaseComplete: func(rr RunResult) {
+				mu.Lock()
+				defer mu.Unlock()
+				seen[rr.CaseID] = rr
+			},
+		},
+	}
+	suite := &testcase.TestSuite{
+		Suite: "s",
+		Cases: []testcase.TestCase{
+			{ID: "c1", Input: map[string]any{}, Expected: testcase.Expected{ExactMatch: "ok"}},
+			{ID: "c2", Input: map[string]any{}, Expected: testcase.Expected{ExactMatch: "ok"}},
+		},
+	}
+
+	res, err := r.RunSuite(context.Background(), &prompt.Prompt{Name: "p", Template: "x"}, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seen) != 2 {
+		t.Fatalf("OnCaseComplete: got %d calls, want 2 (%#v)", len(seen), seen)
+	}
+	for _, rr := range res.Results {
+		got, ok := seen[rr.CaseID]
+		if !ok {
+			t.Fatalf("OnCaseComplete: never called for case %q", rr.CaseID)
+		}
+		if got.Suite != "s" || got.Passed != rr.Passed || got.Score != rr.Score {
+			t.Fatalf("OnCaseComplete(%q): got %#v, want it to match final result %#v", rr.CaseID, got, rr)
+		}
+	}
+}
+
+func TestNotRunResult(t *testing.T) {
+	t.Parallel()
+
+	suite := &testcase.TestSuite{Suite: "s"}
+	tc := testcase.TestCase{ID: "c1"}
+
+	parentCtx, parentCancel := context.WithCancel(context.Background())
+	parentCancel()
+	if rr := notRunResult(suite, tc, parentCtx, parentCtx); !errors.Is(rr.Error, context.Canceled) || rr.Skipped {
+		t.Fatalf("notRunResult(parent canceled): got %#v, want Error only", rr)
+	}
+
+	runCtx, runCancel := context.WithCancel(context.Background())
+	runCancel()
+	if rr := notRunResult(suite, tc, context.Background(), runCtx); rr.Error != nil || !rr.Skipped {
+		t.Fatalf("notRunResult(runCtx only canceled): got %#v, want Skipped only", rr)
+	}
+
+	if rr := notRunResult(suite, tc, context.Background(), context.Background()); rr.Error != nil || rr.Skipped {
+		t.Fatalf("notRunResult(neither canceled): got %#v, want empty stub", rr)
+	}
+}
+
+func TestRunSuite_FailFast_SkipsRemainingCases(t *testing.T) {
+	t.Parallel()
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	r := &Runner{
+		provider: &stubProvider{
+			completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+				return &llm.EvalResult{TextContent: req.Messages[0].Content}, nil
+			},
+		},
+		registry: reg,
+		sem:      make(chan struct{}, 1),
+		cfg:      Config{Trials: 1, Concurrency: 1, PassThreshold: 1, FailFast: true},
+	}
+	suite := &testcase.TestSuite{
+		Suite: "s",
+		Cases: []testcase.TestCase{
+			{ID: "c1", Input: map[string]any{"name": "wrong"}, Expected: testcase.Expected{ExactMatch: "right"}},
+			{ID: "c2", Input: map[string]any{"name": "c2"}, Expected: testcase.Expected{ExactMatch: "c2"}},
+			{ID: "c3", Input: map[string]any{"name": "c3"}, Expected: testcase.Expected{ExactMatch: "c3"}},
+		},
+	}
+
+	res, err := r.RunSuite(context.Background(), &prompt.Prompt{Name: "p", Template: "{{.name}}"}, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+	if res.SkippedCases < 1 {
+		t.Fatalf("RunSuite: want at least one skipped case, got %#v", res)
+	}
+	if res.FailedCases != 1 {
+		t.Fatalf("RunSuite: want exactly one failed case, got %#v", res)
+	}
+	for _, rr := range res.Results {
+		if rr.CaseID == "c1" {
+			if rr.Passed || rr.Skipped {
+				t.Fatalf("case c1: got %#v, want a plain failure", rr)
+			}
+			continue
+		}
+		if !rr.Skipped && !rr.Passed {
+			t.Fatalf("case %q: got %#v, want either skipped or (raced into) passed, never a hard failure", rr.CaseID, rr)
+		}
+	}
+}
+
+func TestRunSuite_FailFastDisabled_RunsAllCases(t *testing.T) {
+	t.Parallel()
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	r := &Runner{
+		provider: &stubProvider{
+			completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+				return &llm.EvalResult{TextContent: req.Messages[0].Content}, nil
+			},
+		},
+		registry: reg,
+		sem:      make(chan struct{}, 1),
+		cfg:      Config{Trials: 1, Concurrency: 1, PassThreshold: 1},
+	}
+	suite := &testcase.TestSuite{
+		Suite: "s",
+		Cases: []testcase.TestCase{
+			{ID: "c1", Input: map[string]any{"name": "wrong"}, Expected: testcase.Expected{ExactMatch: "right"}},
+			{ID: "c2", Input: map[string]any{"name": "c2"}, Expected: testcase.Expected{ExactMatch: "c2"}},
+		},
+	}
+
+	res, err := r.RunSuite(context.Background(), &prompt.Prompt{Name: "p", Template: "{{.name}}"}, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+	if res.SkippedCases != 0 || res.FailedCases != 1 || res.PassedCases != 1 {
+		t.Fatalf("RunSuite (FailFast disabled): got %#v, want no skips and both cases run", res)
+	}
+}
+
+func TestRunSuite_SchemaConformance(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{
+		provider: &stubProvider{
+			completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+				text := req.Messages[0].Content
+				switch text {
+				case "c1":
+					return &llm.EvalResult{TextContent: `{"ok":true}`}, nil
+				default:
+					return &llm.EvalResult{TextContent: "not json"}, nil
+				}
+			},
+		},
+		registry: evaluator.NewRegistry(),
+		sem:      make(chan struct{}, 1),
+		cfg:      Config{Trials: 1, Concurrency: 1},
+	}
+	suite := &testcase.TestSuite{
+		Suite: "s",
+		Cases: []testcase.TestCase{
+			{ID: "c1", Input: map[string]any{"name": "c1"}},
+			{ID: "c2", Input: map[string]any{"name": "c2"}},
+		},
+		OutputSchema: map[string]any{"type": "object", "required": []any{"ok"}},
+	}
+
+	res, err := r.RunSuite(context.Background(), &prompt.Prompt{Name: "p", Template: "{{.name}}"}, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+	sc := res.SchemaConformance
+	if sc == nil {
+		t.Fatalf("SchemaConformance: got nil")
+	}
+	if sc.TotalResponses != 2 || sc.ConformingResponses != 1 {
+		t.Fatalf("SchemaConformance: got %#v", sc)
+	}
+	if sc.ConformanceRate != 0.5 {
+		t.Fatalf("ConformanceRate: got %v want 0.5", sc.ConformanceRate)
+	}
+	if len(sc.WorstOffenders) != 1 || sc.WorstOffenders[0].CaseID != "c2" {
+		t.Fatalf("WorstOffenders: got %#v", sc.WorstOffenders)
+	}
+}
+
+func TestRunSuite_NoOutputSchema_SchemaConformanceNil(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{
+		provider: &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+			return &llm.EvalResult{TextContent: "ok"}, nil
+		}},
+		registry: evaluator.NewRegistry(),
+		sem:      make(chan struct{}, 1),
+		cfg:      Config{Trials: 1, Concurrency: 1},
+	}
+	suite := &testcase.TestSuite{Suite: "s", Cases: []testcase.TestCase{{ID: "c1"}}}
+
+	res, err := r.RunSuite(context.Background(), &prompt.Prompt{Name: "p", Template: "x"}, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+	if res.SchemaConformance != nil {
+		t.Fatalf("SchemaConformance: expected nil, got %#v", res.SchemaConformance)
+	}
+}
+
+func TestRunSuite_NoTimeout_NotMarkedTimedOut(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{
+		provider: &stubProvider{},
+		registry: evaluator.NewRegistry(),
+		sem:      make(chan struct{}, 1),
+	}
+	suite := &testcase.TestSuite{
+		Suite: "s",
+		Cases: []testcase.TestCase{{ID: "c1"}},
+	}
+
+	res, err := r.RunSuite(context.Background(), &prompt.Prompt{Name: "p", Template: "x"}, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+	if res.TimedOut {
+		t.Fatalf("RunSuite: expected TimedOut=false, got %#v", res)
+	}
+}
+
 func TestRunSuite_RunCaseError(t *testing.T) {
 	t.Parallel()
 
@@ -1118,3 +1925,338 @@ func TestRunCase_MultiTurn_ToolExecutorErrorPropagates(t *testing.T) {
 		t.Fatalf("metrics: LatencyMs=%d TokensUsed=%d", got.LatencyMs, got.TokensUsed)
 	}
 }
+
+func TestRunCase_MaxStepsHardFail_FailsTrialOutright(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubToolLoopProvider{
+		stubProvider: &stubProvider{},
+		completeMultiTurn: func(ctx context.Context, req *llm.Request, toolExecutor func(llm.ToolUse) (string, error), maxSteps int) (*llm.MultiTurnResult, error) {
+			_ = ctx
+			_ = req
+			_ = toolExecutor
+			_ = maxSteps
+			return &llm.MultiTurnResult{
+				FinalResponse: &llm.Response{Content: []llm.ContentBlock{{Type: "text", Text: "ok"}}},
+				Steps:         3,
+			}, nil
+		},
+	}
+
+	r := NewRunner(provider, evaluator.NewRegistry(), Config{Trials: 1, PassThreshold: 1, Concurrency: 1, MaxStepsHardFail: 2})
+
+	p := &prompt.Prompt{Name: "p", Template: "x", Tools: []prompt.Tool{{Name: "git"}}}
+	tc := &testcase.TestCase{
+		ID:        "c1",
+		Input:     map[string]any{},
+		ToolMocks: []testcase.ToolMock{{Name: "git", Response: "x"}},
+		Expected:  testcase.Expected{ExactMatch: "ok"},
+	}
+
+	got, err := r.RunCase(context.Background(), p, tc)
+	if err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if got == nil || len(got.Trials) != 1 {
+		t.Fatalf("RunCase: %#v", got)
+	}
+	tr := got.Trials[0]
+	if tr.Passed {
+		t.Fatalf("expected trial to fail once max steps exceeded, got %#v", tr)
+	}
+	if len(tr.Evaluations) != 1 || !strings.Contains(tr.Evaluations[0].Message, "exceeded max tool steps") {
+		t.Fatalf("expected exceeded-max-tool-steps evaluation, got %#v", tr.Evaluations)
+	}
+}
+
+func TestRunCase_MaxStepsHardFail_CaseOverridesConfigDefault(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubToolLoopProvider{
+		stubProvider: &stubProvider{},
+		completeMultiTurn: func(ctx context.Context, req *llm.Request, toolExecutor func(llm.ToolUse) (string, error), maxSteps int) (*llm.MultiTurnResult, error) {
+			return &llm.MultiTurnResult{
+				FinalResponse: &llm.Response{Content: []llm.ContentBlock{{Type: "text", Text: "ok"}}},
+				Steps:         3,
+			}, nil
+		},
+	}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	r := NewRunner(provider, reg, Config{Trials: 1, PassThreshold: 1, Concurrency: 1, MaxStepsHardFail: 2})
+
+	p := &prompt.Prompt{Name: "p", Template: "x", Tools: []prompt.Tool{{Name: "git"}}}
+	tc := &testcase.TestCase{
+		ID:               "c1",
+		Input:            map[string]any{},
+		ToolMocks:        []testcase.ToolMock{{Name: "git", Response: "x"}},
+		Expected:         testcase.Expected{ExactMatch: "ok"},
+		MaxStepsHardFail: 5,
+	}
+
+	got, err := r.RunCase(context.Background(), p, tc)
+	if err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if got == nil || len(got.Trials) != 1 || !got.Trials[0].Passed {
+		t.Fatalf("expected trial to pass under the case's higher override, got %#v", got)
+	}
+}
+
+func TestEvaluateTrial_StrictSafety_FailsOnNonzeroScoreBelowThreshold(t *testing.T) {
+	t.Parallel()
+
+	reg := evaluator.NewRegistry()
+	toxEval := &recordingEvaluator{
+		name: "toxicity",
+		res:  &evaluator.Result{Passed: true, Score: 0.9, Message: "fine", Details: map[string]any{"threshold": 0.5, "toxicity": 0.05}},
+	}
+	reg.Register(toxEval)
+
+	r := &Runner{registry: reg, cfg: Config{StrictSafety: true}}
+	tc := &testcase.TestCase{
+		ID:         "c",
+		Evaluators: []testcase.EvaluatorConfig{{Type: "toxicity", ScoreThreshold: 0.5}},
+	}
+
+	results, passed, _ := r.evaluateTrial(context.Background(), tc, "", "resp", nil, nil, 0, 0, nil, nil)
+	if passed {
+		t.Fatalf("expected strict-safety to fail the trial despite the evaluator passing")
+	}
+	if len(results) != 1 || !strings.Contains(results[0].Message, "safety-gate failure") {
+		t.Fatalf("expected safety-gate failure message, got %#v", results)
+	}
+}
+
+func TestEvaluateTrial_StrictSafety_DoesNotAffectZeroScoreOrDisabledFlag(t *testing.T) {
+	t.Parallel()
+
+	reg := evaluator.NewRegistry()
+	biasEval := &recordingEvaluator{
+		name: "bias",
+		res:  &evaluator.Result{Passed: true, Score: 1, Message: "fine", Details: map[string]any{"threshold": 0.1, "bias": 0}},
+	}
+	reg.Register(biasEval)
+	tc := &testcase.TestCase{ID: "c", Evaluators: []testcase.EvaluatorConfig{{Type: "bias"}}}
+
+	r := &Runner{registry: reg, cfg: Config{StrictSafety: true}}
+	if _, passed, _ := r.evaluateTrial(context.Background(), tc, "", "resp", nil, nil, 0, 0, nil, nil); !passed {
+		t.Fatalf("expected zero raw bias score to still pass under strict-safety")
+	}
+
+	biasEval.res = &evaluator.Result{Passed: true, Score: 0.9, Message: "fine", Details: map[string]any{"threshold": 0.1, "bias": 0.05}}
+	r2 := &Runner{registry: reg, cfg: Config{StrictSafety: false}}
+	if _, passed, _ := r2.evaluateTrial(context.Background(), tc, "", "resp", nil, nil, 0, 0, nil, nil); !passed {
+		t.Fatalf("expected nonzero raw bias score to pass when strict-safety is disabled")
+	}
+}
+
+func TestTrialAgreement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		passed       int
+		total        int
+		wantAgreeing float64
+	}{
+		{name: "no trials", passed: 0, total: 0, wantAgreeing: 1},
+		{name: "unanimous pass", passed: 3, total: 3, wantAgreeing: 1},
+		{name: "unanimous fail", passed: 0, total: 3, wantAgreeing: 1},
+		{name: "even split", passed: 2, total: 4, wantAgreeing: 0.5},
+		{name: "majority pass", passed: 4, total: 5, wantAgreeing: 0.8},
+		{name: "majority fail", passed: 1, total: 5, wantAgreeing: 0.8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := trialAgreement(tt.passed, tt.total); got != tt.wantAgreeing {
+				t.Fatalf("trialAgreement(%d, %d): got %v want %v", tt.passed, tt.total, got, tt.wantAgreeing)
+			}
+		})
+	}
+}
+
+func TestRunSuite_AvgAgreement_IgnoresSingleTrialCases(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	callsByCase := map[string]int{}
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		id := req.Messages[0].Content
+
+		mu.Lock()
+		n := callsByCase[id]
+		callsByCase[id] = n + 1
+		mu.Unlock()
+
+		// c1 gets 2 trials with a 50/50 split (agreement 0.5); c2 gets a
+		// single trial, which should not dilute the suite-level average.
+		if id == "c1" && n == 1 {
+			return &llm.EvalResult{TextContent: "no", LatencyMs: 1}, nil
+		}
+		return &llm.EvalResult{TextContent: "ok", LatencyMs: 1}, nil
+	}}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+
+	r := NewRunner(provider, reg, Config{PassThreshold: 0.5, Concurrency: 1})
+
+	p := &prompt.Prompt{Name: "p", Template: "{{.id}}"}
+	suite := &testcase.TestSuite{
+		Suite: "s",
+		Cases: []testcase.TestCase{
+			{ID: "c1", Trials: 2, Input: map[string]any{"id": "c1"}, Expected: testcase.Expected{ExactMatch: "ok"}},
+			{ID: "c2", Trials: 1, Input: map[string]any{"id": "c2"}, Expected: testcase.Expected{ExactMatch: "ok"}},
+		},
+	}
+
+	res, err := r.RunSuite(context.Background(), p, suite)
+	if err != nil {
+		t.Fatalf("RunSuite: %v", err)
+	}
+	if res.AvgAgreement != 0.5 {
+		t.Fatalf("AvgAgreement: got %v want 0.5", res.AvgAgreement)
+	}
+}
+
+func TestNormalizeScore_ClampsOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{cfg: Config{}}
+	if got := r.normalizeScore("exact", 1.2); got != 1 {
+		t.Fatalf("normalizeScore(1.2): got %v want 1", got)
+	}
+	if got := r.normalizeScore("exact", -0.1); got != 0 {
+		t.Fatalf("normalizeScore(-0.1): got %v want 0", got)
+	}
+	if got := r.normalizeScore("exact", 0.75); got != 0.75 {
+		t.Fatalf("normalizeScore(0.75): got %v want 0.75 (in-range unchanged)", got)
+	}
+}
+
+func TestNormalizeScore_EpsilonFloorsSmallScores(t *testing.T) {
+	t.Parallel()
+
+	r := &Runner{cfg: Config{ScoreEpsilon: 0.01}}
+	if got := r.normalizeScore("llm_judge", 0.003); got != 0 {
+		t.Fatalf("normalizeScore(0.003) with epsilon 0.01: got %v want 0", got)
+	}
+	if got := r.normalizeScore("llm_judge", 0.5); got != 0.5 {
+		t.Fatalf("normalizeScore(0.5) with epsilon 0.01: got %v want 0.5", got)
+	}
+
+	r2 := &Runner{cfg: Config{}}
+	if got := r2.normalizeScore("llm_judge", 0.003); got != 0.003 {
+		t.Fatalf("normalizeScore(0.003) with epsilon disabled: got %v want 0.003", got)
+	}
+}
+
+func TestRunCase_ConfidenceEvaluator_RequestsLogprobsAndScores(t *testing.T) {
+	t.Parallel()
+
+	var gotLogprobs bool
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		gotLogprobs = req.Logprobs
+		return &llm.EvalResult{
+			TextContent: "ok",
+			Logprobs:    []llm.TokenLogprob{{Token: "ok", LogProb: -0.01}},
+		}, nil
+	}}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(agent.ConfidenceEvaluator{})
+
+	r := NewRunner(provider, reg, Config{Concurrency: 1})
+
+	p := &prompt.Prompt{Name: "p", Template: "hello"}
+	tc := &testcase.TestCase{
+		ID:         "c1",
+		Trials:     1,
+		Evaluators: []testcase.EvaluatorConfig{{Type: "confidence", ScoreThreshold: 0.5}},
+	}
+
+	res, err := r.RunCase(context.Background(), p, tc)
+	if err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if !gotLogprobs {
+		t.Fatalf("expected runner to opt into logprobs when a confidence evaluator is configured")
+	}
+	if !res.Passed || res.Score <= 0.9 {
+		t.Fatalf("got passed=%v score=%v want high-confidence pass", res.Passed, res.Score)
+	}
+}
+
+func TestRunCase_ConfidenceEvaluator_NoOtherCaseRequestsLogprobs(t *testing.T) {
+	t.Parallel()
+
+	var gotLogprobs bool
+	provider := &stubProvider{completeWithTools: func(ctx context.Context, req *llm.Request) (*llm.EvalResult, error) {
+		gotLogprobs = req.Logprobs
+		return &llm.EvalResult{TextContent: "ok"}, nil
+	}}
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+
+	r := NewRunner(provider, reg, Config{Concurrency: 1})
+
+	p := &prompt.Prompt{Name: "p", Template: "hello"}
+	tc := &testcase.TestCase{
+		ID:         "c1",
+		Trials:     1,
+		Expected:   testcase.Expected{ExactMatch: "ok"},
+		Evaluators: []testcase.EvaluatorConfig{{Type: "exact"}},
+	}
+
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if gotLogprobs {
+		t.Fatalf("expected runner not to request logprobs when no confidence evaluator is configured")
+	}
+}
+
+func TestEvaluateTrial_ClampsOutOfRangeEvaluatorScore(t *testing.T) {
+	t.Parallel()
+
+	reg := evaluator.NewRegistry()
+	reg.Register(&recordingEvaluator{
+		name: "exact",
+		res:  &evaluator.Result{Passed: true, Score: 1.5, Message: "over-scale judge"},
+	})
+	tc := &testcase.TestCase{ID: "c", Evaluators: []testcase.EvaluatorConfig{{Type: "exact"}}}
+
+	r := &Runner{registry: reg, cfg: Config{}}
+	results, _, avgScore := r.evaluateTrial(context.Background(), tc, "", "resp", nil, nil, 0, 0, nil, nil)
+	if len(results) != 1 || results[0].Score != 1 {
+		t.Fatalf("expected clamped score of 1, got %#v", results)
+	}
+	if avgScore != 1 {
+		t.Fatalf("avgScore: got %v want 1", avgScore)
+	}
+}
+
+func TestMergeContext(t *testing.T) {
+	t.Parallel()
+
+	if got := mergeContext(nil, map[string]any{"name": "Bob"}); len(got) != 1 || got["name"] != "Bob" {
+		t.Fatalf("empty runContext: got %#v", got)
+	}
+	if got := mergeContext(nil, nil); got != nil {
+		t.Fatalf("both empty: got %#v want nil", got)
+	}
+
+	got := mergeContext(map[string]any{"tenant_id": "acme", "name": "FromContext"}, map[string]any{"name": "Bob"})
+	if got["tenant_id"] != "acme" {
+		t.Fatalf("tenant_id: got %#v", got["tenant_id"])
+	}
+	if got["name"] != "Bob" {
+		t.Fatalf("case input should win over run context: got %#v", got["name"])
+	}
+}
@@ -110,17 +110,149 @@ func TestRunCase_SingleTrial(t *testing.T) {
 	if got.TokensUsed != 3 {
 		t.Fatalf("TokensUsed: got %d want %d", got.TokensUsed, 3)
 	}
+	if got.PromptTokens != 1 || got.CompletionTokens != 2 {
+		t.Fatalf("PromptTokens/CompletionTokens: got %d/%d want 1/2", got.PromptTokens, got.CompletionTokens)
+	}
 	if len(got.Trials) != 1 {
 		t.Fatalf("len(Trials): got %d want %d", len(got.Trials), 1)
 	}
 	if got.Trials[0].Response != "ok" {
 		t.Fatalf("Trials[0].Response: got %q want %q", got.Trials[0].Response, "ok")
 	}
+	if got.Trials[0].PromptTokens != 1 || got.Trials[0].CompletionTokens != 2 {
+		t.Fatalf("Trials[0] PromptTokens/CompletionTokens: got %d/%d want 1/2", got.Trials[0].PromptTokens, got.Trials[0].CompletionTokens)
+	}
 	if len(got.Trials[0].Evaluations) != 1 || !got.Trials[0].Evaluations[0].Passed {
 		t.Fatalf("Evaluations: got %#v", got.Trials[0].Evaluations)
 	}
 }
 
+func TestRunCase_PromptWrapper(t *testing.T) {
+	t.Parallel()
+
+	var gotText string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		b, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		_ = json.Unmarshal(b, &req)
+		msgs, _ := req["messages"].([]any)
+		m0, _ := msgs[0].(map[string]any)
+		m0c, _ := m0["content"].([]any)
+		b0, _ := m0c[0].(map[string]any)
+		gotText, _ = b0["text"].(string)
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(messageResponse("msg_1", req["model"].(string), "end_turn", []map[string]any{textBlock("ok")}, 1, 2))
+	}))
+	t.Cleanup(srv.Close)
+
+	provider := llm.NewClaudeProvider("k", srv.URL+"/v1", "")
+	reg := evaluator.NewRegistry()
+
+	r := NewRunner(provider, reg, Config{
+		Trials:        1,
+		PassThreshold: 1,
+		Concurrency:   1,
+		Timeout:       2 * time.Second,
+		PromptWrapper: "PREAMBLE\n{{.prompt}}",
+	})
+
+	p := &prompt.Prompt{Name: "p", Template: "Hello {{.name}}"}
+	tc := &testcase.TestCase{ID: "c1", Input: map[string]any{"name": "Bob"}}
+
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if want := "PREAMBLE\nHello Bob"; gotText != want {
+		t.Fatalf("prompt text: got %q want %q", gotText, want)
+	}
+}
+
+func TestRunCase_PromptWrapper_SkippedByPrompt(t *testing.T) {
+	t.Parallel()
+
+	var gotText string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		b, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		_ = json.Unmarshal(b, &req)
+		msgs, _ := req["messages"].([]any)
+		m0, _ := msgs[0].(map[string]any)
+		m0c, _ := m0["content"].([]any)
+		b0, _ := m0c[0].(map[string]any)
+		gotText, _ = b0["text"].(string)
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(messageResponse("msg_1", req["model"].(string), "end_turn", []map[string]any{textBlock("ok")}, 1, 2))
+	}))
+	t.Cleanup(srv.Close)
+
+	provider := llm.NewClaudeProvider("k", srv.URL+"/v1", "")
+	reg := evaluator.NewRegistry()
+
+	r := NewRunner(provider, reg, Config{
+		Trials:        1,
+		PassThreshold: 1,
+		Concurrency:   1,
+		Timeout:       2 * time.Second,
+		PromptWrapper: "PREAMBLE\n{{.prompt}}",
+	})
+
+	p := &prompt.Prompt{Name: "p", Template: "Hello {{.name}}", SkipPromptWrapper: true}
+	tc := &testcase.TestCase{ID: "c1", Input: map[string]any{"name": "Bob"}}
+
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if want := "Hello Bob"; gotText != want {
+		t.Fatalf("prompt text: got %q want %q", gotText, want)
+	}
+}
+
+func TestRunCase_Context(t *testing.T) {
+	t.Parallel()
+
+	var gotText string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		b, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		_ = json.Unmarshal(b, &req)
+		msgs, _ := req["messages"].([]any)
+		m0, _ := msgs[0].(map[string]any)
+		m0c, _ := m0["content"].([]any)
+		b0, _ := m0c[0].(map[string]any)
+		gotText, _ = b0["text"].(string)
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(messageResponse("msg_1", req["model"].(string), "end_turn", []map[string]any{textBlock("ok")}, 1, 2))
+	}))
+	t.Cleanup(srv.Close)
+
+	provider := llm.NewClaudeProvider("k", srv.URL+"/v1", "")
+	reg := evaluator.NewRegistry()
+
+	r := NewRunner(provider, reg, Config{
+		Trials:        1,
+		PassThreshold: 1,
+		Concurrency:   1,
+		Timeout:       2 * time.Second,
+		Context:       map[string]any{"tenant_id": "acme", "name": "FromContext"},
+	})
+
+	p := &prompt.Prompt{Name: "p", Template: "Hello {{.name}} ({{.tenant_id}})"}
+	tc := &testcase.TestCase{ID: "c1", Input: map[string]any{"name": "Bob"}}
+
+	if _, err := r.RunCase(context.Background(), p, tc); err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if want := "Hello Bob (acme)"; gotText != want {
+		t.Fatalf("prompt text: got %q want %q", gotText, want)
+	}
+}
+
 func TestRunCase_MultipleTrials_PassMetrics(t *testing.T) {
 	t.Parallel()
 
@@ -189,11 +321,81 @@ func TestRunCase_MultipleTrials_PassMetrics(t *testing.T) {
 	if diff := abs(got.PassExpK - wantPassExpK); diff > eps {
 		t.Fatalf("PassExpK: got %v want %v (diff=%v)", got.PassExpK, wantPassExpK, diff)
 	}
+	if diff := abs(got.TrialPassRate - (2.0 / 3.0)); diff > eps {
+		t.Fatalf("TrialPassRate: got %v want %v", got.TrialPassRate, 2.0/3.0)
+	}
+	if diff := abs(got.TrialAgreement - (2.0 / 3.0)); diff > eps {
+		t.Fatalf("TrialAgreement: got %v want %v", got.TrialAgreement, 2.0/3.0)
+	}
 	if diff := abs(got.Score - (2.0 / 3.0)); diff > eps {
 		t.Fatalf("Score: got %v want %v", got.Score, 2.0/3.0)
 	}
 }
 
+func TestRunCase_ConsistencyEvaluator(t *testing.T) {
+	t.Parallel()
+
+	var callNum int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt64(&callNum, 1)
+
+		text := "ok"
+		if n == 3 {
+			text = "different"
+		}
+
+		w.Header().Set("content-type", "application/json")
+		_ = json.NewEncoder(w).Encode(messageResponse(
+			"msg_x",
+			"m",
+			"end_turn",
+			[]map[string]any{textBlock(text)},
+			1,
+			1,
+		))
+	}))
+	t.Cleanup(srv.Close)
+
+	provider := llm.NewClaudeProvider("k", srv.URL+"/v1", "")
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ConsistencyEvaluator{})
+
+	r := NewRunner(provider, reg, Config{
+		Trials:        3,
+		PassThreshold: 0,
+		Concurrency:   1,
+	})
+
+	p := &prompt.Prompt{Name: "p", Template: "x"}
+	tc := &testcase.TestCase{
+		ID:    "c1",
+		Input: map[string]any{},
+		Evaluators: []testcase.EvaluatorConfig{
+			{Type: "consistency"},
+		},
+	}
+
+	got, err := r.RunCase(context.Background(), p, tc)
+	if err != nil {
+		t.Fatalf("RunCase: %v", err)
+	}
+	if len(got.Trials) != 3 {
+		t.Fatalf("len(Trials): got %d want %d", len(got.Trials), 3)
+	}
+	for i, tr := range got.Trials {
+		if tr.Passed {
+			t.Fatalf("Trials[%d].Passed: got true want false (inconsistent responses)", i)
+		}
+		if len(tr.Evaluations) != 1 || tr.Evaluations[0].Message == "" {
+			t.Fatalf("Trials[%d].Evaluations: got %#v", i, tr.Evaluations)
+		}
+	}
+	if got.Score != 0 {
+		t.Fatalf("Score: got %v want 0", got.Score)
+	}
+}
+
 func TestRunSuite_ConcurrencyLimit(t *testing.T) {
 	t.Parallel()
 
@@ -293,6 +495,119 @@ func TestRunSuite_ConcurrencyLimit(t *testing.T) {
 	}
 }
 
+// gatedEvaluator blocks in Evaluate until gate is closed, signalling on
+// started first so a test can observe how many calls are in flight.
+type gatedEvaluator struct {
+	name    string
+	started chan struct{}
+	gate    <-chan struct{}
+}
+
+func (g *gatedEvaluator) Name() string { return g.name }
+
+func (g *gatedEvaluator) Evaluate(ctx context.Context, response string, expected any) (*evaluator.Result, error) {
+	g.started <- struct{}{}
+	<-g.gate
+	return &evaluator.Result{Passed: true, Score: 1}, nil
+}
+
+func TestEvaluateTrial_EvaluatorConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 16)
+	gate := make(chan struct{})
+
+	reg := evaluator.NewRegistry()
+	reg.Register(&gatedEvaluator{name: "llm_judge", started: started, gate: gate})
+
+	r := NewRunner(nil, reg, Config{
+		Trials:               1,
+		Concurrency:          4,
+		EvaluatorConcurrency: 1,
+	})
+
+	tc := &testcase.TestCase{
+		ID: "c1",
+		Evaluators: []testcase.EvaluatorConfig{
+			{Type: "llm_judge", Criteria: "a"},
+			{Type: "llm_judge", Criteria: "b"},
+		},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.evaluateTrial(context.Background(), tc, "", "resp", nil, nil, 0, 0, nil, nil)
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		close(gate)
+		t.Fatalf("first evaluator did not start")
+	}
+
+	select {
+	case <-started:
+		close(gate)
+		t.Fatalf("second evaluator started concurrently despite EvaluatorConcurrency=1")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(gate)
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("evaluateTrial did not finish")
+	}
+}
+
+func TestEvaluateResponse_ScoresWithoutProvider(t *testing.T) {
+	t.Parallel()
+
+	reg := evaluator.NewRegistry()
+	reg.Register(evaluator.ExactEvaluator{})
+	reg.Register(evaluator.ContainsEvaluator{})
+
+	r := NewRunner(nil, reg, Config{Trials: 1, Concurrency: 1})
+
+	tc := &testcase.TestCase{
+		ID: "c1",
+		Expected: testcase.Expected{
+			ExactMatch: "hello world",
+			Contains:   []string{"hello"},
+		},
+	}
+
+	results, passed, score := r.EvaluateResponse(context.Background(), tc, "hello world")
+	if !passed {
+		t.Fatalf("expected passed=true, got false (results=%+v)", results)
+	}
+	if score != 1 {
+		t.Fatalf("expected score=1, got %v", score)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 evaluator results, got %d", len(results))
+	}
+}
+
+func TestEvaluateResponse_NilRunnerOrTestCase(t *testing.T) {
+	t.Parallel()
+
+	var r *Runner
+	results, passed, score := r.EvaluateResponse(context.Background(), &testcase.TestCase{}, "resp")
+	if passed || score != 0 || len(results) != 1 {
+		t.Fatalf("expected a single failed result for nil runner, got %+v/%v/%v", results, passed, score)
+	}
+
+	r = NewRunner(nil, evaluator.NewRegistry(), Config{Trials: 1, Concurrency: 1})
+	results, passed, score = r.EvaluateResponse(context.Background(), nil, "resp")
+	if passed || score != 0 || len(results) != 1 {
+		t.Fatalf("expected a single failed result for nil test case, got %+v/%v/%v", results, passed, score)
+	}
+}
+
 func TestRunCase_MultiTurnWithToolMocks(t *testing.T) {
 	t.Parallel()
 
@@ -421,6 +736,9 @@ func TestRunCase_MultiTurnWithToolMocks(t *testing.T) {
 	if got.TokensUsed != 6 {
 		t.Fatalf("TokensUsed: got %d want %d", got.TokensUsed, 6)
 	}
+	if got.PromptTokens != 3 || got.CompletionTokens != 3 {
+		t.Fatalf("PromptTokens/CompletionTokens: got %d/%d want 3/3", got.PromptTokens, got.CompletionTokens)
+	}
 	if atomic.LoadInt64(&calls) != 2 {
 		t.Fatalf("calls: got %d want %d", atomic.LoadInt64(&calls), 2)
 	}
@@ -9,35 +9,120 @@ import (
 
 // Config defines runner behavior and thresholds.
 type Config struct {
-	Trials        int     // Number of trials for non-deterministic evaluation
-	PassThreshold float64 // Threshold for pass@k
-	Concurrency   int     // Max concurrent evaluations
-	Timeout       time.Duration
+	Trials               int     // Number of trials for non-deterministic evaluation
+	PassThreshold        float64 // Threshold for pass@k
+	Concurrency          int     // Max concurrent case/trial generations
+	EvaluatorConcurrency int     // Max concurrent LLM evaluator calls within a trial; 0 uses Concurrency
+	Timeout              time.Duration
+
+	// AdaptiveConcurrency, when set, replaces the fixed Concurrency cap with
+	// an AIMD controller: it starts at Concurrency and grows by one after a
+	// run of consecutive successes, halving instead the moment a rate-limit
+	// or server error is observed, bounded to [MinConcurrency, MaxConcurrency].
+	AdaptiveConcurrency bool
+	MinConcurrency      int // Floor for AdaptiveConcurrency; defaults to 1
+	MaxConcurrency      int // Ceiling for AdaptiveConcurrency; defaults to 4x Concurrency
+
+	// Seed, when non-zero, makes trial execution reproducible: each case
+	// gets an independent PRNG seeded deterministically from Seed and the
+	// case ID, and each trial draws its llm.Request.Seed from that PRNG in
+	// order. Two runs with the same Seed and inputs draw identical
+	// per-trial seeds regardless of goroutine scheduling.
+	Seed int64
+
+	// MaxStepsHardFail, when > 0, is the default hard tool-step gate for
+	// cases that don't set testcase.TestCase.MaxStepsHardFail themselves. A
+	// trial that takes more steps than the effective limit fails outright
+	// with an "exceeded max tool steps" result instead of being scored on
+	// its partial response. 0 disables the gate by default.
+	MaxStepsHardFail int
+
+	// StrictSafety, when set, overrides the configured pass/fail threshold
+	// for safety-category evaluators (toxicity, bias): any nonzero raw score
+	// fails the trial with a distinct safety-gate result, even if the score
+	// still clears the evaluator's own threshold. See evaluateTrial's
+	// strict-safety check.
+	StrictSafety bool
+
+	// ScoreEpsilon, when > 0, treats any evaluator score below this
+	// threshold as exactly 0 after clamping to [0,1]. Absorbs floating-point
+	// noise from LLM judges that return e.g. 0.003 instead of 0, which would
+	// otherwise skew SuiteResult.AvgScore without ever affecting pass/fail.
+	// 0 (default) disables the transform.
+	ScoreEpsilon float64
+
+	// PromptWrapper, when non-empty, is applied via prompt.Wrap to every
+	// prompt's rendered output before it's sent to the model, unless the
+	// prompt sets prompt.Prompt.SkipPromptWrapper. Empty (default) leaves
+	// rendered prompts untouched.
+	PromptWrapper string
+
+	// Context holds run-scoped values (e.g. current_date, tenant_id) merged
+	// into every case's Input before prompt.Render, so a run doesn't need
+	// to repeat the same values in each case. Keys already present in a
+	// case's own Input win over ones from Context. Nil/empty leaves Input
+	// untouched.
+	Context map[string]any
+
+	// OnCaseComplete, when set, is called once per case as soon as its
+	// RunResult is final, i.e. before RunSuite returns rather than after.
+	// RunSuite runs cases concurrently, so OnCaseComplete may be called from
+	// multiple goroutines at once; callers that do I/O (e.g. streaming a
+	// line per case to a file or socket) must synchronize themselves. Nil
+	// (default) disables per-case notifications.
+	OnCaseComplete func(RunResult)
+
+	// FailFast, when set, cancels the rest of the suite as soon as any case
+	// finishes with Passed == false: cases already in flight are aborted and
+	// any case that hasn't started yet is reported as RunResult.Skipped
+	// instead of being run.
+	FailFast bool
 }
 
 // RunResult reports results for a single test case.
 type RunResult struct {
-	Suite      string
-	CaseID     string
-	Passed     bool
-	Score      float64
-	Trials     []TrialResult
-	PassAtK    float64 // At least one pass in k trials
-	PassExpK   float64 // All k trials pass
-	LatencyMs  int64
-	TokensUsed int
-	Error      error
+	Suite            string
+	CaseID           string
+	Passed           bool
+	Score            float64
+	Trials           []TrialResult
+	TrialPassRate    float64 // Raw fraction of trials that passed (passedTrials / len(Trials)), distinct from the PassAtK/PassExpK gate
+	TrialAgreement   float64 // Fraction of trials sharing the majority pass/fail outcome; 1.0 means every trial agreed. 0 trials reports 1.0 (nothing to disagree on)
+	PassAtK          float64 // At least one pass in k trials
+	PassExpK         float64 // All k trials pass
+	LatencyMs        int64
+	TokensUsed       int
+	PromptTokens     int // Sum of TrialResult.PromptTokens across trials
+	CompletionTokens int // Sum of TrialResult.CompletionTokens across trials
+	Error            error
+
+	// Skipped is true if this case never ran because Config.FailFast had
+	// already canceled the suite over an earlier case's failure. Distinct
+	// from Error, which reports a case that started (or that the ambient
+	// ctx/suite timeout cut off) rather than one skipped by design.
+	Skipped bool
+
+	// RenderedSystem and RenderedUser are the system/user message content
+	// actually sent to the provider, captured from the first trial that
+	// rendered successfully (identical across trials for a given case
+	// since the template and input don't change between trials). Empty if
+	// no trial reached rendering, e.g. because the template failed to
+	// parse.
+	RenderedSystem string
+	RenderedUser   string
 }
 
 // TrialResult reports the outcome of a single trial.
 type TrialResult struct {
-	TrialNum    int
-	Response    string
-	ToolCalls   []llm.ToolUse
-	Evaluations []evaluator.Result
-	Passed      bool
-	Score       float64
-	LatencyMs   int64
+	TrialNum         int
+	Response         string
+	ToolCalls        []llm.ToolUse
+	Evaluations      []evaluator.Result
+	Passed           bool
+	Score            float64
+	LatencyMs        int64
+	PromptTokens     int
+	CompletionTokens int
 }
 
 // SuiteResult aggregates results for a test suite.
@@ -46,9 +131,42 @@ type SuiteResult struct {
 	TotalCases   int
 	PassedCases  int
 	FailedCases  int
+	SkippedCases int // Cases not run because Config.FailFast canceled the suite; excluded from FailedCases
 	PassRate     float64
 	AvgScore     float64
+	AvgAgreement float64 // Mean of per-case TrialAgreement, across cases with more than one trial
 	TotalLatency int64
 	TotalTokens  int
 	Results      []RunResult
+
+	// TimedOut is true if the suite's TestSuite.Timeout elapsed before every
+	// case finished; any cases still pending at that point were filled with a
+	// deadline-exceeded error rather than actually run.
+	TimedOut bool
+
+	// SchemaConformance reports how well every trial response in the suite
+	// conforms to TestSuite.OutputSchema, or nil if the suite has no
+	// OutputSchema set.
+	SchemaConformance *SchemaConformance
+}
+
+// SchemaConformance aggregates TestSuite.OutputSchema validation across
+// every trial response in a suite.
+type SchemaConformance struct {
+	TotalResponses      int
+	ConformingResponses int
+	ConformanceRate     float64
+
+	// WorstOffenders lists the first maxSchemaViolations non-conforming
+	// responses encountered, in case order, for spot-checking; it isn't
+	// exhaustive once TotalResponses-ConformingResponses exceeds that cap.
+	WorstOffenders []SchemaViolation
+}
+
+// SchemaViolation is a single trial response that failed to conform to
+// TestSuite.OutputSchema.
+type SchemaViolation struct {
+	CaseID   string
+	TrialNum int
+	Message  string
 }
@@ -0,0 +1,160 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// migration is a single ordered schema change, applied at most once per
+// database and tracked in the schema_version table. Add new migrations by
+// appending to schemaMigrations with the next sequential version — never
+// edit or reorder an existing entry, since its statements may already have
+// run against a live store.
+type migration struct {
+	version int
+	stmts   []string
+}
+
+// schemaMigrations lists every schema migration in the order they must be
+// applied.
+var schemaMigrations = []migration{
+	{
+		version: 1,
+		stmts: []string{
+			`CREATE TABLE IF NOT EXISTS runs (
+				id TEXT PRIMARY KEY,
+				started_at INTEGER NOT NULL,
+				finished_at INTEGER NOT NULL,
+				total_suites INTEGER NOT NULL,
+				passed_suites INTEGER NOT NULL,
+				failed_suites INTEGER NOT NULL,
+				config_json TEXT
+			)`,
+			`CREATE TABLE IF NOT EXISTS suite_results (
+				id TEXT PRIMARY KEY,
+				run_id TEXT NOT NULL,
+				prompt_name TEXT NOT NULL,
+				prompt_version TEXT NOT NULL,
+				suite_name TEXT NOT NULL,
+				total_cases INTEGER NOT NULL,
+				passed_cases INTEGER NOT NULL,
+				failed_cases INTEGER NOT NULL,
+				pass_rate REAL NOT NULL,
+				avg_score REAL NOT NULL,
+				total_latency INTEGER NOT NULL,
+				total_tokens INTEGER NOT NULL,
+				created_at INTEGER NOT NULL,
+				case_results BLOB NOT NULL,
+				FOREIGN KEY(run_id) REFERENCES runs(id) ON DELETE CASCADE
+			)`,
+			`CREATE INDEX IF NOT EXISTS idx_suite_results_run_id ON suite_results(run_id)`,
+			`CREATE INDEX IF NOT EXISTS idx_suite_results_prompt ON suite_results(prompt_name, prompt_version)`,
+			`CREATE INDEX IF NOT EXISTS idx_suite_results_created_at ON suite_results(created_at)`,
+		},
+	},
+	{
+		version: 2,
+		stmts: []string{
+			`ALTER TABLE suite_results ADD COLUMN metadata TEXT NOT NULL DEFAULT '{}'`,
+		},
+	},
+	{
+		version: 3,
+		stmts: []string{
+			`ALTER TABLE suite_results ADD COLUMN skipped_cases INTEGER NOT NULL DEFAULT 0`,
+		},
+	},
+	{
+		version: 4,
+		stmts: []string{
+			`CREATE TABLE IF NOT EXISTS redteam_results (
+				id TEXT PRIMARY KEY,
+				run_id TEXT NOT NULL,
+				prompt_name TEXT NOT NULL,
+				prompt_version TEXT NOT NULL,
+				category TEXT NOT NULL,
+				attack TEXT NOT NULL,
+				score REAL NOT NULL,
+				passed INTEGER NOT NULL,
+				created_at INTEGER NOT NULL,
+				FOREIGN KEY(run_id) REFERENCES runs(id) ON DELETE CASCADE
+			)`,
+			`CREATE INDEX IF NOT EXISTS idx_redteam_results_run_id ON redteam_results(run_id)`,
+			`CREATE INDEX IF NOT EXISTS idx_redteam_results_prompt ON redteam_results(prompt_name, prompt_version)`,
+		},
+	},
+}
+
+// currentSchemaVersion is the schema version this binary produces. Opening a
+// store whose schema_version is higher fails rather than risking silent
+// misreads of columns this binary doesn't know about.
+var currentSchemaVersion = schemaMigrations[len(schemaMigrations)-1].version
+
+// ErrSchemaTooNew is returned when a store's schema_version is newer than
+// this binary supports.
+var ErrSchemaTooNew = errors.New("store: schema is newer than this binary supports")
+
+// migrateSQLite creates the schema_version table if needed and applies every
+// migration newer than the store's current version, each in its own
+// transaction. It returns the version before and after migration.
+func migrateSQLite(db *sql.DB) (fromVersion, toVersion int, err error) {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_version (
+		version INTEGER NOT NULL,
+		applied_at INTEGER NOT NULL
+	)`); err != nil {
+		return 0, 0, fmt.Errorf("store: create schema_version table: %w", err)
+	}
+
+	from, err := sqliteSchemaVersion(db)
+	if err != nil {
+		return 0, 0, err
+	}
+	if from > currentSchemaVersion {
+		return from, from, fmt.Errorf("%w: store is at version %d, this binary supports up to %d", ErrSchemaTooNew, from, currentSchemaVersion)
+	}
+
+	applied := from
+	for _, m := range schemaMigrations {
+		if m.version <= from {
+			continue
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			return from, applied, fmt.Errorf("store: migrate to version %d: %w", m.version, err)
+		}
+		if err := applyMigration(tx, m); err != nil {
+			_ = tx.Rollback()
+			return from, applied, err
+		}
+		if err := tx.Commit(); err != nil {
+			return from, applied, fmt.Errorf("store: migrate to version %d: %w", m.version, err)
+		}
+		applied = m.version
+	}
+
+	return from, applied, nil
+}
+
+func applyMigration(tx *sql.Tx, m migration) error {
+	for _, stmt := range m.stmts {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("store: migrate to version %d: %w", m.version, err)
+		}
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_version (version, applied_at) VALUES (?, ?)`, m.version, time.Now().UTC().Unix()); err != nil {
+		return fmt.Errorf("store: migrate to version %d: %w", m.version, err)
+	}
+	return nil
+}
+
+func sqliteSchemaVersion(db *sql.DB) (int, error) {
+	row := db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_version`)
+	var v int
+	if err := row.Scan(&v); err != nil {
+		return 0, fmt.Errorf("store: read schema_version: %w", err)
+	}
+	return v, nil
+}
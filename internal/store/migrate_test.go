@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMigrateSQLite_LegacyDatabaseWithoutSchemaVersion(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "legacy.db")
+
+	db, err := sqliteOpen("sqlite3", path)
+	if err != nil {
+		t.Fatalf("sqliteOpen: %v", err)
+	}
+	if _, err := db.Exec(`CREATE TABLE runs (
+		id TEXT PRIMARY KEY,
+		started_at INTEGER NOT NULL,
+		finished_at INTEGER NOT NULL,
+		total_suites INTEGER NOT NULL,
+		passed_suites INTEGER NOT NULL,
+		failed_suites INTEGER NOT NULL,
+		config_json TEXT
+	)`); err != nil {
+		t.Fatalf("create legacy runs table: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO runs (id, started_at, finished_at, total_suites, passed_suites, failed_suites) VALUES (?, ?, ?, ?, ?, ?)`,
+		"run_legacy", 1, 2, 1, 1, 0); err != nil {
+		t.Fatalf("seed legacy run: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("close legacy db: %v", err)
+	}
+
+	from, to, err := MigrateSQLite(path)
+	if err != nil {
+		t.Fatalf("MigrateSQLite: %v", err)
+	}
+	if from != 0 {
+		t.Fatalf("from: got %d want 0", from)
+	}
+	if to != currentSchemaVersion {
+		t.Fatalf("to: got %d want %d", to, currentSchemaVersion)
+	}
+
+	st, err := NewSQLiteStore(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	defer st.Close()
+
+	got, err := st.GetRun(context.Background(), "run_legacy")
+	if err != nil {
+		t.Fatalf("GetRun: %v", err)
+	}
+	if got.ID != "run_legacy" {
+		t.Fatalf("GetRun preserved data: got %#v", got)
+	}
+
+	if err := st.SaveSuiteResult(context.Background(), &SuiteRecord{
+		ID:            "suite_legacy",
+		RunID:         "run_legacy",
+		PromptName:    "p1",
+		PromptVersion: "v1",
+		SuiteName:     "s1",
+		CreatedAt:     time.Unix(3, 0).UTC(),
+	}); err != nil {
+		t.Fatalf("SaveSuiteResult after migration: %v", err)
+	}
+}
+
+func TestMigrateSQLite_AlreadyCurrent(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "current.db")
+	st, err := NewSQLiteStore(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	st.Close()
+
+	from, to, err := MigrateSQLite(path)
+	if err != nil {
+		t.Fatalf("MigrateSQLite: %v", err)
+	}
+	if from != currentSchemaVersion || to != currentSchemaVersion {
+		t.Fatalf("from/to: got %d/%d want %d/%d", from, to, currentSchemaVersion, currentSchemaVersion)
+	}
+}
+
+func TestMigrateSQLite_RefusesNewerSchema(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "future.db")
+
+	db, err := sqliteOpen("sqlite3", path)
+	if err != nil {
+		t.Fatalf("sqliteOpen: %v", err)
+	}
+	if _, _, err := migrateSQLite(db); err != nil {
+		t.Fatalf("migrateSQLite: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO schema_version (version, applied_at) VALUES (?, ?)`, currentSchemaVersion+1, 0); err != nil {
+		t.Fatalf("seed future version: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("close db: %v", err)
+	}
+
+	if _, err := NewSQLiteStore(path); err == nil || !errors.Is(err, ErrSchemaTooNew) {
+		t.Fatalf("NewSQLiteStore: got err %v, want ErrSchemaTooNew", err)
+	}
+
+	if _, _, err := MigrateSQLite(path); err == nil || !errors.Is(err, ErrSchemaTooNew) {
+		t.Fatalf("MigrateSQLite: got err %v, want ErrSchemaTooNew", err)
+	}
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/stellarlinkco/ai-eval/internal/config"
@@ -19,17 +21,83 @@ func Open(cfg *config.Config) (Store, error) {
 		storageType = "sqlite"
 	}
 
+	var (
+		st  *SQLiteStore
+		err error
+	)
 	switch storageType {
 	case "sqlite":
 		path := strings.TrimSpace(cfg.Storage.Path)
 		if path == "" {
 			path = DefaultSQLitePath
 		}
-		return NewSQLiteStore(path)
+		st, err = NewSQLiteStore(path)
 	case "memory":
-		return NewSQLiteStore(":memory:")
+		st, err = NewSQLiteStore(":memory:")
 	default:
 		return nil, fmt.Errorf("store: unsupported type %q", storageType)
 	}
+	if err != nil {
+		return nil, err
+	}
+	st.SetRedactor(cfg.Redactor())
+	return st, nil
 }
 
+// Migrate applies any pending schema migrations to the store configured by
+// cfg and returns the schema version before and after migration.
+func Migrate(cfg *config.Config) (fromVersion, toVersion int, err error) {
+	if cfg == nil {
+		return 0, 0, fmt.Errorf("store: missing config")
+	}
+
+	storageType := strings.ToLower(strings.TrimSpace(cfg.Storage.Type))
+	if storageType == "" {
+		storageType = "sqlite"
+	}
+
+	switch storageType {
+	case "sqlite":
+		path := strings.TrimSpace(cfg.Storage.Path)
+		if path == "" {
+			path = DefaultSQLitePath
+		}
+		return MigrateSQLite(path)
+	case "memory":
+		return MigrateSQLite(":memory:")
+	default:
+		return 0, 0, fmt.Errorf("store: unsupported type %q", storageType)
+	}
+}
+
+// MigrateSQLite opens the sqlite database at path, applies any pending
+// schema migrations, and returns the schema version before and after
+// migration.
+func MigrateSQLite(path string) (fromVersion, toVersion int, err error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return 0, 0, fmt.Errorf("store: empty sqlite path")
+	}
+	if path != ":memory:" {
+		dir := filepath.Dir(path)
+		if dir != "." && dir != "" {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return 0, 0, fmt.Errorf("store: create sqlite dir: %w", err)
+			}
+		}
+	}
+
+	db, err := sqliteOpen("sqlite3", path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("store: open sqlite: %w", err)
+	}
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		return 0, 0, fmt.Errorf("store: ping sqlite: %w", err)
+	}
+	if err := runSQLitePragmas(db); err != nil {
+		return 0, 0, err
+	}
+	return migrateSQLite(db)
+}
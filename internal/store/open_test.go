@@ -36,6 +36,26 @@ func TestOpen_ErrorsAndTypes(t *testing.T) {
 	}
 }
 
+func TestOpen_WiresRedactorFromConfig(t *testing.T) {
+	cfg := &config.Config{
+		Storage:   config.StorageConfig{Type: "memory"},
+		Redaction: config.RedactionConfig{KeyPatterns: []string{"internal_id"}},
+	}
+	st, err := Open(cfg)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { _ = st.Close() })
+
+	sqliteStore, ok := st.(*SQLiteStore)
+	if !ok {
+		t.Fatalf("Open: got %T want *SQLiteStore", st)
+	}
+	if !sqliteStore.redactor.KeyMatches("internal_id") {
+		t.Fatalf("Open: expected configured key pattern to be wired into the store's redactor")
+	}
+}
+
 func TestOpen_DefaultSQLitePath(t *testing.T) {
 	oldWd, err := os.Getwd()
 	if err != nil {
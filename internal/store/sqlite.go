@@ -13,18 +13,22 @@ import (
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/stellarlinkco/ai-eval/internal/redact"
 )
 
 const defaultHistoryLimit = 50
 
 // SQLiteStore implements Store using SQLite.
 type SQLiteStore struct {
-	db *sql.DB
+	db       *sql.DB
+	redactor *redact.Redactor
 
 	insertRunStmt                *sql.Stmt
 	insertSuiteStmt              *sql.Stmt
+	insertRedteamStmt            *sql.Stmt
 	getRunStmt                   *sql.Stmt
 	suitesByRunStmt              *sql.Stmt
+	redteamByRunStmt             *sql.Stmt
 	promptHistoryStmt            *sql.Stmt
 	latestRunByPromptVersionStmt *sql.Stmt
 	suitesByRunPromptVersionStmt *sql.Stmt
@@ -62,12 +66,16 @@ func NewSQLiteStore(path string) (*SQLiteStore, error) {
 		return nil, fmt.Errorf("store: ping sqlite: %w", err)
 	}
 
-	if err := initSQLiteSchema(db); err != nil {
+	if err := runSQLitePragmas(db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if _, _, err := migrateSQLite(db); err != nil {
 		_ = db.Close()
 		return nil, err
 	}
 
-	st := &SQLiteStore{db: db}
+	st := &SQLiteStore{db: db, redactor: redact.Default()}
 	if err := sqlitePrepareStatements(st); err != nil {
 		_ = st.Close()
 		return nil, err
@@ -75,44 +83,28 @@ func NewSQLiteStore(path string) (*SQLiteStore, error) {
 	return st, nil
 }
 
-func initSQLiteSchema(db *sql.DB) error {
+// SetRedactor overrides the Redactor used to mask stored run config before
+// it's persisted. Callers with a config.Config should use
+// config.Config.Redactor (see internal/store.Open); a nil redactor resets
+// to redact.Default().
+func (s *SQLiteStore) SetRedactor(r *redact.Redactor) {
+	if s == nil {
+		return
+	}
+	if r == nil {
+		r = redact.Default()
+	}
+	s.redactor = r
+}
+
+func runSQLitePragmas(db *sql.DB) error {
 	stmts := []string{
 		`PRAGMA foreign_keys = ON`,
 		`PRAGMA journal_mode = WAL`,
-		`CREATE TABLE IF NOT EXISTS runs (
-			id TEXT PRIMARY KEY,
-			started_at INTEGER NOT NULL,
-			finished_at INTEGER NOT NULL,
-			total_suites INTEGER NOT NULL,
-			passed_suites INTEGER NOT NULL,
-			failed_suites INTEGER NOT NULL,
-			config_json TEXT
-		)`,
-		`CREATE TABLE IF NOT EXISTS suite_results (
-			id TEXT PRIMARY KEY,
-			run_id TEXT NOT NULL,
-			prompt_name TEXT NOT NULL,
-			prompt_version TEXT NOT NULL,
-			suite_name TEXT NOT NULL,
-			total_cases INTEGER NOT NULL,
-			passed_cases INTEGER NOT NULL,
-			failed_cases INTEGER NOT NULL,
-			pass_rate REAL NOT NULL,
-			avg_score REAL NOT NULL,
-			total_latency INTEGER NOT NULL,
-			total_tokens INTEGER NOT NULL,
-			created_at INTEGER NOT NULL,
-			case_results BLOB NOT NULL,
-			FOREIGN KEY(run_id) REFERENCES runs(id) ON DELETE CASCADE
-		)`,
-		`CREATE INDEX IF NOT EXISTS idx_suite_results_run_id ON suite_results(run_id)`,
-		`CREATE INDEX IF NOT EXISTS idx_suite_results_prompt ON suite_results(prompt_name, prompt_version)`,
-		`CREATE INDEX IF NOT EXISTS idx_suite_results_created_at ON suite_results(created_at)`,
 	}
-
 	for _, stmt := range stmts {
 		if _, err := db.Exec(stmt); err != nil {
-			return fmt.Errorf("store: init schema: %w", err)
+			return fmt.Errorf("store: set pragma: %w", err)
 		}
 	}
 	return nil
@@ -145,11 +137,20 @@ func (s *SQLiteStore) prepareStatements() error {
 			query: `
 				INSERT INTO suite_results (
 					id, run_id, prompt_name, prompt_version, suite_name, total_cases, passed_cases,
-					failed_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results
-				) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+					failed_cases, skipped_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results, metadata
+				) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 			`,
 			errFmt: "store: prepare insert suite: %w",
 		},
+		{
+			dst: &s.insertRedteamStmt,
+			query: `
+				INSERT INTO redteam_results (
+					id, run_id, prompt_name, prompt_version, category, attack, score, passed, created_at
+				) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			`,
+			errFmt: "store: prepare insert redteam result: %w",
+		},
 		{
 			dst: &s.getRunStmt,
 			query: `
@@ -162,18 +163,28 @@ func (s *SQLiteStore) prepareStatements() error {
 			dst: &s.suitesByRunStmt,
 			query: `
 				SELECT id, run_id, prompt_name, prompt_version, suite_name, total_cases, passed_cases,
-					failed_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results
+					failed_cases, skipped_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results, metadata
 				FROM suite_results
 				WHERE run_id = ?
 				ORDER BY created_at ASC, suite_name ASC
 			`,
 			errFmt: "store: prepare get suites: %w",
 		},
+		{
+			dst: &s.redteamByRunStmt,
+			query: `
+				SELECT id, run_id, prompt_name, prompt_version, category, attack, score, passed, created_at
+				FROM redteam_results
+				WHERE run_id = ?
+				ORDER BY category ASC, id ASC
+			`,
+			errFmt: "store: prepare get redteam results: %w",
+		},
 		{
 			dst: &s.promptHistoryStmt,
 			query: `
 				SELECT id, run_id, prompt_name, prompt_version, suite_name, total_cases, passed_cases,
-					failed_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results
+					failed_cases, skipped_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results, metadata
 				FROM suite_results
 				WHERE prompt_name = ?
 				ORDER BY created_at DESC
@@ -195,7 +206,7 @@ func (s *SQLiteStore) prepareStatements() error {
 			dst: &s.suitesByRunPromptVersionStmt,
 			query: `
 				SELECT id, run_id, prompt_name, prompt_version, suite_name, total_cases, passed_cases,
-					failed_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results
+					failed_cases, skipped_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results, metadata
 				FROM suite_results
 				WHERE run_id = ? AND prompt_name = ? AND prompt_version = ?
 				ORDER BY created_at ASC, suite_name ASC
@@ -223,8 +234,10 @@ func (s *SQLiteStore) Close() error {
 	stmts := []*sql.Stmt{
 		s.insertRunStmt,
 		s.insertSuiteStmt,
+		s.insertRedteamStmt,
 		s.getRunStmt,
 		s.suitesByRunStmt,
+		s.redteamByRunStmt,
 		s.promptHistoryStmt,
 		s.latestRunByPromptVersionStmt,
 		s.suitesByRunPromptVersionStmt,
@@ -263,7 +276,7 @@ func (s *SQLiteStore) SaveRun(ctx context.Context, run *RunRecord) error {
 	cfgJSON := []byte("null")
 	if run.Config != nil {
 		var err error
-		cfgJSON, err = json.Marshal(run.Config)
+		cfgJSON, err = json.Marshal(s.redactor.Map(run.Config))
 		if err != nil {
 			return fmt.Errorf("store: marshal run config: %w", err)
 		}
@@ -332,6 +345,11 @@ func (s *SQLiteStore) SaveSuiteResult(ctx context.Context, result *SuiteRecord)
 		return fmt.Errorf("store: marshal case results: %w", err)
 	}
 
+	metadataJSON, err := json.Marshal(result.Metadata)
+	if err != nil {
+		return fmt.Errorf("store: marshal metadata: %w", err)
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("store: begin suite tx: %w", err)
@@ -353,12 +371,14 @@ func (s *SQLiteStore) SaveSuiteResult(ctx context.Context, result *SuiteRecord)
 		result.TotalCases,
 		result.PassedCases,
 		result.FailedCases,
+		result.SkippedCases,
 		result.PassRate,
 		result.AvgScore,
 		result.TotalLatency,
 		result.TotalTokens,
 		createdAt.UTC().UnixMilli(),
 		caseJSON,
+		metadataJSON,
 	)
 	if err != nil {
 		return fmt.Errorf("store: insert suite result: %w", err)
@@ -369,6 +389,148 @@ func (s *SQLiteStore) SaveSuiteResult(ctx context.Context, result *SuiteRecord)
 	return nil
 }
 
+// SaveRedteamResult persists a single redteam attack result.
+func (s *SQLiteStore) SaveRedteamResult(ctx context.Context, result *RedteamRecord) error {
+	if s == nil {
+		return errors.New("store: nil sqlite store")
+	}
+	if ctx == nil {
+		return errors.New("store: nil context")
+	}
+	if result == nil {
+		return errors.New("store: nil redteam result")
+	}
+
+	id := strings.TrimSpace(result.ID)
+	if id == "" {
+		return errors.New("store: empty redteam result id")
+	}
+	if strings.TrimSpace(result.RunID) == "" {
+		return errors.New("store: empty run id")
+	}
+	if strings.TrimSpace(result.PromptName) == "" || strings.TrimSpace(result.Category) == "" {
+		return errors.New("store: missing prompt name/category")
+	}
+
+	createdAt := result.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("store: begin redteam result tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	stmt := tx.StmtContext(ctx, s.insertRedteamStmt)
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		id,
+		result.RunID,
+		result.PromptName,
+		result.PromptVersion,
+		result.Category,
+		result.Attack,
+		result.Score,
+		result.Passed,
+		createdAt.UTC().UnixMilli(),
+	)
+	if err != nil {
+		return fmt.Errorf("store: insert redteam result: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("store: commit redteam result: %w", err)
+	}
+	return nil
+}
+
+// prunableRunsWhere selects runs eligible for deletion: started before the
+// cutoff and not among the keepLast most recent runs. Shared between
+// PruneRuns and CountPrunableRuns so a dry run reports exactly what a real
+// prune would delete.
+const prunableRunsWhere = `started_at < ? AND id NOT IN (SELECT id FROM runs ORDER BY started_at DESC LIMIT ?)`
+
+// PruneRuns deletes runs (and their suite_results and redteam_results) older
+// than cutoff, always keeping the most recent keepLast runs. Child rows are
+// deleted before their parent runs row in the same transaction, so a crash
+// mid-prune can't leave orphans even if a connection's foreign_keys pragma
+// isn't enforcing the cascade.
+func (s *SQLiteStore) PruneRuns(ctx context.Context, before time.Time, keepLast int) (int, error) {
+	if s == nil {
+		return 0, errors.New("store: nil sqlite store")
+	}
+	if ctx == nil {
+		return 0, errors.New("store: nil context")
+	}
+	if keepLast < 0 {
+		return 0, errors.New("store: keepLast must be >= 0")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("store: begin prune tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	beforeMS := before.UTC().UnixMilli()
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM suite_results WHERE run_id IN (SELECT id FROM runs WHERE `+prunableRunsWhere+`)`,
+		beforeMS, keepLast,
+	); err != nil {
+		return 0, fmt.Errorf("store: prune suite results: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM redteam_results WHERE run_id IN (SELECT id FROM runs WHERE `+prunableRunsWhere+`)`,
+		beforeMS, keepLast,
+	); err != nil {
+		return 0, fmt.Errorf("store: prune redteam results: %w", err)
+	}
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM runs WHERE `+prunableRunsWhere, beforeMS, keepLast)
+	if err != nil {
+		return 0, fmt.Errorf("store: prune runs: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("store: prune runs rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("store: commit prune: %w", err)
+	}
+	return int(n), nil
+}
+
+// CountPrunableRuns reports how many runs PruneRuns would delete for the
+// same arguments, without deleting anything.
+func (s *SQLiteStore) CountPrunableRuns(ctx context.Context, before time.Time, keepLast int) (int, error) {
+	if s == nil {
+		return 0, errors.New("store: nil sqlite store")
+	}
+	if ctx == nil {
+		return 0, errors.New("store: nil context")
+	}
+	if keepLast < 0 {
+		return 0, errors.New("store: keepLast must be >= 0")
+	}
+
+	var n int
+	row := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM runs WHERE `+prunableRunsWhere, before.UTC().UnixMilli(), keepLast)
+	if err := row.Scan(&n); err != nil {
+		return 0, fmt.Errorf("store: count prunable runs: %w", err)
+	}
+	return n, nil
+}
+
 // GetRun loads a run by id.
 func (s *SQLiteStore) GetRun(ctx context.Context, id string) (*RunRecord, error) {
 	if s == nil {
@@ -526,6 +688,64 @@ func (s *SQLiteStore) GetSuiteResults(ctx context.Context, runID string) ([]*Sui
 	return scanSuiteRows(rows)
 }
 
+// GetRedteamResults lists redteam attack results for a run, ordered by
+// category then attack id.
+func (s *SQLiteStore) GetRedteamResults(ctx context.Context, runID string) ([]*RedteamRecord, error) {
+	if s == nil {
+		return nil, errors.New("store: nil sqlite store")
+	}
+	if ctx == nil {
+		return nil, errors.New("store: nil context")
+	}
+	runID = strings.TrimSpace(runID)
+	if runID == "" {
+		return nil, errors.New("store: empty run id")
+	}
+
+	rows, err := s.redteamByRunStmt.QueryContext(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("store: get redteam results: %w", err)
+	}
+	defer rows.Close()
+
+	return scanRedteamRows(rows)
+}
+
+func scanRedteamRows(rows *sql.Rows) ([]*RedteamRecord, error) {
+	var out []*RedteamRecord
+	for rows.Next() {
+		var (
+			id            string
+			runID         string
+			promptName    string
+			promptVersion string
+			category      string
+			attack        string
+			score         float64
+			passed        bool
+			createdAtMS   int64
+		)
+		if err := rows.Scan(&id, &runID, &promptName, &promptVersion, &category, &attack, &score, &passed, &createdAtMS); err != nil {
+			return nil, fmt.Errorf("store: scan redteam result: %w", err)
+		}
+		out = append(out, &RedteamRecord{
+			ID:            id,
+			RunID:         runID,
+			PromptName:    promptName,
+			PromptVersion: promptVersion,
+			Category:      category,
+			Attack:        attack,
+			Score:         score,
+			Passed:        passed,
+			CreatedAt:     time.UnixMilli(createdAtMS).UTC(),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("store: scan redteam rows: %w", err)
+	}
+	return out, nil
+}
+
 // GetPromptHistory returns recent suite results for a prompt.
 func (s *SQLiteStore) GetPromptHistory(ctx context.Context, promptName string, limit int) ([]*SuiteRecord, error) {
 	if s == nil {
@@ -599,6 +819,167 @@ func (s *SQLiteStore) GetVersionComparison(ctx context.Context, promptName, v1,
 	}, nil
 }
 
+// GetFlakyCases scans the last window runs of promptName and returns every
+// (suite, case) whose pass/fail outcome changed at least once across them.
+func (s *SQLiteStore) GetFlakyCases(ctx context.Context, promptName string, window int) ([]FlakyCase, error) {
+	if s == nil {
+		return nil, errors.New("store: nil sqlite store")
+	}
+	if ctx == nil {
+		return nil, errors.New("store: nil context")
+	}
+	promptName = strings.TrimSpace(promptName)
+	if promptName == "" {
+		return nil, errors.New("store: empty prompt name")
+	}
+
+	history, err := s.GetPromptHistory(ctx, promptName, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return flakyCasesFromHistory(history), nil
+}
+
+// flakyCasesFromHistory aggregates CaseRecord outcomes across history (most
+// recent first, as returned by GetPromptHistory) by (suite, case) and
+// reports how often each one's pass/fail outcome flipped between
+// consecutive runs, oldest to newest.
+func flakyCasesFromHistory(history []*SuiteRecord) []FlakyCase {
+	// Walk oldest-to-newest so "flip" means "differs from the previous run".
+	ordered := make([]*SuiteRecord, len(history))
+	copy(ordered, history)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].CreatedAt.Before(ordered[j].CreatedAt) })
+
+	type key struct{ suite, caseID string }
+	type state struct {
+		runs      int
+		flips     int
+		lastKnown bool
+		hasLast   bool
+	}
+	states := make(map[key]*state)
+
+	for _, suite := range ordered {
+		if suite == nil {
+			continue
+		}
+		for _, cr := range suite.CaseResults {
+			k := key{suite: suite.SuiteName, caseID: cr.CaseID}
+			st, ok := states[k]
+			if !ok {
+				st = &state{}
+				states[k] = st
+			}
+			st.runs++
+			if st.hasLast && cr.Passed != st.lastKnown {
+				st.flips++
+			}
+			st.lastKnown = cr.Passed
+			st.hasLast = true
+		}
+	}
+
+	var out []FlakyCase
+	for k, st := range states {
+		if st.flips == 0 {
+			continue
+		}
+		fc := FlakyCase{SuiteName: k.suite, CaseID: k.caseID, Runs: st.runs, Flips: st.flips}
+		if st.runs > 1 {
+			fc.FlipRate = float64(st.flips) / float64(st.runs-1)
+		}
+		out = append(out, fc)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].FlipRate != out[j].FlipRate {
+			return out[i].FlipRate > out[j].FlipRate
+		}
+		if out[i].SuiteName != out[j].SuiteName {
+			return out[i].SuiteName < out[j].SuiteName
+		}
+		return out[i].CaseID < out[j].CaseID
+	})
+
+	return out
+}
+
+// statsBucketFormats maps a supported StatsFilter.Bucket value to the
+// SQLite strftime format used to group created_at into buckets.
+var statsBucketFormats = map[string]string{
+	"day": "%Y-%m-%d",
+}
+
+// AggregateStats groups suite_results into time buckets and averages
+// pass_rate/avg_score per bucket via a single GROUP BY query, so dashboards
+// don't have to pull every run and aggregate client-side.
+func (s *SQLiteStore) AggregateStats(ctx context.Context, filter StatsFilter) ([]StatsBucket, error) {
+	if s == nil {
+		return nil, errors.New("store: nil sqlite store")
+	}
+	if ctx == nil {
+		return nil, errors.New("store: nil context")
+	}
+
+	bucket := strings.TrimSpace(filter.Bucket)
+	if bucket == "" {
+		bucket = "day"
+	}
+	format, ok := statsBucketFormats[bucket]
+	if !ok {
+		return nil, fmt.Errorf("store: unsupported stats bucket %q", bucket)
+	}
+
+	promptName := strings.TrimSpace(filter.PromptName)
+	promptVersion := strings.TrimSpace(filter.PromptVersion)
+
+	var sb strings.Builder
+	sb.WriteString(`SELECT strftime('`)
+	sb.WriteString(format)
+	sb.WriteString(`', created_at / 1000, 'unixepoch') AS bucket,
+		AVG(pass_rate), AVG(avg_score), COUNT(*)
+		FROM suite_results WHERE 1=1`)
+
+	var args []any
+	if promptName != "" {
+		sb.WriteString(` AND prompt_name = ?`)
+		args = append(args, promptName)
+	}
+	if promptVersion != "" {
+		sb.WriteString(` AND prompt_version = ?`)
+		args = append(args, promptVersion)
+	}
+	if !filter.Since.IsZero() {
+		sb.WriteString(` AND created_at >= ?`)
+		args = append(args, filter.Since.UTC().UnixMilli())
+	}
+	if !filter.Until.IsZero() {
+		sb.WriteString(` AND created_at <= ?`)
+		args = append(args, filter.Until.UTC().UnixMilli())
+	}
+	sb.WriteString(` GROUP BY bucket ORDER BY bucket ASC`)
+
+	rows, err := s.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("store: aggregate stats: %w", err)
+	}
+	defer rows.Close()
+
+	var out []StatsBucket
+	for rows.Next() {
+		var b StatsBucket
+		if err := rows.Scan(&b.Bucket, &b.PassRate, &b.AvgScore, &b.TotalRuns); err != nil {
+			return nil, fmt.Errorf("store: scan stats bucket: %w", err)
+		}
+		out = append(out, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("store: aggregate stats: %w", err)
+	}
+	return out, nil
+}
+
 func (s *SQLiteStore) latestRunID(ctx context.Context, promptName, version string) (string, error) {
 	row := s.latestRunByPromptVersionStmt.QueryRowContext(ctx, promptName, version)
 	var runID string
@@ -632,12 +1013,14 @@ func scanSuiteRows(rows *sql.Rows) ([]*SuiteRecord, error) {
 			totalCases    int
 			passedCases   int
 			failedCases   int
+			skippedCases  int
 			passRate      float64
 			avgScore      float64
 			totalLatency  int64
 			totalTokens   int
 			createdAtMS   int64
 			caseJSON      []byte
+			metadataJSON  []byte
 		)
 		if err := rows.Scan(
 			&id,
@@ -648,12 +1031,14 @@ func scanSuiteRows(rows *sql.Rows) ([]*SuiteRecord, error) {
 			&totalCases,
 			&passedCases,
 			&failedCases,
+			&skippedCases,
 			&passRate,
 			&avgScore,
 			&totalLatency,
 			&totalTokens,
 			&createdAtMS,
 			&caseJSON,
+			&metadataJSON,
 		); err != nil {
 			return nil, fmt.Errorf("store: scan suite: %w", err)
 		}
@@ -663,6 +1048,11 @@ func scanSuiteRows(rows *sql.Rows) ([]*SuiteRecord, error) {
 			return nil, fmt.Errorf("store: decode case results: %w", err)
 		}
 
+		metadata, err := decodeMetadata(metadataJSON)
+		if err != nil {
+			return nil, fmt.Errorf("store: decode metadata: %w", err)
+		}
+
 		out = append(out, &SuiteRecord{
 			ID:            id,
 			RunID:         runID,
@@ -672,12 +1062,14 @@ func scanSuiteRows(rows *sql.Rows) ([]*SuiteRecord, error) {
 			TotalCases:    totalCases,
 			PassedCases:   passedCases,
 			FailedCases:   failedCases,
+			SkippedCases:  skippedCases,
 			PassRate:      passRate,
 			AvgScore:      avgScore,
 			TotalLatency:  totalLatency,
 			TotalTokens:   totalTokens,
 			CreatedAt:     time.UnixMilli(createdAtMS).UTC(),
 			CaseResults:   caseResults,
+			Metadata:      metadata,
 		})
 	}
 	if err := rows.Err(); err != nil {
@@ -701,6 +1093,18 @@ func decodeConfig(cfgJSON sql.NullString) (map[string]any, error) {
 	return cfg, nil
 }
 
+func decodeMetadata(metadataJSON []byte) (map[string]string, error) {
+	raw := strings.TrimSpace(string(metadataJSON))
+	if raw == "" || raw == "null" || raw == "{}" {
+		return nil, nil
+	}
+	var out map[string]string
+	if err := json.Unmarshal(metadataJSON, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func decodeCaseResults(caseJSON []byte) ([]CaseRecord, error) {
 	if len(caseJSON) == 0 {
 		return nil, nil
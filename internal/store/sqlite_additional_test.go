@@ -81,8 +81,11 @@ func TestScanRunRows_RowsErr(t *testing.T) {
 	}
 	t.Cleanup(func() { _ = db.Close() })
 
-	if err := initSQLiteSchema(db); err != nil {
-		t.Fatalf("initSQLiteSchema: %v", err)
+	if err := runSQLitePragmas(db); err != nil {
+		t.Fatalf("runSQLitePragmas: %v", err)
+	}
+	if _, _, err := migrateSQLite(db); err != nil {
+		t.Fatalf("migrateSQLite: %v", err)
 	}
 
 	if _, err := db.ExecContext(context.Background(), `
@@ -118,8 +121,11 @@ func TestScanSuiteRows_RowsErr(t *testing.T) {
 	}
 	t.Cleanup(func() { _ = db.Close() })
 
-	if err := initSQLiteSchema(db); err != nil {
-		t.Fatalf("initSQLiteSchema: %v", err)
+	if err := runSQLitePragmas(db); err != nil {
+		t.Fatalf("runSQLitePragmas: %v", err)
+	}
+	if _, _, err := migrateSQLite(db); err != nil {
+		t.Fatalf("migrateSQLite: %v", err)
 	}
 
 	if _, err := db.ExecContext(context.Background(), `
@@ -131,17 +137,17 @@ func TestScanSuiteRows_RowsErr(t *testing.T) {
 	if _, err := db.ExecContext(context.Background(), `
 		INSERT INTO suite_results (
 			id, run_id, prompt_name, prompt_version, suite_name, total_cases, passed_cases, failed_cases,
-			pass_rate, avg_score, total_latency, total_tokens, created_at, case_results
+			skipped_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results
 		) VALUES
-			('s1', 'run', 'p', 'v', 'suite', 0, 0, 0, 0, 0, 0, 0, 1, X'5B5D'),
-			('s2', 'run', 'p', 'v', 'suite', 0, 0, 0, 0, 0, 0, 0, 2, X'5B5D')
+			('s1', 'run', 'p', 'v', 'suite', 0, 0, 0, 0, 0, 0, 0, 0, 1, X'5B5D'),
+			('s2', 'run', 'p', 'v', 'suite', 0, 0, 0, 0, 0, 0, 0, 0, 2, X'5B5D')
 	`); err != nil {
 		t.Fatalf("INSERT suites: %v", err)
 	}
 
 	rows, err := db.QueryContext(context.Background(), `
 		SELECT id, run_id, prompt_name, prompt_version, suite_name, total_cases, passed_cases,
-			failed_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results
+			failed_cases, skipped_cases, pass_rate, avg_score, total_latency, total_tokens, created_at, case_results, metadata
 		FROM suite_results
 		WHERE boom(created_at) = 1
 		ORDER BY created_at ASC
@@ -60,7 +60,7 @@ func TestNewSQLiteStore_InitSchemaError_ReadOnlyDSN(t *testing.T) {
 	}
 }
 
-func TestInitSQLiteSchema_ClosedDB(t *testing.T) {
+func TestMigrateSQLite_ClosedDB(t *testing.T) {
 	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		t.Fatalf("Open: %v", err)
@@ -68,8 +68,8 @@ func TestInitSQLiteSchema_ClosedDB(t *testing.T) {
 	if err := db.Close(); err != nil {
 		t.Fatalf("Close: %v", err)
 	}
-	if err := initSQLiteSchema(db); err == nil {
-		t.Fatalf("initSQLiteSchema: expected error for closed db")
+	if _, _, err := migrateSQLite(db); err == nil {
+		t.Fatalf("migrateSQLite: expected error for closed db")
 	}
 }
 
@@ -468,6 +468,16 @@ func TestSQLiteStore_RowDecoders(t *testing.T) {
 	if _, err := decodeCaseResults([]byte("{")); err == nil {
 		t.Fatalf("decodeCaseResults(invalid): expected error")
 	}
+
+	if got, err := decodeMetadata(nil); err != nil || got != nil {
+		t.Fatalf("decodeMetadata(nil): got=%v err=%v", got, err)
+	}
+	if got, err := decodeMetadata([]byte("{}")); err != nil || got != nil {
+		t.Fatalf("decodeMetadata(\"{}\"): got=%v err=%v", got, err)
+	}
+	if _, err := decodeMetadata([]byte("{")); err == nil {
+		t.Fatalf("decodeMetadata(invalid): expected error")
+	}
 }
 
 func TestScanSuiteRows_ScanError(t *testing.T) {
@@ -10,6 +10,19 @@ type RunWriter interface {
 	// Save evaluation results
 	SaveRun(ctx context.Context, run *RunRecord) error
 	SaveSuiteResult(ctx context.Context, result *SuiteRecord) error
+
+	// PruneRuns deletes runs (and their suite_results) with started_at
+	// before cutoff, always keeping the most recent keepLast runs by
+	// started_at even if they're older than cutoff. Deletion is
+	// transactional: a crash mid-prune leaves either the old state or the
+	// fully-pruned state, never suite_results orphaned by their run. Returns
+	// the number of runs deleted.
+	PruneRuns(ctx context.Context, before time.Time, keepLast int) (int, error)
+
+	// SaveRedteamResult persists a single redteam attack result (see
+	// RedteamRecord). result.RunID must reference a RunRecord already saved
+	// with SaveRun, the same way SaveSuiteResult's results do.
+	SaveRedteamResult(ctx context.Context, result *RedteamRecord) error
 }
 
 // RunReader defines read access to run and suite data.
@@ -18,6 +31,10 @@ type RunReader interface {
 	GetRun(ctx context.Context, id string) (*RunRecord, error)
 	ListRuns(ctx context.Context, filter RunFilter) ([]*RunRecord, error)
 	GetSuiteResults(ctx context.Context, runID string) ([]*SuiteRecord, error)
+
+	// GetRedteamResults lists redteam attack results for a run, ordered by
+	// category then attack id.
+	GetRedteamResults(ctx context.Context, runID string) ([]*RedteamRecord, error)
 }
 
 // Analytics defines query helpers for historical comparisons.
@@ -25,6 +42,23 @@ type Analytics interface {
 	// Analytics
 	GetPromptHistory(ctx context.Context, promptName string, limit int) ([]*SuiteRecord, error)
 	GetVersionComparison(ctx context.Context, promptName, v1, v2 string) (*VersionComparison, error)
+
+	// GetFlakyCases scans the last window runs of promptName (see
+	// GetPromptHistory) and returns every (suite, case) whose pass/fail
+	// outcome changed at least once across them, ordered by flip rate
+	// descending.
+	GetFlakyCases(ctx context.Context, promptName string, window int) ([]FlakyCase, error)
+
+	// AggregateStats groups suite results into time buckets (see
+	// StatsFilter.Bucket) and reports pass rate, average score, and run
+	// count per bucket, ordered oldest bucket first. Intended for
+	// dashboards that would otherwise pull every run client-side.
+	AggregateStats(ctx context.Context, filter StatsFilter) ([]StatsBucket, error)
+
+	// CountPrunableRuns reports how many runs PruneRuns would delete for the
+	// same (before, keepLast) arguments, without deleting anything. Used to
+	// implement `history prune --dry-run`.
+	CountPrunableRuns(ctx context.Context, before time.Time, keepLast int) (int, error)
 }
 
 // Store defines persistence for runs and suite results.
@@ -56,24 +90,74 @@ type SuiteRecord struct {
 	TotalCases    int
 	PassedCases   int
 	FailedCases   int
+	SkippedCases  int
 	PassRate      float64
 	AvgScore      float64
 	TotalLatency  int64
 	TotalTokens   int
 	CreatedAt     time.Time
-	CaseResults   []CaseRecord // JSON serialized
+	Metadata      map[string]string // Suite-level tags (owner, jira ticket, category, ...); JSON serialized
+	CaseResults   []CaseRecord      // JSON serialized
 }
 
 // CaseRecord stores a single test case result.
 type CaseRecord struct {
-	CaseID     string
-	Passed     bool
-	Score      float64
-	PassAtK    float64
-	PassExpK   float64
-	LatencyMs  int64
-	TokensUsed int
-	Error      string
+	CaseID           string
+	Passed           bool
+	Score            float64
+	PassAtK          float64
+	PassExpK         float64
+	LatencyMs        int64
+	TokensUsed       int
+	PromptTokens     int
+	CompletionTokens int
+	Error            string
+	Skipped          bool              // True if runner.Config.FailFast canceled the suite before this case ran
+	Metadata         map[string]string // Case-level tags, carried over from testcase.TestCase.Metadata
+
+	// Responses holds each trial's raw model output, persisted only when the
+	// run opts in (see config.EvaluationConfig.PersistResponses). Nil
+	// otherwise. Lets a case be re-scored against new evaluators later
+	// without re-calling the model.
+	Responses []TrialResponseRecord `json:"responses,omitempty"`
+
+	// RenderedSystem and RenderedUser hold the exact system/user message
+	// content sent to the provider for this case, persisted only when the
+	// run opts in (see config.EvaluationConfig.PersistResponses), redacted
+	// and size-capped the same way as Responses. Lets a failure be debugged
+	// against precisely what was sent, not just what came back.
+	RenderedSystem string `json:"rendered_system,omitempty"`
+	RenderedUser   string `json:"rendered_user,omitempty"`
+}
+
+// TrialResponseRecord stores one trial's raw response text and tool calls,
+// size-capped and redacted before being written (see
+// app.SaveRun/truncateResponse).
+type TrialResponseRecord struct {
+	TrialNum  int
+	Response  string
+	ToolCalls []ToolCallRecord `json:"tool_calls,omitempty"`
+}
+
+// ToolCallRecord stores a single tool invocation made during a trial.
+type ToolCallRecord struct {
+	Name  string
+	Input map[string]any
+}
+
+// RedteamRecord stores a single redteam attack result, persisted from
+// `redteam` runs (unless run with --no-save) so a prompt's safety-check
+// history can be audited later instead of only printed and discarded.
+type RedteamRecord struct {
+	ID            string
+	RunID         string
+	PromptName    string
+	PromptVersion string
+	Category      string
+	Attack        string
+	Score         float64
+	Passed        bool
+	CreatedAt     time.Time
 }
 
 // RunFilter filters run listings.
@@ -85,6 +169,41 @@ type RunFilter struct {
 	Limit         int
 }
 
+// FlakyCase reports a (suite, case) pair whose pass/fail outcome changed
+// across recent runs of the same prompt, as returned by GetFlakyCases.
+type FlakyCase struct {
+	SuiteName string
+	CaseID    string
+	Runs      int     // Number of runs the case appeared in within the window
+	Flips     int     // Number of times the outcome differed from the immediately preceding run
+	FlipRate  float64 // Flips / (Runs - 1); 0 when Runs <= 1
+}
+
+// StatsFilter filters and buckets the AggregateStats query.
+type StatsFilter struct {
+	PromptName    string
+	PromptVersion string
+	Since         time.Time
+	Until         time.Time
+
+	// Bucket selects the time granularity to group by. Only "day" is
+	// currently supported; empty defaults to "day".
+	Bucket string
+}
+
+// StatsBucket reports aggregated suite-result stats for one time bucket, as
+// returned by AggregateStats.
+type StatsBucket struct {
+	Bucket    string  // Bucket start, formatted per StatsFilter.Bucket (e.g. "2026-02-07" for "day")
+	PassRate  float64 // Average of suite_results.pass_rate within the bucket
+	AvgScore  float64 // Average of suite_results.avg_score within the bucket
+	TotalRuns int     // Count of suite results within the bucket
+
+	// TotalCost is always 0: per-run cost isn't tracked in suite_results
+	// yet. Reserved for when cost accounting is added.
+	TotalCost float64
+}
+
 // VersionComparison summarizes regressions between prompt versions.
 type VersionComparison struct {
 	PromptName   string
@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/stellarlinkco/ai-eval/internal/prompt"
 )
 
 func TestLoadFromFile(t *testing.T) {
@@ -170,6 +173,198 @@ func TestLoadFromFile_JSON(t *testing.T) {
 	}
 }
 
+func TestLoadFromFile_InputFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	inputPath := filepath.Join(dir, "payload.json")
+	if err := os.WriteFile(inputPath, []byte(`{"user": "alice", "role": "admin"}`), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	suitePath := filepath.Join(dir, "suite.yaml")
+	const in = `
+suite: s
+prompt: p
+cases:
+  - id: c1
+    input_file: payload.json
+    input:
+      role: viewer
+    expected:
+      exact_match: ok
+`
+	if err := os.WriteFile(suitePath, []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	s, err := LoadFromFile(suitePath)
+	if err != nil {
+		t.Fatalf("LoadFromFile: %v", err)
+	}
+	got := s.Cases[0].Input
+	if got["user"] != "alice" {
+		t.Fatalf("Input[user]: got %v want %v", got["user"], "alice")
+	}
+	if got["role"] != "viewer" {
+		t.Fatalf("Input[role]: got %v want %v (inline input should win)", got["role"], "viewer")
+	}
+}
+
+func TestLoadFromFile_InputFile_Missing(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	suitePath := filepath.Join(dir, "suite.yaml")
+	const in = `
+suite: s
+prompt: p
+cases:
+  - id: c1
+    input_file: missing.json
+    expected:
+      exact_match: ok
+`
+	if err := os.WriteFile(suitePath, []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	_, err := LoadFromFile(suitePath)
+	if err == nil || !strings.Contains(err.Error(), "input_file") {
+		t.Fatalf("LoadFromFile: got %v, want input_file error", err)
+	}
+}
+
+func TestLoadFromFile_InputFile_NotAnObject(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "payload.json"), []byte(`[1, 2, 3]`), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	suitePath := filepath.Join(dir, "suite.yaml")
+	const in = `
+suite: s
+prompt: p
+cases:
+  - id: c1
+    input_file: payload.json
+    expected:
+      exact_match: ok
+`
+	if err := os.WriteFile(suitePath, []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	_, err := LoadFromFile(suitePath)
+	if err == nil || !strings.Contains(err.Error(), "input_file") {
+		t.Fatalf("LoadFromFile: got %v, want input_file error", err)
+	}
+}
+
+func TestLoadFromFile_InputFile_Empty(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "payload.json"), []byte(""), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	suitePath := filepath.Join(dir, "suite.yaml")
+	const in = `
+suite: s
+prompt: p
+cases:
+  - id: c1
+    input_file: payload.json
+    expected:
+      exact_match: ok
+`
+	if err := os.WriteFile(suitePath, []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	_, err := LoadFromFile(suitePath)
+	if err == nil || !strings.Contains(err.Error(), "does not contain a JSON/YAML object") {
+		t.Fatalf("LoadFromFile: got %v", err)
+	}
+}
+
+func TestLoadFromFile_OpenAPISpec_Missing(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	suitePath := filepath.Join(dir, "suite.yaml")
+	const in = `
+suite: s
+prompt: p
+cases:
+  - id: c1
+    input:
+      body: "{}"
+    evaluators:
+      - type: openapi
+        openapi_spec: missing-spec.yaml
+        operation_id: createWidget
+`
+	if err := os.WriteFile(suitePath, []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	_, err := LoadFromFile(suitePath)
+	if err == nil || !strings.Contains(err.Error(), "openapi_spec") {
+		t.Fatalf("LoadFromFile: got %v, want openapi_spec error", err)
+	}
+}
+
+func TestValidateReferences(t *testing.T) {
+	t.Parallel()
+
+	if err := ValidateReferences(nil); err == nil {
+		t.Fatalf("expected error for nil suite")
+	}
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "spec.yaml")
+	if err := os.WriteFile(specPath, []byte("openapi: 3.0.0\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	suite := &TestSuite{
+		Suite:  "s",
+		Prompt: "p",
+		Cases: []TestCase{{
+			ID:    "c1",
+			Input: map[string]any{},
+			Evaluators: []EvaluatorConfig{{
+				Type:        "openapi",
+				OpenAPISpec: specPath,
+				OperationID: "createWidget",
+			}},
+		}},
+	}
+	if err := ValidateReferences(suite); err != nil {
+		t.Fatalf("ValidateReferences: %v", err)
+	}
+
+	suite.Cases[0].Evaluators[0].OpenAPISpec = filepath.Join(dir, "nope.yaml")
+	err := ValidateReferences(suite)
+	if err == nil || !strings.Contains(err.Error(), "nope.yaml") {
+		t.Fatalf("ValidateReferences: got %v, want nope.yaml error", err)
+	}
+
+	suite.Cases[0].Evaluators[0].OpenAPISpec = ""
+	if err := ValidateReferences(suite); err != nil {
+		t.Fatalf("ValidateReferences: empty spec should be ignored (caught by validateEvaluators): %v", err)
+	}
+
+	suite.Cases[0].Evaluators[0].Type = "exact"
+	if err := ValidateReferences(suite); err != nil {
+		t.Fatalf("ValidateReferences: non-openapi evaluator: %v", err)
+	}
+}
+
 func TestLoadFromFile_InvalidSuite(t *testing.T) {
 	t.Parallel()
 
@@ -239,6 +434,11 @@ func TestValidate(t *testing.T) {
 			suite:     &TestSuite{Suite: "s", Prompt: "p"},
 			wantError: "no cases",
 		},
+		{
+			name:      "negative timeout",
+			suite:     &TestSuite{Suite: "s", Prompt: "p", Timeout: -time.Second, Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{ExactMatch: "ok"}}}},
+			wantError: "timeout must be >= 0",
+		},
 		{
 			name:      "missing case id",
 			suite:     &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "", Input: map[string]any{}, Expected: Expected{ExactMatch: "ok"}}}},
@@ -259,11 +459,21 @@ func TestValidate(t *testing.T) {
 			suite:     &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{ExactMatch: "ok"}, MaxSteps: -1}}},
 			wantError: "max_steps must be >= 0",
 		},
+		{
+			name:      "negative max steps hard fail",
+			suite:     &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{ExactMatch: "ok"}, MaxStepsHardFail: -1}}},
+			wantError: "max_steps_hard_fail must be >= 0",
+		},
 		{
 			name:      "tool mock missing name",
 			suite:     &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{ExactMatch: "ok"}, ToolMocks: []ToolMock{{Name: " ", Response: "ok"}}}}},
 			wantError: "tool_mocks[0]: missing name",
 		},
+		{
+			name:      "prompt override with bad template",
+			suite:     &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{ExactMatch: "ok"}, PromptOverride: &prompt.Override{Template: "oops }}"}}}},
+			wantError: "prompt_override",
+		},
 		{
 			name:      "no assertions",
 			suite:     &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{}}}},
@@ -321,6 +531,88 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidate_UnknownEvaluatorListsValidTypes(t *testing.T) {
+	t.Parallel()
+
+	suite := &TestSuite{
+		Suite:  "s",
+		Prompt: "p",
+		Cases: []TestCase{{
+			ID:         "c1",
+			Input:      map[string]any{},
+			Expected:   Expected{},
+			Evaluators: []EvaluatorConfig{{Type: "llm_jduge"}},
+		}},
+	}
+
+	err := Validate(suite)
+	if err == nil {
+		t.Fatalf("Validate: expected error")
+	}
+	if !strings.Contains(err.Error(), `unknown type "llm_jduge"`) {
+		t.Fatalf("Validate: got %v want offending type in message", err)
+	}
+	if !strings.Contains(err.Error(), "valid types:") || !strings.Contains(err.Error(), "llm_judge") {
+		t.Fatalf("Validate: got %v want valid types listed", err)
+	}
+}
+
+func TestResolveEvaluatorType(t *testing.T) {
+	t.Parallel()
+
+	if got := ResolveEvaluatorType(" judge "); got != "llm_judge" {
+		t.Fatalf("judge: got %q", got)
+	}
+	if got := ResolveEvaluatorType("llm_judge"); got != "llm_judge" {
+		t.Fatalf("canonical passthrough: got %q", got)
+	}
+	if got := ResolveEvaluatorType("bogus"); got != "bogus" {
+		t.Fatalf("unknown passthrough: got %q", got)
+	}
+}
+
+func TestEvaluatorAliases_NoCollisionWithCanonicalTypes(t *testing.T) {
+	t.Parallel()
+
+	for alias, canonical := range EvaluatorAliases() {
+		if isKnownEvaluatorType(alias) {
+			t.Fatalf("alias %q collides with a canonical evaluator type", alias)
+		}
+		if !isKnownEvaluatorType(canonical) {
+			t.Fatalf("alias %q resolves to unknown canonical type %q", alias, canonical)
+		}
+	}
+}
+
+func TestEvaluatorAliases_IsACopy(t *testing.T) {
+	t.Parallel()
+
+	aliases := EvaluatorAliases()
+	aliases["new_alias"] = "exact"
+	if _, ok := EvaluatorAliases()["new_alias"]; ok {
+		t.Fatalf("mutating the returned map affected the package's alias table")
+	}
+}
+
+func TestValidate_AcceptsEvaluatorAlias(t *testing.T) {
+	t.Parallel()
+
+	suite := &TestSuite{
+		Suite:  "s",
+		Prompt: "p",
+		Cases: []TestCase{{
+			ID:         "c1",
+			Input:      map[string]any{},
+			Expected:   Expected{},
+			Evaluators: []EvaluatorConfig{{Type: "judge", Criteria: "c"}},
+		}},
+	}
+
+	if err := Validate(suite); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
+
 func TestValidate_NewEvaluatorTypes(t *testing.T) {
 	t.Parallel()
 
@@ -337,8 +629,10 @@ func TestValidate_NewEvaluatorTypes(t *testing.T) {
 				{Type: "precision", Context: "ctx", Question: "q"},
 				{Type: "task_completion", Task: "do it", CriteriaList: []string{"a", "b"}},
 				{Type: "tool_selection", ExpectedTools: []string{"search"}},
+				{Type: "tool_before_answer", RequiredTool: "policy_check"},
 				{Type: "efficiency", MaxSteps: 5, MaxTokens: 1000},
 				{Type: "hallucination", GroundTruth: "gt", ScoreThreshold: 0.9},
+				{Type: "reasoning_answer", Delimiter: "Answer:", AnswerType: "exact", Answer: "42"},
 				{Type: "toxicity", ScoreThreshold: 0.1},
 				{Type: "bias", Categories: []string{"gender"}, ScoreThreshold: 0.1},
 				{Type: "tool_call"},
@@ -374,9 +668,13 @@ func TestValidate_EvaluatorErrors(t *testing.T) {
 		{name: "precision missing context", e: EvaluatorConfig{Type: "precision", Question: "q"}, wantError: "missing context"},
 		{name: "precision missing question", e: EvaluatorConfig{Type: "precision", Context: "c"}, wantError: "missing question"},
 		{name: "task_completion missing task", e: EvaluatorConfig{Type: "task_completion"}, wantError: "missing task"},
+		{name: "tool_before_answer missing required_tool", e: EvaluatorConfig{Type: "tool_before_answer"}, wantError: "missing required_tool"},
 		{name: "efficiency negative max_steps", e: EvaluatorConfig{Type: "efficiency", MaxSteps: -1}, wantError: "max_steps must be >= 0"},
 		{name: "efficiency negative max_tokens", e: EvaluatorConfig{Type: "efficiency", MaxTokens: -1}, wantError: "max_tokens must be >= 0"},
 		{name: "hallucination missing ground_truth", e: EvaluatorConfig{Type: "hallucination"}, wantError: "missing ground_truth"},
+		{name: "reasoning_answer missing delimiter", e: EvaluatorConfig{Type: "reasoning_answer", AnswerType: "exact", Answer: "42"}, wantError: "missing delimiter"},
+		{name: "reasoning_answer missing answer_type", e: EvaluatorConfig{Type: "reasoning_answer", Delimiter: "Answer:", Answer: "42"}, wantError: "missing answer_type"},
+		{name: "reasoning_answer missing answer", e: EvaluatorConfig{Type: "reasoning_answer", Delimiter: "Answer:", AnswerType: "exact"}, wantError: "missing answer"},
 		{name: "bias empty category", e: EvaluatorConfig{Type: "bias", Categories: []string{" "}}, wantError: "categories[0]: empty string"},
 	}
 
@@ -405,3 +703,130 @@ func TestValidate_EvaluatorErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyNoAssertionsPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error policy is a no-op", func(t *testing.T) {
+		t.Parallel()
+
+		suite := &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{}}}}
+		warnings := ApplyNoAssertionsPolicy(suite, NoAssertionsError)
+		if warnings != nil {
+			t.Fatalf("warnings: got %v want nil", warnings)
+		}
+		if len(suite.Cases[0].Evaluators) != 0 {
+			t.Fatalf("Evaluators: got %#v want empty", suite.Cases[0].Evaluators)
+		}
+	})
+
+	t.Run("unset policy is a no-op", func(t *testing.T) {
+		t.Parallel()
+
+		suite := &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{}}}}
+		if warnings := ApplyNoAssertionsPolicy(suite, ""); warnings != nil {
+			t.Fatalf("warnings: got %v want nil", warnings)
+		}
+	})
+
+	t.Run("default_evaluator adds non_empty and warns", func(t *testing.T) {
+		t.Parallel()
+
+		suite := &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{{ID: "c1", Input: map[string]any{}, Expected: Expected{}}}}
+		warnings := ApplyNoAssertionsPolicy(suite, NoAssertionsDefaultEvaluator)
+		if len(warnings) != 1 || !strings.Contains(warnings[0], "c1") {
+			t.Fatalf("warnings: got %v", warnings)
+		}
+		if len(suite.Cases[0].Evaluators) != 1 || suite.Cases[0].Evaluators[0].Type != "non_empty" {
+			t.Fatalf("Evaluators: got %#v", suite.Cases[0].Evaluators)
+		}
+	})
+
+	t.Run("default_evaluator skips cases with evaluators or expected", func(t *testing.T) {
+		t.Parallel()
+
+		suite := &TestSuite{Suite: "s", Prompt: "p", Cases: []TestCase{
+			{ID: "has_evaluator", Input: map[string]any{}, Expected: Expected{}, Evaluators: []EvaluatorConfig{{Type: "exact"}}},
+			{ID: "has_expected", Input: map[string]any{}, Expected: Expected{ExactMatch: "ok"}},
+		}}
+		if warnings := ApplyNoAssertionsPolicy(suite, NoAssertionsDefaultEvaluator); warnings != nil {
+			t.Fatalf("warnings: got %v want nil", warnings)
+		}
+		if len(suite.Cases[0].Evaluators) != 1 {
+			t.Fatalf("has_evaluator.Evaluators: got %#v", suite.Cases[0].Evaluators)
+		}
+		if len(suite.Cases[1].Evaluators) != 0 {
+			t.Fatalf("has_expected.Evaluators: got %#v", suite.Cases[1].Evaluators)
+		}
+	})
+
+	t.Run("nil suite", func(t *testing.T) {
+		t.Parallel()
+
+		if warnings := ApplyNoAssertionsPolicy(nil, NoAssertionsDefaultEvaluator); warnings != nil {
+			t.Fatalf("warnings: got %v want nil", warnings)
+		}
+	})
+}
+
+func TestLoadFromFileWithPolicy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "suite.yaml")
+	const in = `
+suite: s
+prompt: p
+cases:
+  - id: no_assertions
+    input: {}
+    expected: {}
+`
+	if err := os.WriteFile(path, []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, _, err := LoadFromFileWithPolicy(path, NoAssertionsError); err == nil {
+		t.Fatalf("LoadFromFileWithPolicy(error): expected error")
+	}
+
+	s, warnings, err := LoadFromFileWithPolicy(path, NoAssertionsDefaultEvaluator)
+	if err != nil {
+		t.Fatalf("LoadFromFileWithPolicy(default_evaluator): %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("warnings: got %v", warnings)
+	}
+	if len(s.Cases[0].Evaluators) != 1 || s.Cases[0].Evaluators[0].Type != "non_empty" {
+		t.Fatalf("Evaluators: got %#v", s.Cases[0].Evaluators)
+	}
+}
+
+func TestLoadFromDirWithPolicy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	const in = `
+suite: s
+prompt: p
+cases:
+  - id: no_assertions
+    input: {}
+    expected: {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "suite.yaml"), []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, _, err := LoadFromDirWithPolicy(dir, NoAssertionsError); err == nil {
+		t.Fatalf("LoadFromDirWithPolicy(error): expected error")
+	}
+
+	ss, warnings, err := LoadFromDirWithPolicy(dir, NoAssertionsDefaultEvaluator)
+	if err != nil {
+		t.Fatalf("LoadFromDirWithPolicy(default_evaluator): %v", err)
+	}
+	if len(ss) != 1 || len(warnings) != 1 {
+		t.Fatalf("got ss=%#v warnings=%v", ss, warnings)
+	}
+}
@@ -1,34 +1,84 @@
 package testcase
 
+import (
+	"time"
+
+	"github.com/stellarlinkco/ai-eval/internal/prompt"
+)
+
 // TestSuite defines a suite of test cases.
 type TestSuite struct {
-	Suite          string     `yaml:"suite"`
-	Prompt         string     `yaml:"prompt"` // Reference to prompt name
-	Description    string     `yaml:"description,omitempty"`
-	IsSystemPrompt bool       `yaml:"is_system_prompt,omitempty"` // If true, prompt is used as system message
-	Cases          []TestCase `yaml:"cases"`
+	Suite          string            `yaml:"suite"`
+	Prompt         string            `yaml:"prompt"` // Reference to prompt name
+	Description    string            `yaml:"description,omitempty"`
+	IsSystemPrompt bool              `yaml:"is_system_prompt,omitempty"` // If true, prompt is used as system message
+	Metadata       map[string]string `yaml:"metadata,omitempty"`         // Arbitrary tags (owner, jira ticket, category, ...)
+	Cases          []TestCase        `yaml:"cases"`
+
+	// Timeout bounds this suite's entire RunSuite call, independent of the
+	// runner's per-trial Timeout and any deadline already on the context
+	// passed in. Zero means "no suite-level timeout" (the ambient context's
+	// deadline, if any, still applies).
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+
+	// OutputSchema, when set, is a JSON Schema (the same shape accepted by
+	// the json_schema evaluator's Expected field) that every trial response
+	// in the suite is expected to conform to, independent of any per-case
+	// evaluators. RunSuite validates every response against it and reports
+	// the aggregate conformance rate on SuiteResult.SchemaConformance. Use
+	// this to enforce a consistent structured output shape across a whole
+	// suite instead of repeating the same json_schema assertion per case.
+	OutputSchema map[string]any `yaml:"json_schema,omitempty"`
 }
 
 // TestCase defines a single evaluation case.
 type TestCase struct {
-	ID          string            `yaml:"id"`
-	Description string            `yaml:"description,omitempty"`
-	Input       map[string]any    `yaml:"input"`
-	Expected    Expected          `yaml:"expected"`
-	Evaluators  []EvaluatorConfig `yaml:"evaluators,omitempty"`
-	Trials      int               `yaml:"trials,omitempty"` // Override default trials
-	ToolMocks   []ToolMock        `yaml:"tool_mocks,omitempty"`
-	MaxSteps    int               `yaml:"max_steps,omitempty"` // Max agent steps, default 5
+	ID          string         `yaml:"id"`
+	Description string         `yaml:"description,omitempty"`
+	Input       map[string]any `yaml:"input"`
+	// InputFile references a JSON/YAML file, resolved relative to the suite
+	// file (or absolute), whose contents are parsed as an object and merged
+	// into Input at load time. Keys set directly in Input take precedence
+	// over ones loaded from InputFile. Useful for cases that need a large
+	// document (e.g. a full API payload) that's awkward to keep inline in
+	// the suite YAML.
+	InputFile  string            `yaml:"input_file,omitempty"`
+	Expected   Expected          `yaml:"expected"`
+	Evaluators []EvaluatorConfig `yaml:"evaluators,omitempty"`
+	Trials     int               `yaml:"trials,omitempty"` // Override default trials
+	ToolMocks  []ToolMock        `yaml:"tool_mocks,omitempty"`
+	MaxSteps   int               `yaml:"max_steps,omitempty"` // Max agent steps, default 5
+	Metadata   map[string]string `yaml:"metadata,omitempty"`  // Arbitrary tags (owner, jira ticket, category, ...)
+
+	// MaxStepsHardFail, when > 0, fails the trial outright with an
+	// "exceeded max tool steps" evaluator result if the agent takes more
+	// than this many steps, instead of letting it be scored on whatever
+	// partial response it produced. Unlike MaxSteps (a loop budget the
+	// provider enforces while generating), this is a post-hoc gate the
+	// runner applies to the completed trial. Falls back to
+	// runner.Config.MaxStepsHardFail when unset (0 disables the gate at
+	// either level).
+	MaxStepsHardFail int `yaml:"max_steps_hard_fail,omitempty"`
+
+	// StopSequences overrides the prompt's StopSequences for this case, if set.
+	StopSequences []string `yaml:"stop_sequences,omitempty"`
+
+	// PromptOverride patches the suite's prompt (Template/Variables/Metadata,
+	// see prompt.Override) for this case only, e.g. to A/B a wording tweak
+	// without duplicating the whole prompt file into a near-identical
+	// suite. Other cases in the suite render against the unpatched prompt.
+	PromptOverride *prompt.Override `yaml:"prompt_override,omitempty"`
 }
 
 // Expected defines built-in expectation assertions.
 type Expected struct {
-	ExactMatch  string           `yaml:"exact_match,omitempty"`
-	Contains    []string         `yaml:"contains,omitempty"`
-	NotContains []string         `yaml:"not_contains,omitempty"`
-	Regex       []string         `yaml:"regex,omitempty"`
-	JSONSchema  map[string]any   `yaml:"json_schema,omitempty"`
-	ToolCalls   []ToolCallExpect `yaml:"tool_calls,omitempty"`
+	ExactMatch      string             `yaml:"exact_match,omitempty"`
+	Contains        []string           `yaml:"contains,omitempty"`
+	NotContains     []string           `yaml:"not_contains,omitempty"`
+	Regex           []string           `yaml:"regex,omitempty"`
+	JSONSchema      map[string]any     `yaml:"json_schema,omitempty"`
+	ToolCalls       []ToolCallExpect   `yaml:"tool_calls,omitempty"`
+	ToolResultUsage []ToolResultExpect `yaml:"tool_result_usage,omitempty"`
 }
 
 // ToolCallExpect describes an expected tool call.
@@ -41,27 +91,85 @@ type ToolCallExpect struct {
 
 // EvaluatorConfig configures a custom evaluator.
 type EvaluatorConfig struct {
-	Type           string   `yaml:"type"`                      // exact, contains, regex, json_schema, llm_judge, similarity, factuality, tool_call, faithfulness, relevancy, precision, task_completion, tool_selection, efficiency, hallucination, toxicity, bias
+	Type           string   `yaml:"type"`                      // exact, contains, contains_at_least, regex, json_schema, json_valid, openapi, llm_judge, similarity, factuality, tool_call, faithfulness, relevancy, precision, citation, diversity, task_completion, tool_selection, tool_before_answer, efficiency, confidence, agent_faithfulness, hallucination, toxicity, bias, enum, reasoning_answer
 	Criteria       string   `yaml:"criteria,omitempty"`        // llm_judge
 	Rubric         []string `yaml:"rubric,omitempty"`          // llm_judge
 	ScoreScale     int      `yaml:"score_scale,omitempty"`     // llm_judge
 	Reference      string   `yaml:"reference,omitempty"`       // similarity
+	References     []string `yaml:"references,omitempty"`      // similarity: multiple acceptable reference answers; scores against the best match
 	GroundTruth    string   `yaml:"ground_truth,omitempty"`    // factuality, hallucination
 	ScoreThreshold float64  `yaml:"score_threshold,omitempty"` // Optional override / threshold
 
 	// RAG evaluators
-	Context  string `yaml:"context,omitempty"`  // faithfulness, precision
+	Context  string `yaml:"context,omitempty"`  // faithfulness, precision, citation
 	Question string `yaml:"question,omitempty"` // relevancy, precision
 
+	// openapi
+	OpenAPISpec string `yaml:"openapi_spec,omitempty"` // openapi: path to the OpenAPI document
+	OperationID string `yaml:"operation_id,omitempty"` // openapi: operationId to validate the request body against
+
+	// diversity
+	Delimiter   string `yaml:"delimiter,omitempty"`    // diversity: item delimiter, default "\n"
+	MinDistinct int    `yaml:"min_distinct,omitempty"` // diversity: minimum distinct items required, default 1
+
+	// contains_at_least
+	Substrings []string `yaml:"substrings,omitempty"` // contains_at_least: candidate substrings
+	MinCount   int      `yaml:"min_count,omitempty"`  // contains_at_least: minimum number that must be found, default 1
+
+	// enum
+	Labels        []string `yaml:"labels,omitempty"`         // enum: fixed set of allowed labels
+	ExpectedLabel string   `yaml:"expected_label,omitempty"` // enum: optional specific label the response must equal
+
+	// reasoning_answer (also uses Delimiter, above)
+	AnswerType string `yaml:"answer_type,omitempty"` // reasoning_answer: exact|contains|numeric, selects how Answer is checked
+	Answer     any    `yaml:"answer,omitempty"`      // reasoning_answer: expected value for the nested check (string, []string, or number)
+
 	// Agent evaluators
 	Task          string   `yaml:"task,omitempty"`           // task_completion
 	CriteriaList  []string `yaml:"criteria_list,omitempty"`  // task_completion
 	ExpectedTools []string `yaml:"expected_tools,omitempty"` // tool_selection
+	RequiredTool  string   `yaml:"required_tool,omitempty"`  // tool_before_answer: guardrail tool that must run before any final answer
 	MaxSteps      int      `yaml:"max_steps,omitempty"`      // efficiency
 	MaxTokens     int      `yaml:"max_tokens,omitempty"`     // efficiency
 
 	// Safety evaluators
 	Categories []string `yaml:"categories,omitempty"` // bias
+
+	// json_valid
+	Canonical bool `yaml:"canonical,omitempty"` // json_valid: also require no trailing data and no duplicate object keys
+
+	// Optional excludes this evaluator's Passed from the case's allPassed
+	// conjunction; its Score still counts toward the case's average. Use it
+	// for evaluators that should inform the score without being able to
+	// block a pass on their own.
+	Optional bool `yaml:"optional,omitempty"`
+
+	// LLMParams overrides the LLM call parameters used by this evaluator's
+	// own judge call. Applies to llm_judge, similarity, factuality,
+	// faithfulness, relevancy, precision, task_completion, agent_faithfulness,
+	// hallucination, toxicity, and bias; ignored by every other evaluator type.
+	LLMParams ModelParams `yaml:"llm_params,omitempty"`
+}
+
+// ModelParams overrides LLM call parameters an LLM-backed evaluator's own
+// judge call uses, independent of whatever temperature/model the response
+// under test was generated with. Zero/empty values mean "use the
+// evaluator's default" (deterministic temperature 0, the evaluator's usual
+// max_tokens, and the provider's configured model).
+type ModelParams struct {
+	Temperature float64 `yaml:"temperature,omitempty"`
+	MaxTokens   int     `yaml:"max_tokens,omitempty"`
+	Model       string  `yaml:"model,omitempty"`
+}
+
+// ToolResultExpect asserts that the final response references or derives
+// from a value returned by a mocked tool call (see ToolMock), catching
+// agents that call a tool but then ignore its result.
+type ToolResultExpect struct {
+	Tool     string `yaml:"tool"`               // Name of the ToolMock whose response the answer must reflect
+	Value    string `yaml:"value,omitempty"`    // Value to look for; defaults to the tool's mocked response
+	Mode     string `yaml:"mode,omitempty"`     // "contains" (default) or "llm_judge"
+	Criteria string `yaml:"criteria,omitempty"` // llm_judge: judging criteria; defaults to a generic prompt seeded with Value
 }
 
 // ToolMock defines a stubbed tool response.
@@ -70,4 +178,14 @@ type ToolMock struct {
 	Response string         `yaml:"response"`
 	Error    string         `yaml:"error,omitempty"`
 	Match    map[string]any `yaml:"match,omitempty"` // Only apply if args match
+
+	// Responses, if set, overrides Response with a sequence consumed in
+	// order across successive matching calls to this mock (e.g. paginated
+	// results). A call past the end of the sequence errors.
+	Responses []string `yaml:"responses,omitempty"`
+
+	// CallCount, if > 0, restricts this mock to the Nth (1-indexed) call to
+	// Name, letting separate ToolMock entries model different responses to
+	// the same tool by call number instead of by argument matching.
+	CallCount int `yaml:"call_count,omitempty"`
 }
@@ -0,0 +1,37 @@
+// Package version holds build metadata for the ai-eval binaries, set via
+// -ldflags -X at build time, e.g.:
+//
+//	go build -ldflags "-X github.com/stellarlinkco/ai-eval/internal/version.Version=v1.2.3 \
+//	  -X github.com/stellarlinkco/ai-eval/internal/version.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/stellarlinkco/ai-eval/internal/version.Date=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+package version
+
+import "runtime"
+
+// Version, Commit, and Date default to "dev"/"unknown" for a plain `go
+// build`/`go run` with no -ldflags, e.g. during local development.
+var (
+	Version = "dev"
+	Commit  = "unknown"
+	Date    = "unknown"
+)
+
+// Info is the build metadata reported by `ai-eval version` and
+// GET /api/version.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	Date      string `json:"date"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the current build's Info, filling GoVersion from the runtime
+// rather than -ldflags since it's already known at compile time.
+func Get() Info {
+	return Info{
+		Version:   Version,
+		Commit:    Commit,
+		Date:      Date,
+		GoVersion: runtime.Version(),
+	}
+}